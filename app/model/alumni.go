@@ -16,16 +16,24 @@ type Alumni struct {
 	StatusKematian bool `json:"status_kematian"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+	Version    int       `json:"version"` // naik setiap update, dikirim juga sebagai ETag
+}
+
+// AlumniTrashed alumni di trash (GET /api/alumni/trashed)
+type AlumniTrashed struct {
+	Alumni
+	IsDeleted bool       `json:"is_deleted"`
+	DeletedAt *time.Time `json:"deleted_at"`
 }
 
 type CreateAlumniRequest struct {
-	NIM            string `json:"nim" example:"12345678"`
-	Nama           string `json:"nama" example:"John Doe"`
-	Jurusan        string `json:"jurusan" example:"Teknik Informatika"`
-	Angkatan       int    `json:"angkatan" example:"2019"`
-	TahunLulus     int    `json:"tahun_lulus" example:"2023"`
-	Email          string `json:"email" example:"john@example.com"`
-	NoTelepon      string `json:"no_telepon" example:"08123456789"`
+	NIM            string `json:"nim" example:"12345678" validate:"required,nim"`
+	Nama           string `json:"nama" example:"John Doe" validate:"required,max=100"`
+	Jurusan        string `json:"jurusan" example:"Teknik Informatika" validate:"max=100"`
+	Angkatan       int    `json:"angkatan" example:"2019" validate:"min=1900,max=2100"`
+	TahunLulus     int    `json:"tahun_lulus" example:"2023" validate:"min=1900,max=2100,gtefield=angkatan"`
+	Email          string `json:"email" example:"john@example.com" validate:"required,email,max=100"`
+	NoTelepon      string `json:"no_telepon" example:"08123456789" validate:"phone"`
 	Alamat         string `json:"alamat" example:"Jl. Merdeka No. 1"`
 	StatusKematian bool   `json:"status_kematian" example:"false"`
 }
@@ -33,13 +41,13 @@ type CreateAlumniRequest struct {
 type UpdateAlumniRequest struct {
 	ID             int    `json:"id"` // biar bisa dipakai di repository
 	UserID         int    `json:"user_id,omitempty"`
-	NIM            string `json:"nim" example:"12345678"`
-	Nama           string `json:"nama" example:"John Doe"`
-	Jurusan        string `json:"jurusan" example:"Teknik Informatika"`
-	Angkatan       int    `json:"angkatan" example:"2019"`
-	TahunLulus     int    `json:"tahun_lulus" example:"2023"`
-	Email          string `json:"email" example:"john@example.com"`
-	NoTelepon      string `json:"no_telepon" example:"08123456789"`
+	NIM            string `json:"nim" example:"12345678" validate:"required,nim"`
+	Nama           string `json:"nama" example:"John Doe" validate:"required,max=100"`
+	Jurusan        string `json:"jurusan" example:"Teknik Informatika" validate:"max=100"`
+	Angkatan       int    `json:"angkatan" example:"2019" validate:"min=1900,max=2100"`
+	TahunLulus     int    `json:"tahun_lulus" example:"2023" validate:"min=1900,max=2100,gtefield=angkatan"`
+	Email          string `json:"email" example:"john@example.com" validate:"required,email,max=100"`
+	NoTelepon      string `json:"no_telepon" example:"08123456789" validate:"phone"`
 	Alamat         string `json:"alamat" example:"Jl. Merdeka No. 1"`
 	StatusKematian bool   `json:"status_kematian" example:"false"`
 }
@@ -49,3 +57,11 @@ type UpdateStatusKematianRequest struct {
 }
 
 
+
+// Request alumni mengubah data kontaknya sendiri (PATCH, field kosong = tidak diubah).
+// NIM, angkatan, status_kematian, dll hanya bisa diubah admin.
+type UpdateMyAlumniRequest struct {
+	Email     *string `json:"email" example:"john.baru@example.com" validate:"notblank,email,max=100"`
+	NoTelepon *string `json:"no_telepon" example:"08123456789" validate:"phone"`
+	Alamat    *string `json:"alamat" example:"Jl. Sudirman No. 2"`
+}
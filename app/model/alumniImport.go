@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// Kolom yang bisa diisi lewat import alumni (nama field = tag json CreateAlumniRequest)
+var AlumniImportColumns = []string{
+	"nim", "nama", "jurusan", "angkatan", "tahun_lulus",
+	"email", "no_telepon", "alamat", "status_kematian",
+}
+
+// AlumniImportRow satu baris valid hasil pemetaan file import
+type AlumniImportRow struct {
+	Row  int                 `json:"row"` // nomor baris di file (header = 1)
+	Data CreateAlumniRequest `json:"data"`
+}
+
+// ImportRowError kesalahan validasi per baris
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	NIM     string `json:"nim,omitempty"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// AlumniImportResult hasil import / dry-run
+type AlumniImportResult struct {
+	DryRun    bool             `json:"dry_run"`
+	TotalRows int              `json:"total_rows"`
+	Created   int              `json:"created"`
+	Updated   int              `json:"updated"`
+	Failed    int              `json:"failed"`
+	Errors    []ImportRowError `json:"errors"`
+	ReportID  string           `json:"report_id,omitempty"` // download di /api/alumni/import/reports/{id}
+}
+
+// AlumniImportReport laporan error import yang disimpan untuk diunduh
+type AlumniImportReport struct {
+	ID        string    `json:"id"`
+	CreatedBy int       `json:"created_by"`
+	Filename  string    `json:"filename"`
+	Content   []byte    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
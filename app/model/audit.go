@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// AuditLog catatan aktivitas penting (lockout login, unlock oleh admin, dll)
+type AuditLog struct {
+	ID          int                    `json:"id"`
+	ActorUserID *int                   `json:"actor_user_id"`
+	Action      string                 `json:"action"`
+	Target      string                 `json:"target"`
+	IP          string                 `json:"ip"`
+	Detail      map[string]interface{} `json:"detail"`
+	CreatedAt   time.Time              `json:"created_at"`
+}
+
+// LoginAttempt status percobaan login gagal per akun atau per IP
+type LoginAttempt struct {
+	Scope         string     `json:"scope"` // "account" atau "ip"
+	Key           string     `json:"key"`
+	Failures      int        `json:"failures"`
+	LastFailureAt time.Time  `json:"last_failure_at"`
+	LockedUntil   *time.Time `json:"locked_until"`
+}
+
+// Nama aksi audit log
+const (
+	AuditLoginAccountLocked   = "login.account_locked"
+	AuditLoginIPLocked        = "login.ip_locked"
+	AuditLoginAccountUnlocked = "login.account_unlocked"
+	AuditRoleCreated          = "role.created"
+	AuditRoleUpdated          = "role.updated"
+	AuditRoleDeleted          = "role.deleted"
+	AuditUserRoleChanged      = "user.role_changed"
+	AuditUserCreated          = "user.created"
+	AuditUserEnabled          = "user.enabled"
+	AuditUserDisabled         = "user.disabled"
+	AuditUserAlumniLinked     = "user.alumni_linked"
+	AuditUserAlumniUnlinked   = "user.alumni_unlinked"
+	AuditRegistrationApproved = "registration.approved"
+	AuditRegistrationRejected = "registration.rejected"
+	AuditRosterImported       = "roster.imported"
+	AuditAlumniImported       = "alumni.imported"
+	AuditUserPasswordReset    = "user.password_reset"
+	AuditFilesReconciled      = "files.reconciled"
+)
@@ -12,6 +12,9 @@ type User struct {
 	Email     string    `json:"email"`
 	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
+	MustChangePassword bool `json:"must_change_password"`
+	TOTPEnabled bool `json:"totp_enabled"`
+	IsActive bool `json:"is_active"`
 	PasswordHash string `json:"-"`
 }
 
@@ -23,14 +26,60 @@ type LoginRequest struct {
 
 // Untuk response login
 type LoginResponse struct {
-	User  User   `json:"user"`
-	Token string `json:"token"`
+	User         User      `json:"user"`
+	Token        string    `json:"token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	RefreshToken string    `json:"refresh_token"`
+}
+
+// Untuk request refresh token
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" example:"3q2-7w..."`
+}
+
+// RefreshToken disimpan di tabel refresh_tokens (yang disimpan hanya hash-nya).
+// Semua token hasil rotasi dari satu login berbagi FamilyID yang sama.
+type RefreshToken struct {
+	ID        int        `json:"id"`
+	UserID    int        `json:"user_id"`
+	TokenHash string     `json:"-"`
+	FamilyID  string     `json:"family_id"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Untuk ganti password sendiri
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" example:"123456"`
+	NewPassword string `json:"new_password" example:"passwordBaru123"`
+}
+
+// Untuk reset password memakai token dari email
+type ResetPasswordRequest struct {
+	Token       string `json:"token" example:"Zm9vYmFy..."`
+	NewPassword string `json:"new_password" example:"passwordBaru123"`
+}
+
+// PasswordResetToken token sekali pakai untuk reset password (yang disimpan hanya hash-nya)
+type PasswordResetToken struct {
+	ID        int        `json:"id"`
+	UserID    int        `json:"user_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedBy int        `json:"created_by"`
+	CreatedAt time.Time  `json:"created_at"`
 }
 
 // JWTClaims dipakai di JWT
 type JWTClaims struct {
-	UserID   int    `json:"user_id"`
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	UserID    int    `json:"user_id"`
+	Username  string `json:"username"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid"`
+	MustChangePassword bool `json:"mcp"`
+	MFAEnrollRequired bool `json:"mfa_enroll"`
 	jwt.RegisteredClaims
 }
@@ -0,0 +1,15 @@
+package model
+
+// ErrorBody isi envelope error; details opsional (mis. daftar FieldError untuk 422)
+type ErrorBody struct {
+	Code      string      `json:"code" example:"NOT_FOUND"`
+	Message   string      `json:"message" example:"Alumni tidak ditemukan"`
+	Details   interface{} `json:"details,omitempty"`
+	RequestID string      `json:"request_id" example:"3f0c8a52-6f1e-4d7b-9a57-0d3c5b1e2a44"`
+}
+
+// ErrorResponse envelope seragam untuk semua respon gagal (4xx / 5xx)
+type ErrorResponse struct {
+	Success bool      `json:"success" example:"false"`
+	Error   ErrorBody `json:"error"`
+}
@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// UserTOTP data TOTP milik user (secret tidak pernah dikirim ke client setelah enrollment)
+type UserTOTP struct {
+	UserID      int    `json:"user_id"`
+	Secret      string `json:"-"`
+	Enabled     bool   `json:"enabled"`
+	LastCounter int64  `json:"-"`
+}
+
+// Response login ketika user wajib memasukkan kode 2FA
+type MFAChallengeResponse struct {
+	MFARequired bool      `json:"mfa_required" example:"true"`
+	MFAToken    string    `json:"mfa_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// Request langkah kedua login: kode TOTP atau salah satu recovery code
+type MFALoginRequest struct {
+	MFAToken     string `json:"mfa_token"`
+	Code         string `json:"code" example:"123456"`
+	RecoveryCode string `json:"recovery_code" example:"abcde-fghij"`
+}
+
+// Response enrollment TOTP
+type TOTPEnrollResponse struct {
+	Secret          string `json:"secret" example:"JBSWY3DPEHPK3PXP"`
+	ProvisioningURI string `json:"provisioning_uri" example:"otpauth://totp/Alumni%20Portal:admin?secret=JBSWY3DPEHPK3PXP&issuer=Alumni+Portal"`
+}
+
+// Request yang butuh konfirmasi kode TOTP
+type TOTPCodeRequest struct {
+	Code string `json:"code" example:"123456"`
+}
+
+// Response verifikasi TOTP: recovery code hanya ditampilkan sekali
+type TOTPVerifyResponse struct {
+	RecoveryCodes []string       `json:"recovery_codes"`
+	Tokens        *LoginResponse `json:"tokens,omitempty"`
+}
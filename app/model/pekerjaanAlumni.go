@@ -16,32 +16,33 @@ type PekerjaanAlumni struct {
 	DeskripsiPekerjaan string    `json:"deskripsi_pekerjaan"`
 	CreatedAt         time.Time `json:"created_at"`
 	UpdatedAt         time.Time `json:"updated_at"`
+	Version           int       `json:"version"` // naik setiap update, dikirim juga sebagai ETag
 }
 
 // Request untuk CREATE pekerjaan
 type CreatePekerjaanRequest struct {
-    AlumniID            int     `json:"alumni_id"`
-    NamaPerusahaan      string  `json:"nama_perusahaan"`
-    PosisiJabatan       string  `json:"posisi_jabatan"`
-    BidangIndustri      string  `json:"bidang_industri"`
-    LokasiKerja         string  `json:"lokasi_kerja"`
-    GajiRange           string  `json:"gaji_range"`
-    TanggalMulaiKerja   string  `json:"tanggal_mulai_kerja"`   // string → biar gampang diparse
-    TanggalSelesaiKerja *string `json:"tanggal_selesai_kerja"` // nullable
-    StatusPekerjaan     string  `json:"status_pekerjaan"`
+    AlumniID            int     `json:"alumni_id" validate:"required"`
+    NamaPerusahaan      string  `json:"nama_perusahaan" validate:"required,max=100"`
+    PosisiJabatan       string  `json:"posisi_jabatan" validate:"required,max=100"`
+    BidangIndustri      string  `json:"bidang_industri" validate:"max=50"`
+    LokasiKerja         string  `json:"lokasi_kerja" validate:"max=100"`
+    GajiRange           string  `json:"gaji_range" validate:"max=50"`
+    TanggalMulaiKerja   string  `json:"tanggal_mulai_kerja" validate:"required,date"`   // string → biar gampang diparse
+    TanggalSelesaiKerja *string `json:"tanggal_selesai_kerja" validate:"date,gtefield=tanggal_mulai_kerja"` // nullable
+    StatusPekerjaan     string  `json:"status_pekerjaan" validate:"oneof=aktif selesai resign"`
     DeskripsiPekerjaan  string  `json:"deskripsi_pekerjaan"`
 }
 
 // Request untuk UPDATE pekerjaan
 type UpdatePekerjaanRequest struct {
-    NamaPerusahaan      string  `json:"nama_perusahaan"`
-    PosisiJabatan       string  `json:"posisi_jabatan"`
-    BidangIndustri      string  `json:"bidang_industri"`
-    LokasiKerja         string  `json:"lokasi_kerja"`
-    GajiRange           string  `json:"gaji_range"`
-    TanggalMulaiKerja   string  `json:"tanggal_mulai_kerja"`
-    TanggalSelesaiKerja *string `json:"tanggal_selesai_kerja"`
-    StatusPekerjaan     string  `json:"status_pekerjaan"`
+    NamaPerusahaan      string  `json:"nama_perusahaan" validate:"required,max=100"`
+    PosisiJabatan       string  `json:"posisi_jabatan" validate:"required,max=100"`
+    BidangIndustri      string  `json:"bidang_industri" validate:"max=50"`
+    LokasiKerja         string  `json:"lokasi_kerja" validate:"max=100"`
+    GajiRange           string  `json:"gaji_range" validate:"max=50"`
+    TanggalMulaiKerja   string  `json:"tanggal_mulai_kerja" validate:"required,date"`
+    TanggalSelesaiKerja *string `json:"tanggal_selesai_kerja" validate:"date,gtefield=tanggal_mulai_kerja"`
+    StatusPekerjaan     string  `json:"status_pekerjaan" validate:"oneof=aktif selesai resign"`
     DeskripsiPekerjaan  string  `json:"deskripsi_pekerjaan"`
 }
 
@@ -62,3 +63,16 @@ type PekerjaanAlumniTrashed struct {
 	CreatedAt         time.Time `json:"created_at"`
 	UpdatedAt         time.Time `json:"updated_at"`
 }
+
+// Request alumni menambah / mengubah pekerjaannya sendiri (alumni_id diambil dari akun login)
+type MyPekerjaanRequest struct {
+    NamaPerusahaan      string  `json:"nama_perusahaan" example:"PT Maju Jaya" validate:"required,max=100"`
+    PosisiJabatan       string  `json:"posisi_jabatan" example:"Backend Engineer" validate:"required,max=100"`
+    BidangIndustri      string  `json:"bidang_industri" example:"Teknologi" validate:"max=50"`
+    LokasiKerja         string  `json:"lokasi_kerja" example:"Jakarta" validate:"max=100"`
+    GajiRange           string  `json:"gaji_range" example:"10-15 juta" validate:"max=50"`
+    TanggalMulaiKerja   string  `json:"tanggal_mulai_kerja" example:"2023-08-01" validate:"required,date"`
+    TanggalSelesaiKerja *string `json:"tanggal_selesai_kerja" validate:"date,gtefield=tanggal_mulai_kerja"`
+    StatusPekerjaan     string  `json:"status_pekerjaan" example:"aktif" validate:"oneof=aktif selesai resign"`
+    DeskripsiPekerjaan  string  `json:"deskripsi_pekerjaan" example:"Mengembangkan API"`
+}
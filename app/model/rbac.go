@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+// Kode permission. Role dan pemetaan role → permission disimpan di database,
+// jadi role baru (mis. "operator_prodi", "dosen") bisa dibuat tanpa ubah kode.
+const (
+	PermAlumniRead       = "alumni:read"
+	PermAlumniWrite      = "alumni:write"
+	PermAlumniDelete     = "alumni:delete"      // soft delete / restore / lihat trash alumni
+	PermAlumniHardDelete = "alumni:hard_delete" // hapus permanen alumni beserta akun user-nya
+
+	PermPekerjaanRead       = "pekerjaan:read"
+	PermPekerjaanWrite      = "pekerjaan:write"
+	PermPekerjaanDelete     = "pekerjaan:delete"
+	PermPekerjaanManageAll  = "pekerjaan:manage_all"  // soft delete / restore / lihat trash milik semua alumni
+	PermPekerjaanHardDelete = "pekerjaan:hard_delete" // hapus permanen pekerjaan milik semua alumni
+
+	PermFilesReadAll   = "files:read_all"
+	PermFilesManageAll = "files:manage_all" // upload untuk user lain & hapus file milik user lain
+
+	PermUsersManage         = "users:manage"
+	PermRolesManage         = "roles:manage"
+	PermRegistrationsManage = "registrations:manage" // roster lulusan + approve / reject pendaftaran
+)
+
+// Role bawaan yang tidak boleh dihapus
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+)
+
+type Role struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	IsSystem    bool      `json:"is_system"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Permission struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// Request untuk membuat / mengubah role
+type RoleRequest struct {
+	Name        string   `json:"name" example:"operator_prodi"`
+	Description string   `json:"description" example:"Operator program studi"`
+	Permissions []string `json:"permissions" example:"alumni:read,alumni:write"`
+}
+
+// Request untuk mengganti role user
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" example:"operator_prodi"`
+}
+
+// ActiveSession hasil pengecekan sesi di AuthRequired (role dibaca ulang dari DB
+// supaya perubahan role langsung berlaku tanpa menunggu token kadaluarsa)
+type ActiveSession struct {
+	UserID   int
+	Role     string
+	IsActive bool
+	AlumniID int // 0 kalau user belum terhubung ke data alumni
+}
@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// Status pendaftaran mandiri alumni
+const (
+	RegistrationPendingVerification = "pending_verification" // menunggu klik link verifikasi email
+	RegistrationPendingApproval     = "pending_approval"     // email terverifikasi, menunggu admin
+	RegistrationApproved            = "approved"
+	RegistrationRejected            = "rejected"
+)
+
+// GraduateRoster data lulusan resmi (diimport admin) untuk mencocokkan NIM pendaftar
+type GraduateRoster struct {
+	NIM        string    `json:"nim"`
+	Nama       string    `json:"nama"`
+	Jurusan    string    `json:"jurusan"`
+	Angkatan   int       `json:"angkatan"`
+	TahunLulus int       `json:"tahun_lulus"`
+	ImportedAt time.Time `json:"imported_at"`
+}
+
+// Registration pendaftaran mandiri alumni
+type Registration struct {
+	ID              int        `json:"id"`
+	NIM             string     `json:"nim"`
+	Nama            string     `json:"nama"`
+	Email           string     `json:"email"`
+	Status          string     `json:"status"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	ReviewedBy      *int       `json:"reviewed_by"`
+	ReviewedAt      *time.Time `json:"reviewed_at"`
+	RejectReason    string     `json:"reject_reason"`
+	AlumniID        *int       `json:"alumni_id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// Request pendaftaran (publik)
+type RegisterRequest struct {
+	NIM   string `json:"nim" example:"12345678"`
+	Nama  string `json:"nama" example:"John Doe"`
+	Email string `json:"email" example:"john@example.com"`
+}
+
+// Request verifikasi email pendaftaran
+type VerifyRegistrationRequest struct {
+	Token string `json:"token" example:"Zm9vYmFy..."`
+}
+
+// Request penolakan pendaftaran oleh admin
+type RejectRegistrationRequest struct {
+	Reason string `json:"reason" example:"NIM tidak sesuai dengan data kelulusan"`
+}
+
+// RosterImportResult ringkasan import roster lulusan
+type RosterImportResult struct {
+	Imported int      `json:"imported"`
+	Skipped  int      `json:"skipped"`
+	Errors   []string `json:"errors"`
+}
@@ -12,6 +12,26 @@ type MetaInfo struct {
 	SortBy string `json:"sortBy"`
 	Order string `json:"order"`
 	Search string `json:"search"`
+	Sort []SortField `json:"sort,omitempty"` // urutan lengkap (multi kolom)
+	Filters map[string]string `json:"filters,omitempty"` // filter yang dipakai
+}
+
+// CursorMeta meta untuk mode cursor pagination (tanpa total / jumlah halaman)
+type CursorMeta struct {
+	Limit int `json:"limit"`
+	NextCursor *string `json:"next_cursor"` // null = tidak ada halaman berikutnya
+	PrevCursor *string `json:"prev_cursor"` // null = halaman pertama
+	SortBy string `json:"sortBy"`
+	Order string `json:"order"`
+	Search string `json:"search"`
+	Sort []SortField `json:"sort,omitempty"`
+	Filters map[string]string `json:"filters,omitempty"`
+}
+
+// SortField satu kolom pengurutan list
+type SortField struct {
+	Column string `json:"column" example:"angkatan"`
+	Order string `json:"order" example:"desc"`
 }
 
 type AlumniResponse struct {
@@ -0,0 +1,23 @@
+package model
+
+// Tipe hasil pencarian
+const (
+	SearchTypeAlumni    = "alumni"
+	SearchTypePekerjaan = "pekerjaan"
+)
+
+// SearchResult satu hasil pencarian gabungan alumni + pekerjaan
+type SearchResult struct {
+	Type      string  `json:"type" example:"alumni"` // alumni / pekerjaan
+	ID        int     `json:"id" example:"12"`
+	AlumniID  int     `json:"alumni_id" example:"12"`
+	Title     string  `json:"title" example:"Budi Santoso"`
+	Subtitle  string  `json:"subtitle" example:"2101 · Teknik Informatika"`
+	Highlight string  `json:"highlight" example:"<mark>Budi</mark> Santoso 2101 Teknik Informatika"` // HTML aman, potongan cocok dibungkus <mark>
+	Score     float64 `json:"score" example:"0.87"`
+}
+
+type SearchResponse struct {
+	Data []SearchResult `json:"data"`
+	Meta MetaInfo       `json:"meta"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+// UserDetail data user untuk endpoint manajemen user (tanpa password)
+type UserDetail struct {
+	ID                 int       `json:"id"`
+	Username           string    `json:"username"`
+	Email              string    `json:"email"`
+	Role               string    `json:"role"`
+	IsActive           bool      `json:"is_active"`
+	MustChangePassword bool      `json:"must_change_password"`
+	TOTPEnabled        bool      `json:"totp_enabled"`
+	AlumniID           *int      `json:"alumni_id"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+type UserResponse struct {
+	Data []UserDetail `json:"data"`
+	Meta MetaInfo     `json:"meta"`
+}
+
+// UserFilter filter daftar user
+type UserFilter struct {
+	Search   string
+	Role     string
+	IsActive *bool
+}
+
+// Request membuat user baru oleh admin
+type CreateUserRequest struct {
+	Username string `json:"username" example:"operator1"`
+	Email    string `json:"email" example:"operator1@example.com"`
+	Password string `json:"password" example:"passwordAwal123"`
+	Role     string `json:"role" example:"user"`
+}
+
+// Request mengaktifkan / menonaktifkan user
+type UpdateUserStatusRequest struct {
+	IsActive bool `json:"is_active" example:"false"`
+}
+
+// Request menghubungkan user dengan data alumni
+type LinkAlumniRequest struct {
+	AlumniID int `json:"alumni_id" example:"1"`
+}
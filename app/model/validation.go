@@ -0,0 +1,10 @@
+package model
+
+// FieldError satu field request yang tidak lolos validasi (field = nama json).
+// Message dalam bahasa default; Key + Args dipakai untuk menerjemahkan ulang.
+type FieldError struct {
+	Field   string        `json:"field" example:"email"`
+	Message string        `json:"message" example:"format email tidak valid"`
+	Key     string        `json:"-"`
+	Args    []interface{} `json:"-"`
+}
@@ -15,4 +15,5 @@ type File struct {
 	FileType     string             `json:"file_type" bson:"file_type"`
 	FileCategory string             `json:"file_category" bson:"file_category"` 
 	UploadedAt   time.Time          `json:"uploaded_at" bson:"uploaded_at"`
+	IsDeleted    bool               `json:"is_deleted" bson:"is_deleted"` // ikut di-trash bersama alumni pemiliknya
 }
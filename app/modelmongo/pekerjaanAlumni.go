@@ -22,6 +22,7 @@ type PekerjaanAlumni struct {
 	CreatedAt           time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt           time.Time          `bson:"updated_at" json:"updated_at"`
 	IsDeleted           bool               `bson:"is_deleted" json:"is_deleted"`
+	DeletedWithAlumni   bool               `bson:"deleted_with_alumni,omitempty" json:"-"` // ikut di-trash bersama alumninya
 }
 
 // TrashPekerjaan represents soft-deleted pekerjaan with deleted timestamp
@@ -46,27 +47,27 @@ type TrashPekerjaanAlumni struct {
 
 // CreatePekerjaanRequest used when adding new job data
 type CreatePekerjaanRequest struct {
-	AlumniID            int    `json:"alumni_id"`
-	NamaPerusahaan      string `json:"nama_perusahaan"`
-	PosisiJabatan       string `json:"posisi_jabatan"`
-	BidangIndustri      string `json:"bidang_industri"`
-	LokasiKerja         string `json:"lokasi_kerja"`
-	GajiRange           string `json:"gaji_range"`
-	TanggalMulaiKerja   string `json:"tanggal_mulai_kerja"`   // ISO8601 expected, optional
-	TanggalSelesaiKerja string `json:"tanggal_selesai_kerja"` // ISO8601 expected, optional
-	StatusPekerjaan     string `json:"status_pekerjaan"`
+	AlumniID            int    `json:"alumni_id" validate:"required"`
+	NamaPerusahaan      string `json:"nama_perusahaan" validate:"required,max=100"`
+	PosisiJabatan       string `json:"posisi_jabatan" validate:"required,max=100"`
+	BidangIndustri      string `json:"bidang_industri" validate:"max=50"`
+	LokasiKerja         string `json:"lokasi_kerja" validate:"max=100"`
+	GajiRange           string `json:"gaji_range" validate:"max=50"`
+	TanggalMulaiKerja   string `json:"tanggal_mulai_kerja" validate:"date"`                                // YYYY-MM-DD, optional
+	TanggalSelesaiKerja string `json:"tanggal_selesai_kerja" validate:"date,gtefield=tanggal_mulai_kerja"` // YYYY-MM-DD, optional
+	StatusPekerjaan     string `json:"status_pekerjaan" validate:"oneof=aktif selesai resign"`
 	DeskripsiPekerjaan  string `json:"deskripsi_pekerjaan"`
 }
 
 // UpdatePekerjaanRequest used when modifying existing job data
 type UpdatePekerjaanRequest struct {
-	NamaPerusahaan      *string `json:"nama_perusahaan"`
-	PosisiJabatan       *string `json:"posisi_jabatan"`
-	BidangIndustri      *string `json:"bidang_industri"`
-	LokasiKerja         *string `json:"lokasi_kerja"`
-	GajiRange           *string `json:"gaji_range"`
-	TanggalMulaiKerja   *string `json:"tanggal_mulai_kerja"`   // optional ISO8601
-	TanggalSelesaiKerja *string `json:"tanggal_selesai_kerja"` // optional ISO8601
-	StatusPekerjaan     *string `json:"status_pekerjaan"`
+	NamaPerusahaan      *string `json:"nama_perusahaan" validate:"notblank,max=100"`
+	PosisiJabatan       *string `json:"posisi_jabatan" validate:"notblank,max=100"`
+	BidangIndustri      *string `json:"bidang_industri" validate:"max=50"`
+	LokasiKerja         *string `json:"lokasi_kerja" validate:"max=100"`
+	GajiRange           *string `json:"gaji_range" validate:"max=50"`
+	TanggalMulaiKerja   *string `json:"tanggal_mulai_kerja" validate:"date"`                                // optional YYYY-MM-DD
+	TanggalSelesaiKerja *string `json:"tanggal_selesai_kerja" validate:"date,gtefield=tanggal_mulai_kerja"` // optional YYYY-MM-DD
+	StatusPekerjaan     *string `json:"status_pekerjaan" validate:"oneof=aktif selesai resign"`
 	DeskripsiPekerjaan  *string `json:"deskripsi_pekerjaan"`
 }
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"backendgo/apperror"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ErrImportReportNotFound laporan error import tidak ada (atau id bukan UUID)
+var ErrImportReportNotFound = apperror.NotFound("import.report_not_found")
+
+// AlumniImportRepository upsert alumni massal dari spreadsheet dan laporan error-nya
+type AlumniImportRepository interface {
+	GetAlumniIDsByNIM(nims []string) (map[string]int, error)
+	GetTakenUserIdentities(usernames, emails []string) (takenUsernames, takenEmails map[string]bool, err error)
+	Import(rows []model.AlumniImportRow) (created, updated int, err error)
+	CreateReport(createdBy *int, filename string, content []byte) (string, error)
+	GetReport(id string) (*model.AlumniImportReport, error)
+}
+
+type alumniImportRepository struct {
+	db *sql.DB
+}
+
+func NewAlumniImportRepository(db *sql.DB) AlumniImportRepository {
+	return &alumniImportRepository{db: db}
+}
+
+// AlumniImportError baris import yang gagal disimpan; seluruh transaksi dibatalkan
+type AlumniImportError struct {
+	Row int
+	NIM string
+	Err error
+}
+
+func (e *AlumniImportError) Error() string {
+	return fmt.Sprintf("baris %d (NIM %s): %v", e.Row, e.NIM, e.Err)
+}
+
+func (e *AlumniImportError) Unwrap() error { return e.Err }
+
+// ===================================================
+// 🔹 Cari alumni yang sudah ada berdasarkan daftar NIM
+// ===================================================
+func (r *alumniImportRepository) GetAlumniIDsByNIM(nims []string) (map[string]int, error) {
+	rows, err := r.db.Query(`SELECT nim, id FROM alumni WHERE nim = ANY($1)`, pq.Array(nims))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := map[string]int{}
+	for rows.Next() {
+		var nim string
+		var id int
+		if err := rows.Scan(&nim, &id); err != nil {
+			return nil, err
+		}
+		ids[nim] = id
+	}
+	return ids, rows.Err()
+}
+
+// ===================================================
+// 🔹 Username / email user yang sudah dipakai (dari daftar kandidat)
+// ===================================================
+func (r *alumniImportRepository) GetTakenUserIdentities(usernames, emails []string) (takenUsernames, takenEmails map[string]bool, err error) {
+	rows, err := r.db.Query(`
+		SELECT username, email FROM users
+		WHERE username = ANY($1) OR email = ANY($2)
+	`, pq.Array(usernames), pq.Array(emails))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	takenUsernames, takenEmails = map[string]bool{}, map[string]bool{}
+	for rows.Next() {
+		var username, email string
+		if err := rows.Scan(&username, &email); err != nil {
+			return nil, nil, err
+		}
+		takenUsernames[username] = true
+		takenEmails[email] = true
+	}
+	return takenUsernames, takenEmails, rows.Err()
+}
+
+// ===================================================
+// 🔹 Import alumni (upsert berdasarkan NIM, satu transaksi)
+// ===================================================
+// Alumni baru dibuat lewat createAlumniTx (ikut membuat akun user), alumni dengan NIM
+// yang sudah ada diperbarui datanya. Satu baris gagal → seluruh import dibatalkan.
+func (r *alumniImportRepository) Import(rows []model.AlumniImportRow) (created, updated int, err error) {
+	ctx := context.Background()
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	for _, row := range rows {
+		a := row.Data
+		res, err := tx.ExecContext(ctx, `
+			UPDATE alumni SET
+				nama = $2, jurusan = $3, angkatan = $4, tahun_lulus = $5, email = $6,
+				no_telepon = $7, alamat = $8, status_kematian = $9, updated_at = NOW()
+			WHERE nim = $1
+		`, a.NIM, a.Nama, a.Jurusan, a.Angkatan, a.TahunLulus, a.Email,
+			a.NoTelepon, a.Alamat, a.StatusKematian)
+		if err != nil {
+			return 0, 0, &AlumniImportError{Row: row.Row, NIM: a.NIM, Err: err}
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			updated++
+			continue
+		}
+
+		if _, err := createAlumniTx(ctx, tx, a); err != nil {
+			return 0, 0, &AlumniImportError{Row: row.Row, NIM: a.NIM, Err: err}
+		}
+		created++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+	return created, updated, nil
+}
+
+// ===================================================
+// 🔹 Simpan & ambil laporan error import
+// ===================================================
+func (r *alumniImportRepository) CreateReport(createdBy *int, filename string, content []byte) (string, error) {
+	id := uuid.New().String()
+	_, err := r.db.Exec(`
+		INSERT INTO alumni_import_reports (id, created_by, filename, content, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+	`, id, createdBy, filename, content)
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (r *alumniImportRepository) GetReport(id string) (*model.AlumniImportReport, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrImportReportNotFound
+	}
+	var report model.AlumniImportReport
+	err := r.db.QueryRow(`
+		SELECT id, COALESCE(created_by, 0), filename, content, created_at
+		FROM alumni_import_reports
+		WHERE id = $1
+	`, id).Scan(&report.ID, &report.CreatedBy, &report.Filename, &report.Content, &report.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrImportReportNotFound
+		}
+		return nil, err
+	}
+	return &report, nil
+}
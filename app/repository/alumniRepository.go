@@ -2,7 +2,9 @@ package repository
 
 import (
 	"backendgo/app/model"
-	"backendgo/database"
+	"backendgo/apperror"
+	"backendgo/config"
+	"backendgo/utils"
 	"context"
 	"database/sql"
 	"fmt"
@@ -11,14 +13,53 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// ErrAlumniNotTrashed restore / hard delete hanya untuk alumni yang ada di trash
+var ErrAlumniNotTrashed = apperror.NotFound("alumni.not_trashed")
+
+// AlumniRepository akses data tabel alumni. Update menerima versi data yang terakhir
+// dibaca client (0 = tanpa cek) dan mengembalikan ErrVersionConflict kalau sudah berubah.
+// Alumni di trash (is_deleted) tidak terlihat di method selain GetTrashed*, Restore,
+// dan HardDelete; SoftDelete ikut men-trash pekerjaannya, HardDelete ikut menghapus
+// akun user-nya (pekerjaan terhapus lewat ON DELETE CASCADE).
+type AlumniRepository interface {
+	GetAll() ([]model.Alumni, error)
+	GetByID(id int) (model.Alumni, error)
+	Create(a model.CreateAlumniRequest) (model.Alumni, error)
+	Update(a model.UpdateAlumniRequest, version int) (model.Alumni, error)
+	SoftDelete(id int) error
+	Restore(id int) error
+	HardDelete(id int) (deleted model.Alumni, userDeleted bool, err error)
+	GetTrashed() ([]model.AlumniTrashed, error)
+	GetTrashedByID(id int) (model.AlumniTrashed, error)
+	UpdateStatusKematian(id int, status bool) error
+	UpdateContact(id int, req model.UpdateMyAlumniRequest) (model.Alumni, error)
+	List(q ListQuery, limit, offset int) ([]model.Alumni, error)
+	Count(q ListQuery) (int, error)
+	ListCursor(q ListQuery, cur *utils.Cursor, limit int) ([]model.Alumni, utils.CursorPage, error)
+	Stream(q ListQuery, fn func(model.Alumni) error) error
+}
+
+// activeAlumni dipakai di FROM query list / count / cursor / stream sebagai pengganti
+// tabel alumni, supaya WHERE dari ListQuery tetap bisa ditempel apa adanya
+const activeAlumni = `(SELECT * FROM alumni WHERE is_deleted = FALSE) AS alumni`
+
+type alumniRepository struct {
+	db *sql.DB
+}
+
+func NewAlumniRepository(db *sql.DB) AlumniRepository {
+	return &alumniRepository{db: db}
+}
+
 // ===================================================
 // 🔹 Get All Alumni
 // ===================================================
-func GetAllAlumni() ([]model.Alumni, error) {
-	rows, err := database.DB.Query(`
-		SELECT id, user_id, nim, nama, jurusan, angkatan, tahun_lulus, email, 
-		       no_telepon, alamat, status_kematian, created_at, updated_at
+func (r *alumniRepository) GetAll() ([]model.Alumni, error) {
+	rows, err := r.db.Query(`
+		SELECT id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus, email, 
+		       no_telepon, alamat, status_kematian, created_at, updated_at, version
 		FROM alumni 
+		WHERE is_deleted = FALSE
 		ORDER BY created_at DESC
 	`)
 	if err != nil {
@@ -32,7 +73,7 @@ func GetAllAlumni() ([]model.Alumni, error) {
 		if err := rows.Scan(
 			&a.ID, &a.UserID, &a.NIM, &a.Nama, &a.Jurusan,
 			&a.Angkatan, &a.TahunLulus, &a.Email, &a.NoTelepon,
-			&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt,
+			&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt, &a.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -44,46 +85,60 @@ func GetAllAlumni() ([]model.Alumni, error) {
 // ===================================================
 // 🔹 Get Alumni by ID
 // ===================================================
-func GetAlumniByID(id int) (model.Alumni, error) {
+func (r *alumniRepository) GetByID(id int) (model.Alumni, error) {
 	var a model.Alumni
-	err := database.DB.QueryRow(`
-		SELECT id, user_id, nim, nama, jurusan, angkatan, tahun_lulus, email, 
-		       no_telepon, alamat, status_kematian, created_at, updated_at
+	err := r.db.QueryRow(`
+		SELECT id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus, email, 
+		       no_telepon, alamat, status_kematian, created_at, updated_at, version
 		FROM alumni 
-		WHERE id=$1
+		WHERE id=$1 AND is_deleted = FALSE
 	`, id).Scan(
 		&a.ID, &a.UserID, &a.NIM, &a.Nama, &a.Jurusan,
 		&a.Angkatan, &a.TahunLulus, &a.Email, &a.NoTelepon,
-		&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt,
+		&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt, &a.Version,
 	)
+	if err == sql.ErrNoRows {
+		return a, ErrAlumniNotFound
+	}
 	return a, err
 }
 
 // ===================================================
 // 🔹 Create Alumni
 // ===================================================
-func CreateAlumni(a model.CreateAlumniRequest) (model.Alumni, error) {
+func (r *alumniRepository) Create(a model.CreateAlumniRequest) (model.Alumni, error) {
 	ctx := context.Background()
-	tx, err := database.DB.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return model.Alumni{}, err
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		}
-	}()
+	defer tx.Rollback()
+
+	newAlumni, err := createAlumniTx(ctx, tx, a)
+	if err != nil {
+		return model.Alumni{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.Alumni{}, err
+	}
+	return newAlumni, nil
+}
 
-	// 1️⃣ Buat user otomatis
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte("123456"), bcrypt.DefaultCost)
+// createAlumniTx buat user + alumni di dalam transaksi yang sudah ada,
+// dipakai juga oleh approval pendaftaran dan import massal
+func createAlumniTx(ctx context.Context, tx *sql.Tx, a model.CreateAlumniRequest) (model.Alumni, error) {
+	// 1️⃣ Buat user otomatis (password awal wajib diganti saat login pertama)
+	defaultPassword := config.GetEnv("DEFAULT_ALUMNI_PASSWORD", "123456")
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(defaultPassword), bcrypt.DefaultCost)
 	if err != nil {
 		return model.Alumni{}, err
 	}
 
 	var userID int
 	err = tx.QueryRowContext(ctx, `
-		INSERT INTO users (username, email, password_hash, role, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		INSERT INTO users (username, email, password_hash, role, must_change_password, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, TRUE, NOW(), NOW())
 		RETURNING id
 	`, a.Nama, a.Email, string(passwordHash), "user").Scan(&userID)
 	if err != nil {
@@ -98,8 +153,8 @@ func CreateAlumni(a model.CreateAlumniRequest) (model.Alumni, error) {
 			no_telepon, alamat, status_kematian, created_at, updated_at
 		) VALUES (
 			$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NOW(),NOW()
-		) RETURNING id, user_id, nim, nama, jurusan, angkatan, tahun_lulus,
-		          email, no_telepon, alamat, status_kematian, created_at, updated_at
+		) RETURNING id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus,
+		          email, no_telepon, alamat, status_kematian, created_at, updated_at, version
 	`,
 		userID, a.NIM, a.Nama, a.Jurusan, a.Angkatan,
 		a.TahunLulus, a.Email, a.NoTelepon, a.Alamat, a.StatusKematian,
@@ -107,78 +162,251 @@ func CreateAlumni(a model.CreateAlumniRequest) (model.Alumni, error) {
 		&newAlumni.ID, &newAlumni.UserID, &newAlumni.NIM, &newAlumni.Nama,
 		&newAlumni.Jurusan, &newAlumni.Angkatan, &newAlumni.TahunLulus,
 		&newAlumni.Email, &newAlumni.NoTelepon, &newAlumni.Alamat,
-		&newAlumni.StatusKematian, &newAlumni.CreatedAt, &newAlumni.UpdatedAt,
+		&newAlumni.StatusKematian, &newAlumni.CreatedAt, &newAlumni.UpdatedAt, &newAlumni.Version,
 	)
 	if err != nil {
 		return model.Alumni{}, err
 	}
-
-	err = tx.Commit()
-	if err != nil {
-		return model.Alumni{}, err
-	}
-
 	return newAlumni, nil
 }
 
 // ===================================================
 // 🔹 Update Alumni
 // ===================================================
-func UpdateAlumni(a model.UpdateAlumniRequest) (model.Alumni, error) {
+func (r *alumniRepository) Update(a model.UpdateAlumniRequest, version int) (model.Alumni, error) {
 	now := time.Now()
 
-	_, err := database.DB.Exec(`
+	// version dinaikkan trigger trg_alumni_version
+	result, err := r.db.Exec(`
 		UPDATE alumni 
 		SET nim=$1, nama=$2, jurusan=$3, angkatan=$4, tahun_lulus=$5,
 		    email=$6, no_telepon=$7, alamat=$8, status_kematian=$9, updated_at=$10
-		WHERE id=$11
+		WHERE id=$11 AND is_deleted = FALSE AND ($12 = 0 OR version = $12)
 	`,
 		a.NIM, a.Nama, a.Jurusan, a.Angkatan, a.TahunLulus,
-		a.Email, a.NoTelepon, a.Alamat, a.StatusKematian, now, a.ID,
+		a.Email, a.NoTelepon, a.Alamat, a.StatusKematian, now, a.ID, version,
 	)
 	if err != nil {
 		return model.Alumni{}, err
 	}
 
-	// ambil lagi data terbaru
-	updated, err := GetAlumniByID(a.ID)
-	return updated, err
+	// ambil lagi data terbaru; tidak ada baris yang berubah = data hilang atau versi beda
+	updated, err := r.GetByID(a.ID)
+	if err != nil {
+		return model.Alumni{}, err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return updated, ErrVersionConflict
+	}
+	return updated, nil
+}
+
+// ===================================================
+// 🔹 Soft Delete Alumni (pekerjaan aktif ikut di-trash)
+// ===================================================
+func (r *alumniRepository) SoftDelete(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		UPDATE alumni
+		SET is_deleted = TRUE, deleted_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND is_deleted = FALSE
+	`, id)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrAlumniNotFound
+	}
+
+	_, err = tx.Exec(`
+		UPDATE pekerjaan_alumni
+		SET is_deleted = TRUE, deleted_with_alumni = TRUE, updated_at = NOW()
+		WHERE alumni_id = $1 AND is_deleted = FALSE
+	`, id)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ===================================================
+// 🔹 Restore Alumni (hanya pekerjaan yang ikut di-trash bersama alumni)
+// ===================================================
+func (r *alumniRepository) Restore(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		UPDATE alumni
+		SET is_deleted = FALSE, deleted_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND is_deleted = TRUE
+	`, id)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrAlumniNotTrashed
+	}
+
+	_, err = tx.Exec(`
+		UPDATE pekerjaan_alumni
+		SET is_deleted = FALSE, deleted_with_alumni = FALSE, updated_at = NOW()
+		WHERE alumni_id = $1 AND deleted_with_alumni = TRUE
+	`, id)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ===================================================
+// 🔹 Hard Delete Alumni (hanya dari trash) — akun user ikut dihapus,
+// alumni + pekerjaan terhapus lewat ON DELETE CASCADE
+// ===================================================
+// userDeleted false kalau akun yang ditautkan tetap ada (role selain user, mis. admin atau staf)
+// atau alumni belum punya akun.
+func (r *alumniRepository) HardDelete(id int) (deleted model.Alumni, userDeleted bool, err error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return model.Alumni{}, false, err
+	}
+	defer tx.Rollback()
+
+	var a model.Alumni
+	err = tx.QueryRow(`
+		SELECT id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus, email,
+		       no_telepon, alamat, status_kematian, created_at, updated_at, version
+		FROM alumni
+		WHERE id = $1 AND is_deleted = TRUE
+		FOR UPDATE
+	`, id).Scan(
+		&a.ID, &a.UserID, &a.NIM, &a.Nama, &a.Jurusan,
+		&a.Angkatan, &a.TahunLulus, &a.Email, &a.NoTelepon,
+		&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt, &a.Version,
+	)
+	if err == sql.ErrNoRows {
+		return model.Alumni{}, false, ErrAlumniNotTrashed
+	}
+	if err != nil {
+		return model.Alumni{}, false, err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM alumni WHERE id = $1`, id); err != nil {
+		return model.Alumni{}, false, err
+	}
+	// hanya akun alumni biasa (role user) yang ikut dihapus; akun admin / staf / role
+	// lain yang ditautkan ke alumni tetap ada, cukup alumninya
+	if a.UserID != 0 {
+		result, err := tx.Exec(`DELETE FROM users WHERE id = $1 AND role = $2`, a.UserID, model.RoleUser)
+		if err != nil {
+			return model.Alumni{}, false, err
+		}
+		rows, _ := result.RowsAffected()
+		userDeleted = rows > 0
+	}
+	return a, userDeleted, tx.Commit()
 }
 
 // ===================================================
-// 🔹 Delete Alumni
+// 🔹 Trash (terakhir dihapus dulu)
 // ===================================================
-func DeleteAlumni(id int) error {
-	_, err := database.DB.Exec("DELETE FROM alumni WHERE id=$1", id)
-	return err
+func (r *alumniRepository) GetTrashed() ([]model.AlumniTrashed, error) {
+	rows, err := r.db.Query(`
+		SELECT id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus, email,
+		       no_telepon, alamat, status_kematian, created_at, updated_at, version,
+		       is_deleted, deleted_at
+		FROM alumni
+		WHERE is_deleted = TRUE
+		ORDER BY deleted_at DESC, id DESC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.AlumniTrashed
+	for rows.Next() {
+		a, err := scanAlumniTrashed(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+func (r *alumniRepository) GetTrashedByID(id int) (model.AlumniTrashed, error) {
+	a, err := scanAlumniTrashed(r.db.QueryRow(`
+		SELECT id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus, email,
+		       no_telepon, alamat, status_kematian, created_at, updated_at, version,
+		       is_deleted, deleted_at
+		FROM alumni
+		WHERE id = $1 AND is_deleted = TRUE
+	`, id))
+	if err == sql.ErrNoRows {
+		return a, ErrAlumniNotTrashed
+	}
+	return a, err
+}
+
+func scanAlumniTrashed(row interface{ Scan(...interface{}) error }) (model.AlumniTrashed, error) {
+	var a model.AlumniTrashed
+	var deletedAt sql.NullTime
+	err := row.Scan(
+		&a.ID, &a.UserID, &a.NIM, &a.Nama, &a.Jurusan,
+		&a.Angkatan, &a.TahunLulus, &a.Email, &a.NoTelepon,
+		&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt, &a.Version,
+		&a.IsDeleted, &deletedAt,
+	)
+	if deletedAt.Valid {
+		t := deletedAt.Time
+		a.DeletedAt = &t
+	}
+	return a, err
 }
 
 // ===================================================
 // 🔹 Update Status Kematian
 // ===================================================
-func UpdateStatusKematian(id int, status bool) error {
-	_, err := database.DB.Exec(`
+func (r *alumniRepository) UpdateStatusKematian(id int, status bool) error {
+	result, err := r.db.Exec(`
         UPDATE alumni 
         SET status_kematian=$1, updated_at=NOW() 
-        WHERE id=$2
+        WHERE id=$2 AND is_deleted = FALSE
     `, status, id)
-	return err
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrAlumniNotFound
+	}
+	return nil
 }
 
 // ===================================================
-// 🔹 Pagination with Search
+// 🔹 Pagination with Search + filter (lihat AlumniListSpec)
 // ===================================================
-func GetAlumniRepo(search, sortBy, order string, limit, offset int) ([]model.Alumni, error) {
+func (r *alumniRepository) List(q ListQuery, limit, offset int) ([]model.Alumni, error) {
+	where, args := q.Where(nil)
+	args = append(args, limit, offset)
 	query := fmt.Sprintf(`
-        SELECT id, user_id, nim, nama, jurusan, angkatan, tahun_lulus, email, 
-               no_telepon, alamat, status_kematian, created_at, updated_at
-        FROM alumni
-        WHERE nama ILIKE $1 OR email ILIKE $1 OR jurusan ILIKE $1
-        ORDER BY %s %s
-        LIMIT $2 OFFSET $3
-    `, sortBy, order)
+		SELECT id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus, email,
+		       no_telepon, alamat, status_kematian, created_at, updated_at, version
+		FROM %s
+		%s
+		%s
+		LIMIT $%d OFFSET $%d
+	`, activeAlumni, where, q.OrderBy(), len(args)-1, len(args))
 
-	rows, err := database.DB.Query(query, "%"+search+"%", limit, offset)
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +418,7 @@ func GetAlumniRepo(search, sortBy, order string, limit, offset int) ([]model.Alu
 		if err := rows.Scan(
 			&a.ID, &a.UserID, &a.NIM, &a.Nama, &a.Jurusan,
 			&a.Angkatan, &a.TahunLulus, &a.Email, &a.NoTelepon,
-			&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt,
+			&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt, &a.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -202,12 +430,128 @@ func GetAlumniRepo(search, sortBy, order string, limit, offset int) ([]model.Alu
 // ===================================================
 // 🔹 Count total alumni (for pagination)
 // ===================================================
-func CountAlumniRepo(search string) (int, error) {
+func (r *alumniRepository) Count(q ListQuery) (int, error) {
+	where, args := q.Where(nil)
 	var total int
-	err := database.DB.QueryRow(`
-		SELECT COUNT(*) 
-		FROM alumni 
-		WHERE nama ILIKE $1 OR email ILIKE $1 OR jurusan ILIKE $1
-	`, "%"+search+"%").Scan(&total)
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM `+activeAlumni+` `+where, args...).Scan(&total)
 	return total, err
 }
+
+// ===================================================
+// 🔹 Update kontak alumni (self-service)
+// ===================================================
+func (r *alumniRepository) UpdateContact(id int, req model.UpdateMyAlumniRequest) (model.Alumni, error) {
+	_, err := r.db.Exec(`
+		UPDATE alumni
+		SET email = COALESCE($1, email),
+		    no_telepon = COALESCE($2, no_telepon),
+		    alamat = COALESCE($3, alamat),
+		    updated_at = NOW()
+		WHERE id = $4 AND is_deleted = FALSE
+	`, req.Email, req.NoTelepon, req.Alamat, id)
+	if err != nil {
+		return model.Alumni{}, err
+	}
+	return r.GetByID(id)
+}
+
+// ===================================================
+// 🔹 Stream alumni (export) — filter sama dengan pagination, tanpa LIMIT
+// ===================================================
+func (r *alumniRepository) Stream(q ListQuery, fn func(model.Alumni) error) error {
+	where, args := q.Where(nil)
+	query := fmt.Sprintf(`
+		SELECT id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus, email,
+		       no_telepon, alamat, status_kematian, created_at, updated_at, version
+		FROM %s
+		%s
+		%s
+	`, activeAlumni, where, q.OrderBy())
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a model.Alumni
+		if err := rows.Scan(
+			&a.ID, &a.UserID, &a.NIM, &a.Nama, &a.Jurusan,
+			&a.Angkatan, &a.TahunLulus, &a.Email, &a.NoTelepon,
+			&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt, &a.Version,
+		); err != nil {
+			return err
+		}
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// ===================================================
+// 🔹 Cursor (keyset) pagination
+// ===================================================
+func (r *alumniRepository) ListCursor(q ListQuery, cur *utils.Cursor, limit int) ([]model.Alumni, utils.CursorPage, error) {
+	clause, args, err := q.CursorQuery(cur, limit)
+	if err != nil {
+		return nil, utils.CursorPage{}, err
+	}
+	rows, err := r.db.Query(`
+		SELECT id, COALESCE(user_id, 0), nim, nama, jurusan, angkatan, tahun_lulus, email,
+		       no_telepon, alamat, status_kematian, created_at, updated_at, version
+		FROM `+activeAlumni+`
+		`+clause, args...)
+	if err != nil {
+		return nil, utils.CursorPage{}, err
+	}
+	defer rows.Close()
+
+	list := []model.Alumni{}
+	for rows.Next() {
+		var a model.Alumni
+		if err := rows.Scan(
+			&a.ID, &a.UserID, &a.NIM, &a.Nama, &a.Jurusan,
+			&a.Angkatan, &a.TahunLulus, &a.Email, &a.NoTelepon,
+			&a.Alamat, &a.StatusKematian, &a.CreatedAt, &a.UpdatedAt, &a.Version,
+		); err != nil {
+			return nil, utils.CursorPage{}, err
+		}
+		list = append(list, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, utils.CursorPage{}, err
+	}
+
+	list, page := utils.BuildCursorPage(list, limit, cur, q.SortKey(), func(a model.Alumni) []string {
+		return q.cursorValues(func(col string) interface{} { return AlumniColumnValue(a, col) })
+	})
+	return list, page, nil
+}
+
+// AlumniColumnValue nilai kolom AlumniListSpec (sort, search, filter) dari satu alumni
+func AlumniColumnValue(a model.Alumni, col string) interface{} {
+	switch col {
+	case "nim":
+		return a.NIM
+	case "nama":
+		return a.Nama
+	case "jurusan":
+		return a.Jurusan
+	case "angkatan":
+		return a.Angkatan
+	case "tahun_lulus":
+		return a.TahunLulus
+	case "email":
+		return a.Email
+	case "status_kematian":
+		return a.StatusKematian
+	case "created_at":
+		return a.CreatedAt
+	case "updated_at":
+		return a.UpdatedAt
+	default:
+		return a.ID
+	}
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"database/sql"
+	"encoding/json"
+)
+
+// AuditRepository menulis tabel audit_logs
+type AuditRepository interface {
+	Create(entry model.AuditLog) error
+}
+
+type auditRepository struct {
+	db *sql.DB
+}
+
+func NewAuditRepository(db *sql.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+// ===================================================
+// 🔹 Simpan audit log
+// ===================================================
+func (r *auditRepository) Create(entry model.AuditLog) error {
+	detail, err := json.Marshal(entry.Detail)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(`
+		INSERT INTO audit_logs (actor_user_id, action, target, ip, detail, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+	`, entry.ActorUserID, entry.Action, entry.Target, entry.IP, detail)
+	return err
+}
@@ -0,0 +1,421 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"backendgo/apperror"
+	"backendgo/utils"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilterKind tipe nilai filter list
+type FilterKind int
+
+const (
+	FilterText FilterKind = iota // sama persis, tidak peka huruf besar/kecil
+	FilterInt
+	FilterBool
+	FilterDate // format YYYY-MM-DD
+)
+
+// FilterSpec satu query param filter yang diizinkan untuk sebuah list
+type FilterSpec struct {
+	Param  string // nama query param, mis. "angkatan_min"
+	Column string // kolom SQL
+	Kind   FilterKind
+	Op     string // "=", ">=", "<="
+}
+
+// ListSpec whitelist kolom sort, kolom pencarian, dan filter untuk satu resource.
+// Hanya kolom di sini yang pernah masuk ke SQL; nilai dari user selalu lewat placeholder.
+type ListSpec struct {
+	SortColumns   map[string]string // nama di API → kolom SQL
+	DefaultSort   []model.SortField
+	TieBreaker    string // kolom unik untuk urutan stabil, mis. "id"
+	SearchColumns []string
+	Filters       []FilterSpec
+	Nullable      []string                    // kolom sort yang bisa NULL → tidak bisa dipakai cursor pagination
+	ColumnKinds   map[string]utils.CursorKind // tipe kolom sort untuk nilai cursor, selain itu teks
+}
+
+// ListQuery parameter list yang sudah divalidasi terhadap ListSpec
+type ListQuery struct {
+	Search  string
+	Sort    []model.SortField
+	Filters map[string]string // filter yang dipakai, dikembalikan di meta
+	spec    *ListSpec
+	conds   []listCondition
+}
+
+type listCondition struct {
+	column string
+	op     string
+	kind   FilterKind
+	value  interface{}
+}
+
+const maxSortFields = 3
+
+var (
+	AlumniListSpec = &ListSpec{
+		SortColumns: columnSet(
+			"id", "nim", "nama", "jurusan", "angkatan", "tahun_lulus",
+			"email", "status_kematian", "created_at", "updated_at",
+		),
+		DefaultSort:   []model.SortField{{Column: "id", Order: "asc"}},
+		TieBreaker:    "id",
+		SearchColumns: []string{"nama", "email", "jurusan"},
+		ColumnKinds: map[string]utils.CursorKind{
+			"id": utils.CursorInt, "angkatan": utils.CursorInt, "tahun_lulus": utils.CursorInt,
+			"status_kematian": utils.CursorBool, "created_at": utils.CursorTimestamp, "updated_at": utils.CursorTimestamp,
+		},
+		Filters: []FilterSpec{
+			{Param: "jurusan", Column: "jurusan", Kind: FilterText, Op: "="},
+			{Param: "angkatan_min", Column: "angkatan", Kind: FilterInt, Op: ">="},
+			{Param: "angkatan_max", Column: "angkatan", Kind: FilterInt, Op: "<="},
+			{Param: "tahun_lulus_min", Column: "tahun_lulus", Kind: FilterInt, Op: ">="},
+			{Param: "tahun_lulus_max", Column: "tahun_lulus", Kind: FilterInt, Op: "<="},
+			{Param: "status_kematian", Column: "status_kematian", Kind: FilterBool, Op: "="},
+			{Param: "created_from", Column: "created_at", Kind: FilterDate, Op: ">="},
+			{Param: "created_to", Column: "created_at", Kind: FilterDate, Op: "<="},
+		},
+	}
+
+	PekerjaanListSpec = &ListSpec{
+		SortColumns: columnSet(
+			"id", "alumni_id", "nama_perusahaan", "posisi_jabatan", "bidang_industri", "lokasi_kerja",
+			"tanggal_mulai_kerja", "tanggal_selesai_kerja", "status_pekerjaan", "created_at", "updated_at",
+		),
+		DefaultSort:   []model.SortField{{Column: "created_at", Order: "desc"}},
+		TieBreaker:    "id",
+		SearchColumns: []string{"nama_perusahaan", "posisi_jabatan", "bidang_industri"},
+		Nullable:      []string{"tanggal_selesai_kerja"},
+		ColumnKinds: map[string]utils.CursorKind{
+			"id": utils.CursorInt, "alumni_id": utils.CursorInt, "tanggal_mulai_kerja": utils.CursorTimestamp,
+			"created_at": utils.CursorTimestamp, "updated_at": utils.CursorTimestamp,
+		},
+		Filters: []FilterSpec{
+			{Param: "alumni_id", Column: "alumni_id", Kind: FilterInt, Op: "="},
+			{Param: "bidang_industri", Column: "bidang_industri", Kind: FilterText, Op: "="},
+			{Param: "status_pekerjaan", Column: "status_pekerjaan", Kind: FilterText, Op: "="},
+			{Param: "lokasi_kerja", Column: "lokasi_kerja", Kind: FilterText, Op: "="},
+			{Param: "mulai_from", Column: "tanggal_mulai_kerja", Kind: FilterDate, Op: ">="},
+			{Param: "mulai_to", Column: "tanggal_mulai_kerja", Kind: FilterDate, Op: "<="},
+			{Param: "selesai_from", Column: "tanggal_selesai_kerja", Kind: FilterDate, Op: ">="},
+			{Param: "selesai_to", Column: "tanggal_selesai_kerja", Kind: FilterDate, Op: "<="},
+			{Param: "created_from", Column: "created_at", Kind: FilterDate, Op: ">="},
+			{Param: "created_to", Column: "created_at", Kind: FilterDate, Op: "<="},
+		},
+	}
+)
+
+func columnSet(cols ...string) map[string]string {
+	m := make(map[string]string, len(cols))
+	for _, c := range cols {
+		m[c] = c
+	}
+	return m
+}
+
+// ParseListQuery baca search, sort, dan filter dari query param (signature sama dengan fiber Ctx.Query).
+// Sort: sort=-angkatan,nama (prefix "-" = desc) atau sort=angkatan:desc,nama:asc;
+// sortBy + order lama tetap didukung untuk satu kolom.
+func ParseListQuery(spec *ListSpec, query func(key string, defaultValue ...string) string) (ListQuery, error) {
+	q := ListQuery{
+		Search:  strings.TrimSpace(query("search")),
+		Filters: map[string]string{},
+		spec:    spec,
+	}
+
+	sortFields, err := parseSort(spec, query)
+	if err != nil {
+		return ListQuery{}, err
+	}
+	q.Sort = sortFields
+
+	for _, f := range spec.Filters {
+		raw := strings.TrimSpace(query(f.Param))
+		if raw == "" {
+			continue
+		}
+		value, err := parseFilterValue(f, raw)
+		if err != nil {
+			return ListQuery{}, err
+		}
+		q.Filters[f.Param] = raw
+		q.conds = append(q.conds, listCondition{column: f.Column, op: f.Op, kind: f.Kind, value: value})
+	}
+	return q, nil
+}
+
+func parseSort(spec *ListSpec, query func(key string, defaultValue ...string) string) ([]model.SortField, error) {
+	defaultOrder := "asc"
+	if len(spec.DefaultSort) > 0 {
+		defaultOrder = spec.DefaultSort[0].Order
+	}
+
+	var fields []model.SortField
+	if raw := strings.TrimSpace(query("sort")); raw != "" {
+		for _, item := range strings.Split(raw, ",") {
+			item = strings.TrimSpace(item)
+			f := model.SortField{Column: item, Order: "asc"}
+			if strings.HasPrefix(item, "-") {
+				f = model.SortField{Column: item[1:], Order: "desc"}
+			} else if col, order, ok := strings.Cut(item, ":"); ok {
+				f = model.SortField{Column: col, Order: strings.ToLower(order)}
+			}
+			fields = append(fields, f)
+		}
+	} else if sortBy := strings.TrimSpace(query("sortBy")); sortBy != "" {
+		fields = []model.SortField{{Column: sortBy, Order: strings.ToLower(query("order", defaultOrder))}}
+	} else {
+		fields = append(fields, spec.DefaultSort...)
+		if order := strings.ToLower(query("order")); order != "" && len(fields) > 0 {
+			fields[0].Order = order
+		}
+	}
+
+	if len(fields) > maxSortFields {
+		return nil, apperror.BadRequest("list.too_many_sort", maxSortFields)
+	}
+	seen := map[string]bool{}
+	for _, f := range fields {
+		if _, ok := spec.SortColumns[f.Column]; !ok {
+			return nil, apperror.BadRequest("list.sort_not_allowed", f.Column, strings.Join(sortedKeys(spec.SortColumns), ", "))
+		}
+		if f.Order != "asc" && f.Order != "desc" {
+			return nil, apperror.BadRequest("list.invalid_order")
+		}
+		if seen[f.Column] {
+			return nil, apperror.BadRequest("list.sort_duplicate", f.Column)
+		}
+		seen[f.Column] = true
+	}
+	return fields, nil
+}
+
+func parseFilterValue(f FilterSpec, raw string) (interface{}, error) {
+	switch f.Kind {
+	case FilterInt:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, apperror.BadRequest("list.filter_number", f.Param)
+		}
+		return n, nil
+	case FilterBool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, apperror.BadRequest("list.filter_bool", f.Param)
+		}
+		return b, nil
+	case FilterDate:
+		t, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return nil, apperror.BadRequest("list.filter_date", f.Param)
+		}
+		// batas atas tanggal inklusif: < hari berikutnya
+		if f.Op == "<=" {
+			return t.AddDate(0, 0, 1), nil
+		}
+		return t, nil
+	default:
+		return raw, nil
+	}
+}
+
+// Where susun klausa WHERE; placeholder dinomori setelah args yang sudah ada
+func (q ListQuery) Where(args []interface{}) (string, []interface{}) {
+	var parts []string
+	if q.Search != "" && len(q.spec.SearchColumns) > 0 {
+		args = append(args, "%"+q.Search+"%")
+		var or []string
+		for _, col := range q.spec.SearchColumns {
+			or = append(or, fmt.Sprintf("%s ILIKE $%d", col, len(args)))
+		}
+		parts = append(parts, "("+strings.Join(or, " OR ")+")")
+	}
+	for _, c := range q.conds {
+		args = append(args, c.value)
+		switch {
+		case c.kind == FilterText:
+			parts = append(parts, fmt.Sprintf("LOWER(%s) = LOWER($%d)", c.column, len(args)))
+		case c.kind == FilterDate && c.op == "<=":
+			parts = append(parts, fmt.Sprintf("%s < $%d", c.column, len(args)))
+		default:
+			parts = append(parts, fmt.Sprintf("%s %s $%d", c.column, c.op, len(args)))
+		}
+	}
+	if len(parts) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(parts, " AND "), args
+}
+
+// OrderBy susun klausa ORDER BY dari kolom whitelist (+ tie breaker supaya urutan stabil)
+func (q ListQuery) OrderBy() string {
+	return q.orderBy(false)
+}
+
+func (q ListQuery) orderBy(reverse bool) string {
+	var parts []string
+	for _, f := range q.keysetFields() {
+		desc := f.Order == "desc"
+		if reverse {
+			desc = !desc
+		}
+		dir := "ASC"
+		if desc {
+			dir = "DESC"
+		}
+		parts = append(parts, q.spec.SortColumns[f.Column]+" "+dir)
+	}
+	return "ORDER BY " + strings.Join(parts, ", ")
+}
+
+// keysetFields kolom sort + tie breaker (kalau belum disebut)
+func (q ListQuery) keysetFields() []model.SortField {
+	fields := append([]model.SortField{}, q.Sort...)
+	for _, f := range fields {
+		if f.Column == q.spec.TieBreaker {
+			return fields
+		}
+	}
+	if q.spec.TieBreaker != "" {
+		fields = append(fields, model.SortField{Column: q.spec.TieBreaker, Order: "asc"})
+	}
+	return fields
+}
+
+// SortKey identitas urutan yang dipakai, disimpan di cursor
+func (q ListQuery) SortKey() string {
+	var parts []string
+	for _, f := range q.keysetFields() {
+		parts = append(parts, f.Column+":"+f.Order)
+	}
+	return strings.Join(parts, ",")
+}
+
+// CursorKinds tipe tiap kolom keyset, urut sama dengan nilai di cursor
+func (q ListQuery) CursorKinds() []utils.CursorKind {
+	fields := q.keysetFields()
+	kinds := make([]utils.CursorKind, len(fields))
+	for i, f := range fields {
+		kinds[i] = q.spec.ColumnKinds[f.Column]
+	}
+	return kinds
+}
+
+// cursorArgs nilai cursor yang sudah diubah ke tipe kolomnya; cursor dengan jumlah
+// atau tipe nilai yang tidak cocok ditolak 400
+func (q ListQuery) cursorArgs(values []string) ([]interface{}, error) {
+	kinds := q.CursorKinds()
+	if len(values) != len(kinds) {
+		return nil, apperror.BadRequest("list.invalid_cursor")
+	}
+	parsed := make([]interface{}, len(values))
+	for i, v := range values {
+		value, err := utils.ParseCursorValue(kinds[i], v)
+		if err != nil {
+			return nil, apperror.BadRequest("list.invalid_cursor")
+		}
+		parsed[i] = value
+	}
+	return parsed, nil
+}
+
+// CheckCursorSort pastikan semua kolom sort bisa dipakai keyset (tidak NULL)
+func (q ListQuery) CheckCursorSort() error {
+	for _, f := range q.Sort {
+		for _, n := range q.spec.Nullable {
+			if f.Column == n {
+				return apperror.BadRequest("list.cursor_sort", f.Column)
+			}
+		}
+	}
+	return nil
+}
+
+// keysetWhere kondisi "sesudah baris batas" untuk urutan multi kolom:
+// (c1 > v1) OR (c1 = v1 AND c2 > v2) OR ... ; operator dibalik untuk kolom desc / arah prev.
+// Nilai cursor di-bind sesuai tipe kolom (lihat ColumnKinds), bukan sebagai teks.
+func (q ListQuery) keysetWhere(values []string, reverse bool, args []interface{}) (string, []interface{}, error) {
+	fields := q.keysetFields()
+	parsed, err := q.cursorArgs(values)
+	if err != nil {
+		return "", nil, err
+	}
+	placeholders := make([]string, len(parsed))
+	for i, v := range parsed {
+		args = append(args, v)
+		placeholders[i] = fmt.Sprintf("$%d", len(args))
+	}
+
+	var or []string
+	for i, f := range fields {
+		var and []string
+		for j := 0; j < i; j++ {
+			and = append(and, fmt.Sprintf("%s = %s", q.spec.SortColumns[fields[j].Column], placeholders[j]))
+		}
+		op := ">"
+		if (f.Order == "desc") != reverse {
+			op = "<"
+		}
+		and = append(and, fmt.Sprintf("%s %s %s", q.spec.SortColumns[f.Column], op, placeholders[i]))
+		or = append(or, "("+strings.Join(and, " AND ")+")")
+	}
+	return "(" + strings.Join(or, " OR ") + ")", args, nil
+}
+
+// CursorQuery susun WHERE + ORDER BY + LIMIT untuk satu halaman cursor (LIMIT limit+1 untuk cek has_more)
+func (q ListQuery) CursorQuery(cur *utils.Cursor, limit int) (string, []interface{}, error) {
+	where, args := q.Where(nil)
+	reverse := cur != nil && cur.Dir == utils.CursorPrev
+	if cur != nil {
+		cond, withCursor, err := q.keysetWhere(cur.Values, reverse, args)
+		if err != nil {
+			return "", nil, err
+		}
+		args = withCursor
+		if where == "" {
+			where = "WHERE " + cond
+		} else {
+			where += " AND " + cond
+		}
+	}
+	args = append(args, limit+1)
+	return fmt.Sprintf("%s %s LIMIT $%d", where, q.orderBy(reverse), len(args)), args, nil
+}
+
+// cursorValues nilai kolom keyset dari satu baris (column = nilai kolom SQL baris tsb)
+func (q ListQuery) cursorValues(column func(col string) interface{}) []string {
+	fields := q.keysetFields()
+	values := make([]string, len(fields))
+	for i, f := range fields {
+		values[i] = cursorValue(column(f.Column))
+	}
+	return values
+}
+
+// cursorValue format nilai kolom untuk disimpan di cursor
+func cursorValue(v interface{}) string {
+	switch x := v.(type) {
+	case time.Time:
+		return x.Format(utils.CursorTimeLayout)
+	case string:
+		return x
+	default:
+		return fmt.Sprint(x)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
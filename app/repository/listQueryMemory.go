@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"backendgo/utils"
+	"cmp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ===================================================
+// 🔹 ListQuery untuk data di memori
+// Padanan Where / OrderBy / CursorQuery untuk repository tanpa SQL (lihat repositoryMemory).
+// column(row, col) mengembalikan nilai kolom SQL baris tsb, mis. AlumniColumnValue.
+// ===================================================
+
+// SelectInMemory baris yang lolos search + filter, diurutkan seperti ORDER BY
+func SelectInMemory[T any](q ListQuery, rows []T, column func(T, string) interface{}) []T {
+	out := []T{}
+	for _, r := range rows {
+		if q.match(rowValue(r, column)) {
+			out = append(out, r)
+		}
+	}
+	sortInMemory(q, out, column, false)
+	return out
+}
+
+// CursorInMemory satu halaman cursor pagination, hasilnya sama dengan CursorQuery + BuildCursorPage
+func CursorInMemory[T any](q ListQuery, rows []T, column func(T, string) interface{}, cur *utils.Cursor, limit int) ([]T, utils.CursorPage, error) {
+	reverse := cur != nil && cur.Dir == utils.CursorPrev
+	if cur != nil {
+		if _, err := q.cursorArgs(cur.Values); err != nil {
+			return nil, utils.CursorPage{}, err
+		}
+	}
+
+	list := []T{}
+	for _, r := range rows {
+		value := rowValue(r, column)
+		if q.match(value) && (cur == nil || q.afterCursor(value, cur.Values, reverse)) {
+			list = append(list, r)
+		}
+	}
+	sortInMemory(q, list, column, reverse)
+	if len(list) > limit+1 {
+		list = list[:limit+1]
+	}
+
+	list, page := utils.BuildCursorPage(list, limit, cur, q.SortKey(), func(r T) []string {
+		return q.cursorValues(rowValue(r, column))
+	})
+	return list, page, nil
+}
+
+func rowValue[T any](r T, column func(T, string) interface{}) func(string) interface{} {
+	return func(col string) interface{} { return column(r, col) }
+}
+
+// match padanan Where: search ILIKE di SearchColumns + semua filter
+func (q ListQuery) match(value func(col string) interface{}) bool {
+	if q.Search != "" && len(q.spec.SearchColumns) > 0 {
+		term := strings.ToLower(q.Search)
+		found := false
+		for _, col := range q.spec.SearchColumns {
+			if s, ok := value(col).(string); ok && strings.Contains(strings.ToLower(s), term) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, c := range q.conds {
+		v := value(c.column)
+		if c.kind == FilterText {
+			s, _ := v.(string)
+			if !strings.EqualFold(s, c.value.(string)) {
+				return false
+			}
+			continue
+		}
+		// NULL tidak pernah lolos perbandingan, sama seperti SQL
+		if isNull(v) {
+			return false
+		}
+		diff := compareValues(v, c.value)
+		switch {
+		case c.kind == FilterDate && c.op == "<=":
+			if diff >= 0 {
+				return false
+			}
+		case c.op == ">=":
+			if diff < 0 {
+				return false
+			}
+		case c.op == "<=":
+			if diff > 0 {
+				return false
+			}
+		default:
+			if diff != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// sortInMemory padanan ORDER BY (NULL di akhir untuk asc, di awal untuk desc seperti Postgres)
+func sortInMemory[T any](q ListQuery, rows []T, column func(T, string) interface{}, reverse bool) {
+	fields := q.keysetFields()
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, f := range fields {
+			diff := compareValues(column(rows[i], f.Column), column(rows[j], f.Column))
+			if (f.Order == "desc") != reverse {
+				diff = -diff
+			}
+			if diff != 0 {
+				return diff < 0
+			}
+		}
+		return false
+	})
+}
+
+// afterCursor padanan keysetWhere: baris berada sesudah nilai cursor pada urutan keyset
+func (q ListQuery) afterCursor(value func(col string) interface{}, values []string, reverse bool) bool {
+	for i, f := range q.keysetFields() {
+		diff := compareCursorValue(value(f.Column), values[i])
+		if (f.Order == "desc") != reverse {
+			diff = -diff
+		}
+		if diff != 0 {
+			return diff > 0
+		}
+	}
+	return false
+}
+
+func isNull(v interface{}) bool {
+	t, ok := v.(*time.Time)
+	return v == nil || (ok && t == nil)
+}
+
+// compareValues bandingkan dua nilai kolom bertipe sama; NULL dianggap paling besar
+func compareValues(a, b interface{}) int {
+	if t, ok := a.(*time.Time); ok && t != nil {
+		a = *t
+	}
+	if t, ok := b.(*time.Time); ok && t != nil {
+		b = *t
+	}
+	switch an, bn := isNull(a), isNull(b); {
+	case an && bn:
+		return 0
+	case an:
+		return 1
+	case bn:
+		return -1
+	}
+
+	switch x := a.(type) {
+	case int:
+		return cmp.Compare(x, b.(int))
+	case string:
+		return strings.Compare(x, b.(string))
+	case bool:
+		y := b.(bool)
+		if x == y {
+			return 0
+		}
+		if !x {
+			return -1
+		}
+		return 1
+	case time.Time:
+		return x.Compare(b.(time.Time))
+	default:
+		return strings.Compare(cursorValue(a), cursorValue(b))
+	}
+}
+
+// compareCursorValue bandingkan nilai kolom dengan nilai teks dari cursor (di-parse sesuai tipe kolom)
+func compareCursorValue(v interface{}, raw string) int {
+	switch x := v.(type) {
+	case int:
+		n, _ := strconv.Atoi(raw)
+		return cmp.Compare(x, n)
+	case bool:
+		b, _ := strconv.ParseBool(raw)
+		return compareValues(x, b)
+	case time.Time:
+		a, _ := time.Parse(utils.CursorTimeLayout, cursorValue(x))
+		b, _ := time.Parse(utils.CursorTimeLayout, raw)
+		return a.Compare(b)
+	default:
+		return strings.Compare(cursorValue(v), raw)
+	}
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"database/sql"
+	"time"
+)
+
+// LoginAttemptRepository akses tabel login_attempts (throttle login per akun / IP)
+type LoginAttemptRepository interface {
+	Get(scope, key string) (*model.LoginAttempt, error)
+	RecordFailure(scope, key string, window time.Duration) (model.LoginAttempt, error)
+	Lock(scope, key string, until time.Time) error
+	Clear(scope, key string) (bool, error)
+}
+
+type loginAttemptRepository struct {
+	db *sql.DB
+}
+
+func NewLoginAttemptRepository(db *sql.DB) LoginAttemptRepository {
+	return &loginAttemptRepository{db: db}
+}
+
+// Scope percobaan login
+const (
+	LoginScopeAccount = "account"
+	LoginScopeIP      = "ip"
+)
+
+// ===================================================
+// 🔹 Ambil status percobaan login (nil kalau belum ada)
+// ===================================================
+func (r *loginAttemptRepository) Get(scope, key string) (*model.LoginAttempt, error) {
+	a := model.LoginAttempt{Scope: scope, Key: key}
+	var lockedUntil sql.NullTime
+	err := r.db.QueryRow(`
+		SELECT failures, last_failure_at, locked_until
+		FROM login_attempts
+		WHERE scope = $1 AND key = $2
+	`, scope, key).Scan(&a.Failures, &a.LastFailureAt, &lockedUntil)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if lockedUntil.Valid {
+		a.LockedUntil = &lockedUntil.Time
+	}
+	return &a, nil
+}
+
+// ===================================================
+// 🔹 Catat login gagal
+// ===================================================
+// Counter dimulai ulang dari 1 kalau kegagalan terakhir sudah di luar window
+// atau masa lockout sebelumnya sudah habis.
+func (r *loginAttemptRepository) RecordFailure(scope, key string, window time.Duration) (model.LoginAttempt, error) {
+	a := model.LoginAttempt{Scope: scope, Key: key}
+	var lockedUntil sql.NullTime
+	err := r.db.QueryRow(`
+		INSERT INTO login_attempts (scope, key, failures, last_failure_at)
+		VALUES ($1, $2, 1, NOW())
+		ON CONFLICT (scope, key) DO UPDATE SET
+			failures = CASE
+				WHEN login_attempts.last_failure_at < NOW() - $3 * INTERVAL '1 second'
+				  OR login_attempts.locked_until < NOW()
+				THEN 1
+				ELSE login_attempts.failures + 1
+			END,
+			locked_until = CASE
+				WHEN login_attempts.locked_until < NOW() THEN NULL
+				ELSE login_attempts.locked_until
+			END,
+			last_failure_at = NOW()
+		RETURNING failures, last_failure_at, locked_until
+	`, scope, key, window.Seconds()).Scan(&a.Failures, &a.LastFailureAt, &lockedUntil)
+	if lockedUntil.Valid {
+		a.LockedUntil = &lockedUntil.Time
+	}
+	return a, err
+}
+
+// ===================================================
+// 🔹 Kunci akun / IP sampai waktu tertentu
+// ===================================================
+func (r *loginAttemptRepository) Lock(scope, key string, until time.Time) error {
+	_, err := r.db.Exec(`
+		UPDATE login_attempts SET locked_until = $3
+		WHERE scope = $1 AND key = $2
+	`, scope, key, until)
+	return err
+}
+
+// ===================================================
+// 🔹 Reset percobaan login (login sukses / unlock admin)
+// ===================================================
+// Mengembalikan true kalau sebelumnya memang ada catatan kegagalan.
+func (r *loginAttemptRepository) Clear(scope, key string) (bool, error) {
+	result, err := r.db.Exec(`
+		DELETE FROM login_attempts WHERE scope = $1 AND key = $2
+	`, scope, key)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"database/sql"
+	"time"
+)
+
+// MFARepository akses data TOTP di tabel users dan tabel user_recovery_codes
+type MFARepository interface {
+	GetTOTP(userID int) (model.UserTOTP, error)
+	SetTOTPSecret(userID int, secret string) error
+	EnableTOTP(userID int, counter int64, recoveryCodeHashes []string) error
+	DisableTOTP(userID int) error
+	ConsumeTOTPCounter(userID int, counter int64) (bool, error)
+	ReplaceRecoveryCodes(userID int, codeHashes []string) error
+	UseRecoveryCode(userID int, codeHash string) (bool, error)
+	CountUnusedRecoveryCodes(userID int) (int, error)
+	ConsumeChallenge(userID int, jti string, expiresAt time.Time) (bool, error)
+}
+
+type mfaRepository struct {
+	db *sql.DB
+}
+
+func NewMFARepository(db *sql.DB) MFARepository {
+	return &mfaRepository{db: db}
+}
+
+// ===================================================
+// 🔹 Ambil data TOTP user
+// ===================================================
+func (r *mfaRepository) GetTOTP(userID int) (model.UserTOTP, error) {
+	t := model.UserTOTP{UserID: userID}
+	var secret sql.NullString
+	err := r.db.QueryRow(`
+		SELECT totp_secret, totp_enabled, totp_last_counter
+		FROM users WHERE id = $1
+	`, userID).Scan(&secret, &t.Enabled, &t.LastCounter)
+	if err == sql.ErrNoRows {
+		return t, ErrUserNotFound
+	}
+	t.Secret = secret.String
+	return t, err
+}
+
+// ===================================================
+// 🔹 Simpan secret TOTP baru (belum aktif sampai diverifikasi)
+// ===================================================
+func (r *mfaRepository) SetTOTPSecret(userID int, secret string) error {
+	_, err := r.db.Exec(`
+		UPDATE users
+		SET totp_secret = $1, totp_enabled = FALSE, totp_last_counter = 0, updated_at = NOW()
+		WHERE id = $2
+	`, secret, userID)
+	return err
+}
+
+// ===================================================
+// 🔹 Aktifkan TOTP + simpan recovery code (satu transaksi)
+// ===================================================
+func (r *mfaRepository) EnableTOTP(userID int, counter int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec(`
+		UPDATE users
+		SET totp_enabled = TRUE, totp_last_counter = $1, updated_at = NOW()
+		WHERE id = $2
+	`, counter, userID); err != nil {
+		return err
+	}
+	if err = replaceRecoveryCodesTx(tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ===================================================
+// 🔹 Nonaktifkan TOTP
+// ===================================================
+func (r *mfaRepository) DisableTOTP(userID int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec(`
+		UPDATE users
+		SET totp_secret = NULL, totp_enabled = FALSE, totp_last_counter = 0, updated_at = NOW()
+		WHERE id = $1
+	`, userID); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(`DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ===================================================
+// 🔹 Pakai counter TOTP (anti replay)
+// ===================================================
+// Mengembalikan false kalau kode untuk counter ini (atau yang lebih baru)
+// sudah pernah dipakai.
+func (r *mfaRepository) ConsumeTOTPCounter(userID int, counter int64) (bool, error) {
+	result, err := r.db.Exec(`
+		UPDATE users SET totp_last_counter = $1
+		WHERE id = $2 AND totp_last_counter < $1
+	`, counter, userID)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
+
+// ===================================================
+// 🔹 Ganti semua recovery code
+// ===================================================
+func (r *mfaRepository) ReplaceRecoveryCodes(userID int, codeHashes []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = replaceRecoveryCodesTx(tx, userID, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func replaceRecoveryCodesTx(tx *sql.Tx, userID int, codeHashes []string) error {
+	if _, err := tx.Exec(`DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.Exec(`
+			INSERT INTO user_recovery_codes (user_id, code_hash, created_at)
+			VALUES ($1, $2, NOW())
+		`, userID, hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ===================================================
+// 🔹 Pakai recovery code (sekali pakai)
+// ===================================================
+func (r *mfaRepository) UseRecoveryCode(userID int, codeHash string) (bool, error) {
+	result, err := r.db.Exec(`
+		UPDATE user_recovery_codes SET used_at = NOW()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`, userID, codeHash)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
+
+// ===================================================
+// 🔹 Hitung recovery code yang belum dipakai
+// ===================================================
+func (r *mfaRepository) CountUnusedRecoveryCodes(userID int) (int, error) {
+	var total int
+	err := r.db.QueryRow(`
+		SELECT COUNT(*) FROM user_recovery_codes
+		WHERE user_id = $1 AND used_at IS NULL
+	`, userID).Scan(&total)
+	return total, err
+}
+
+// ===================================================
+// 🔹 Pakai token challenge MFA (sekali pakai)
+// ===================================================
+// Mengembalikan false kalau jti ini sudah pernah ditukar. Catatan challenge
+// yang sudah kadaluarsa ikut dibersihkan karena tokennya sudah ditolak oleh exp.
+func (r *mfaRepository) ConsumeChallenge(userID int, jti string, expiresAt time.Time) (bool, error) {
+	if _, err := r.db.Exec(`DELETE FROM mfa_challenges_used WHERE expires_at < NOW()`); err != nil {
+		return false, err
+	}
+	result, err := r.db.Exec(`
+		INSERT INTO mfa_challenges_used (jti, user_id, expires_at, used_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (jti) DO NOTHING
+	`, jti, userID, expiresAt)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"backendgo/apperror"
+	"database/sql"
+	"time"
+)
+
+// ErrResetTokenNotFound token reset tidak ada di database
+var ErrResetTokenNotFound = apperror.BadRequest("password.reset_token_invalid")
+
+// PasswordResetRepository akses tabel password_reset_tokens
+type PasswordResetRepository interface {
+	Create(userID, createdBy int, tokenHash string, expiresAt time.Time) error
+	GetByHash(tokenHash string) (*model.PasswordResetToken, error)
+	MarkUsed(id int) (bool, error)
+}
+
+type passwordResetRepository struct {
+	db *sql.DB
+}
+
+func NewPasswordResetRepository(db *sql.DB) PasswordResetRepository {
+	return &passwordResetRepository{db: db}
+}
+
+// ===================================================
+// 🔹 Buat token reset password
+// ===================================================
+// Token lama milik user yang belum dipakai langsung dibatalkan,
+// jadi hanya link reset terakhir yang berlaku.
+func (r *passwordResetRepository) Create(userID, createdBy int, tokenHash string, expiresAt time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec(`
+		UPDATE password_reset_tokens
+		SET used_at = NOW()
+		WHERE user_id = $1 AND used_at IS NULL
+	`, userID); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(`
+		INSERT INTO password_reset_tokens (user_id, token_hash, expires_at, created_by, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+	`, userID, tokenHash, expiresAt, createdBy); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ===================================================
+// 🔹 Ambil token reset berdasarkan hash
+// ===================================================
+func (r *passwordResetRepository) GetByHash(tokenHash string) (*model.PasswordResetToken, error) {
+	var t model.PasswordResetToken
+	var usedAt sql.NullTime
+	err := r.db.QueryRow(`
+		SELECT id, user_id, token_hash, expires_at, used_at, created_by, created_at
+		FROM password_reset_tokens
+		WHERE token_hash = $1
+	`, tokenHash).Scan(
+		&t.ID, &t.UserID, &t.TokenHash, &t.ExpiresAt, &usedAt, &t.CreatedBy, &t.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrResetTokenNotFound
+		}
+		return nil, err
+	}
+	if usedAt.Valid {
+		t.UsedAt = &usedAt.Time
+	}
+	return &t, nil
+}
+
+// ===================================================
+// 🔹 Tandai token reset sudah dipakai
+// ===================================================
+// Mengembalikan false kalau token sudah dipakai lebih dulu.
+func (r *passwordResetRepository) MarkUsed(id int) (bool, error) {
+	result, err := r.db.Exec(`
+		UPDATE password_reset_tokens
+		SET used_at = NOW()
+		WHERE id = $1 AND used_at IS NULL
+	`, id)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
@@ -2,18 +2,68 @@ package repository
 
 import (
 	"backendgo/app/model"
-	"backendgo/database"
+	"backendgo/apperror"
+	"backendgo/utils"
 	"database/sql"
 	"fmt"
 	"log"
 )
 
+// ErrPekerjaanNotOwned pekerjaan tidak ada atau bukan milik alumni yang diminta
+var ErrPekerjaanNotOwned = apperror.NotFound("pekerjaan.not_owned")
+
+// ErrPekerjaanNotTrashed hard delete hanya untuk pekerjaan yang sudah di-soft delete
+var ErrPekerjaanNotTrashed = apperror.NotFound("pekerjaan.not_trashed")
+
+// PekerjaanRepository akses data tabel pekerjaan_alumni. Method *Owned hanya
+// mengubah pekerjaan milik alumniID dan mengembalikan ErrPekerjaanNotOwned jika bukan.
+// Update menerima versi data yang terakhir dibaca client (0 = tanpa cek) dan
+// mengembalikan ErrVersionConflict kalau sudah berubah. Pekerjaan milik alumni yang ada
+// di trash disembunyikan dari daftar dan trash pekerjaan; yang ikut di-trash bersama
+// alumninya (deleted_with_alumni) hanya bisa dipulihkan lewat restore alumni.
+type PekerjaanRepository interface {
+	GetAll() ([]model.PekerjaanAlumni, error)
+	GetByID(id int) (model.PekerjaanAlumni, error)
+	GetByAlumniID(alumniID int) ([]model.PekerjaanAlumni, error)
+	Create(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error)
+	Update(p model.PekerjaanAlumni, version int) (model.PekerjaanAlumni, error)
+	UpdateOwned(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error)
+	Delete(id int) error
+	List(q ListQuery, limit, offset int) ([]model.PekerjaanAlumni, error)
+	Count(q ListQuery) (int, error)
+	ListCursor(q ListQuery, cur *utils.Cursor, limit int) ([]model.PekerjaanAlumni, utils.CursorPage, error)
+	Stream(q ListQuery, fn func(model.PekerjaanAlumni) error) error
+	SoftDelete(id int) error
+	SoftDeleteOwned(id, alumniID int) error
+	Restore(id int) error
+	RestoreOwned(id, alumniID int) error
+	HardDelete(id int) error
+	HardDeleteOwned(id, alumniID int) error
+	GetTrashed() ([]model.PekerjaanAlumniTrashed, error)
+	GetTrashedByAlumniID(alumniID int) ([]model.PekerjaanAlumniTrashed, error)
+}
+
+// alumniNotTrashed kondisi pekerjaan yang alumninya tidak di trash
+const alumniNotTrashed = `alumni_id NOT IN (SELECT id FROM alumni WHERE is_deleted = TRUE)`
+
+// activePekerjaan pengganti tabel pekerjaan_alumni di query list / count / cursor / stream
+const activePekerjaan = `(SELECT * FROM pekerjaan_alumni WHERE ` + alumniNotTrashed + `) AS pekerjaan_alumni`
+
+type pekerjaanRepository struct {
+	db *sql.DB
+}
+
+func NewPekerjaanRepository(db *sql.DB) PekerjaanRepository {
+	return &pekerjaanRepository{db: db}
+}
+
 // Get All
-func GetAllPekerjaan() ([]model.PekerjaanAlumni, error) {
-	rows, err := database.DB.Query(`
+func (r *pekerjaanRepository) GetAll() ([]model.PekerjaanAlumni, error) {
+	rows, err := r.db.Query(`
 		SELECT id, alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja, gaji_range,
-			tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan, created_at, updated_at
+			tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan, created_at, updated_at, version
 		FROM pekerjaan_alumni
+		WHERE ` + alumniNotTrashed + `
 		ORDER BY created_at DESC
 	`)
 
@@ -29,7 +79,7 @@ func GetAllPekerjaan() ([]model.PekerjaanAlumni, error) {
 		if err := rows.Scan(
 			&p.ID, &p.AlumniID, &p.NamaPerusahaan, &p.PosisiJabatan, &p.BidangIndustri,
 			&p.LokasiKerja, &p.GajiRange, &p.TanggalMulaiKerja, &ts,
-			&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt,
+			&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt, &p.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -43,17 +93,17 @@ func GetAllPekerjaan() ([]model.PekerjaanAlumni, error) {
 }
 
 // Get by ID
-func GetPekerjaanByID(id int) (model.PekerjaanAlumni, error) {
+func (r *pekerjaanRepository) GetByID(id int) (model.PekerjaanAlumni, error) {
 	var p model.PekerjaanAlumni
 	var ts sql.NullTime
-	err := database.DB.QueryRow(`
+	err := r.db.QueryRow(`
 		SELECT id, alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja, gaji_range,
-		       tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan, created_at, updated_at
+		       tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan, created_at, updated_at, version
 		FROM pekerjaan_alumni WHERE id=$1
 	`, id).Scan(
 		&p.ID, &p.AlumniID, &p.NamaPerusahaan, &p.PosisiJabatan, &p.BidangIndustri,
 		&p.LokasiKerja, &p.GajiRange, &p.TanggalMulaiKerja, &ts,
-		&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt,
+		&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt, &p.Version,
 	)
 	if ts.Valid {
 		t := ts.Time
@@ -63,11 +113,11 @@ func GetPekerjaanByID(id int) (model.PekerjaanAlumni, error) {
 }
 
 // Get by Alumni
-func GetPekerjaanByAlumniID(alumniID int) ([]model.PekerjaanAlumni, error) {
-	rows, err := database.DB.Query(`
+func (r *pekerjaanRepository) GetByAlumniID(alumniID int) ([]model.PekerjaanAlumni, error) {
+	rows, err := r.db.Query(`
 		SELECT id, alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja, gaji_range,
-		       tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan, created_at, updated_at
-		FROM pekerjaan_alumni WHERE alumni_id=$1 ORDER BY created_at DESC
+		       tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan, created_at, updated_at, version
+		FROM pekerjaan_alumni WHERE alumni_id=$1 AND `+alumniNotTrashed+` ORDER BY created_at DESC
 	`, alumniID)
 	if err != nil {
 		return nil, err
@@ -81,7 +131,7 @@ func GetPekerjaanByAlumniID(alumniID int) ([]model.PekerjaanAlumni, error) {
 		if err := rows.Scan(
 			&p.ID, &p.AlumniID, &p.NamaPerusahaan, &p.PosisiJabatan, &p.BidangIndustri,
 			&p.LokasiKerja, &p.GajiRange, &p.TanggalMulaiKerja, &ts,
-			&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt,
+			&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt, &p.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -95,17 +145,17 @@ func GetPekerjaanByAlumniID(alumniID int) ([]model.PekerjaanAlumni, error) {
 }
 
 // Create
-func CreatePekerjaan(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error) {
-	err := database.DB.QueryRow(`
+func (r *pekerjaanRepository) Create(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error) {
+	err := r.db.QueryRow(`
 		INSERT INTO pekerjaan_alumni (
 			alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja, gaji_range,
 			tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan,
 			created_at, updated_at
 		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
-		RETURNING id
+		RETURNING id, version
 	`, p.AlumniID, p.NamaPerusahaan, p.PosisiJabatan, p.BidangIndustri, p.LokasiKerja,
 		p.GajiRange, p.TanggalMulaiKerja, p.TanggalSelesaiKerja, p.StatusPekerjaan,
-		p.DeskripsiPekerjaan, p.CreatedAt, p.UpdatedAt).Scan(&p.ID)
+		p.DeskripsiPekerjaan, p.CreatedAt, p.UpdatedAt).Scan(&p.ID, &p.Version)
 	if err != nil {
 		log.Println("DB error CreatePekerjaan:", err)
 	}
@@ -114,37 +164,69 @@ func CreatePekerjaan(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error) {
 
 
 
-// Update
-func UpdatePekerjaan(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error) {
-	_, err := database.DB.Exec(`
+// Update (version dinaikkan trigger trg_pekerjaan_version)
+func (r *pekerjaanRepository) Update(p model.PekerjaanAlumni, version int) (model.PekerjaanAlumni, error) {
+	result, err := r.db.Exec(`
 		UPDATE pekerjaan_alumni
 		SET nama_perusahaan=$1, posisi_jabatan=$2, bidang_industri=$3, lokasi_kerja=$4, gaji_range=$5,
 		    tanggal_mulai_kerja=$6, tanggal_selesai_kerja=$7, status_pekerjaan=$8, deskripsi_pekerjaan=$9, updated_at=$10
-		WHERE id=$11
+		WHERE id=$11 AND ($12 = 0 OR version = $12)
 	`, p.NamaPerusahaan, p.PosisiJabatan, p.BidangIndustri, p.LokasiKerja, p.GajiRange,
-		p.TanggalMulaiKerja, p.TanggalSelesaiKerja, p.StatusPekerjaan, p.DeskripsiPekerjaan, p.UpdatedAt, p.ID)
-	return p, err
+		p.TanggalMulaiKerja, p.TanggalSelesaiKerja, p.StatusPekerjaan, p.DeskripsiPekerjaan, p.UpdatedAt, p.ID, version)
+	if err != nil {
+		return p, err
+	}
+
+	updated, err := r.GetByID(p.ID)
+	if err != nil {
+		return p, err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return updated, ErrVersionConflict
+	}
+	return updated, nil
+}
+
+// Update milik alumni tertentu (self-service)
+func (r *pekerjaanRepository) UpdateOwned(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error) {
+	result, err := r.db.Exec(`
+		UPDATE pekerjaan_alumni
+		SET nama_perusahaan=$1, posisi_jabatan=$2, bidang_industri=$3, lokasi_kerja=$4, gaji_range=$5,
+		    tanggal_mulai_kerja=$6, tanggal_selesai_kerja=$7, status_pekerjaan=$8, deskripsi_pekerjaan=$9, updated_at=$10
+		WHERE id=$11 AND alumni_id=$12 AND is_deleted = FALSE
+	`, p.NamaPerusahaan, p.PosisiJabatan, p.BidangIndustri, p.LokasiKerja, p.GajiRange,
+		p.TanggalMulaiKerja, p.TanggalSelesaiKerja, p.StatusPekerjaan, p.DeskripsiPekerjaan, p.UpdatedAt,
+		p.ID, p.AlumniID)
+	if err != nil {
+		return p, err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return p, ErrPekerjaanNotOwned
+	}
+	return r.GetByID(p.ID)
 }
 
 // Delete
-func DeletePekerjaan(id int) error {
-	_, err := database.DB.Exec("DELETE FROM pekerjaan_alumni WHERE id=$1", id)
+func (r *pekerjaanRepository) Delete(id int) error {
+	_, err := r.db.Exec("DELETE FROM pekerjaan_alumni WHERE id=$1", id)
 	return err
 }
 
-// Pagination
-func GetAllPekerjaanWithPagination(search, sortBy, order string, limit, offset int) ([]model.PekerjaanAlumni, error) {
+// Pagination + filter (lihat PekerjaanListSpec)
+func (r *pekerjaanRepository) List(q ListQuery, limit, offset int) ([]model.PekerjaanAlumni, error) {
+	where, args := q.Where(nil)
+	args = append(args, limit, offset)
 	query := fmt.Sprintf(`
 		SELECT id, alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja,
 		       gaji_range, tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan,
-		       deskripsi_pekerjaan, created_at, updated_at
-		FROM pekerjaan_alumni
-		WHERE nama_perusahaan ILIKE $1 OR posisi_jabatan ILIKE $1 OR bidang_industri ILIKE $1
-		ORDER BY %s %s
-		LIMIT $2 OFFSET $3
-	`, sortBy, order)
+		       deskripsi_pekerjaan, created_at, updated_at, version
+		FROM %s
+		%s
+		%s
+		LIMIT $%d OFFSET $%d
+	`, activePekerjaan, where, q.OrderBy(), len(args)-1, len(args))
 
-	rows, err := database.DB.Query(query, "%"+search+"%", limit, offset)
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +239,7 @@ func GetAllPekerjaanWithPagination(search, sortBy, order string, limit, offset i
 		if err := rows.Scan(
 			&p.ID, &p.AlumniID, &p.NamaPerusahaan, &p.PosisiJabatan, &p.BidangIndustri,
 			&p.LokasiKerja, &p.GajiRange, &p.TanggalMulaiKerja, &ts,
-			&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt,
+			&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt, &p.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -170,17 +252,127 @@ func GetAllPekerjaanWithPagination(search, sortBy, order string, limit, offset i
 	return list, nil
 }
 
-func CountPekerjaan(search string) (int, error) {
+func (r *pekerjaanRepository) Count(q ListQuery) (int, error) {
+	where, args := q.Where(nil)
 	var count int
-	err := database.DB.QueryRow(`
-		SELECT COUNT(*) FROM pekerjaan_alumni
-		WHERE nama_perusahaan ILIKE $1 OR posisi_jabatan ILIKE $1 OR bidang_industri ILIKE $1
-	`, "%"+search+"%").Scan(&count)
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM `+activePekerjaan+` `+where, args...).Scan(&count)
 	return count, err
 }
 
-func SoftDeletePekerjaanAdmin(id int) error {
-	_, err := database.DB.Exec(`
+// Stream pekerjaan (export) — filter sama dengan pagination, tanpa LIMIT
+func (r *pekerjaanRepository) Stream(q ListQuery, fn func(model.PekerjaanAlumni) error) error {
+	where, args := q.Where(nil)
+	query := fmt.Sprintf(`
+		SELECT id, alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja,
+		       gaji_range, tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan,
+		       deskripsi_pekerjaan, created_at, updated_at, version
+		FROM %s
+		%s
+		%s
+	`, activePekerjaan, where, q.OrderBy())
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p model.PekerjaanAlumni
+		var ts sql.NullTime
+		if err := rows.Scan(
+			&p.ID, &p.AlumniID, &p.NamaPerusahaan, &p.PosisiJabatan, &p.BidangIndustri,
+			&p.LokasiKerja, &p.GajiRange, &p.TanggalMulaiKerja, &ts,
+			&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt, &p.Version,
+		); err != nil {
+			return err
+		}
+		if ts.Valid {
+			t := ts.Time
+			p.TanggalSelesaiKerja = &t
+		}
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Cursor (keyset) pagination
+func (r *pekerjaanRepository) ListCursor(q ListQuery, cur *utils.Cursor, limit int) ([]model.PekerjaanAlumni, utils.CursorPage, error) {
+	clause, args, err := q.CursorQuery(cur, limit)
+	if err != nil {
+		return nil, utils.CursorPage{}, err
+	}
+	rows, err := r.db.Query(`
+		SELECT id, alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja,
+		       gaji_range, tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan,
+		       deskripsi_pekerjaan, created_at, updated_at, version
+		FROM `+activePekerjaan+`
+		`+clause, args...)
+	if err != nil {
+		return nil, utils.CursorPage{}, err
+	}
+	defer rows.Close()
+
+	list := []model.PekerjaanAlumni{}
+	for rows.Next() {
+		var p model.PekerjaanAlumni
+		var ts sql.NullTime
+		if err := rows.Scan(
+			&p.ID, &p.AlumniID, &p.NamaPerusahaan, &p.PosisiJabatan, &p.BidangIndustri,
+			&p.LokasiKerja, &p.GajiRange, &p.TanggalMulaiKerja, &ts,
+			&p.StatusPekerjaan, &p.DeskripsiPekerjaan, &p.CreatedAt, &p.UpdatedAt, &p.Version,
+		); err != nil {
+			return nil, utils.CursorPage{}, err
+		}
+		if ts.Valid {
+			t := ts.Time
+			p.TanggalSelesaiKerja = &t
+		}
+		list = append(list, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, utils.CursorPage{}, err
+	}
+
+	list, page := utils.BuildCursorPage(list, limit, cur, q.SortKey(), func(p model.PekerjaanAlumni) []string {
+		return q.cursorValues(func(col string) interface{} { return PekerjaanColumnValue(p, col) })
+	})
+	return list, page, nil
+}
+
+// PekerjaanColumnValue nilai kolom PekerjaanListSpec (sort, search, filter) dari satu pekerjaan;
+// tanggal_selesai_kerja bisa nil (*time.Time)
+func PekerjaanColumnValue(p model.PekerjaanAlumni, col string) interface{} {
+	switch col {
+	case "alumni_id":
+		return p.AlumniID
+	case "nama_perusahaan":
+		return p.NamaPerusahaan
+	case "posisi_jabatan":
+		return p.PosisiJabatan
+	case "bidang_industri":
+		return p.BidangIndustri
+	case "lokasi_kerja":
+		return p.LokasiKerja
+	case "tanggal_mulai_kerja":
+		return p.TanggalMulaiKerja
+	case "tanggal_selesai_kerja":
+		return p.TanggalSelesaiKerja
+	case "status_pekerjaan":
+		return p.StatusPekerjaan
+	case "created_at":
+		return p.CreatedAt
+	case "updated_at":
+		return p.UpdatedAt
+	default:
+		return p.ID
+	}
+}
+
+func (r *pekerjaanRepository) SoftDelete(id int) error {
+	_, err := r.db.Exec(`
 		UPDATE pekerjaan_alumni
 		SET is_deleted = TRUE, updated_at = NOW()
 		WHERE id = $1
@@ -188,8 +380,8 @@ func SoftDeletePekerjaanAdmin(id int) error {
 	return err
 }
 
-func SoftDeletePekerjaanUser(id, alumniID int) error {
-	result, err := database.DB.Exec(`
+func (r *pekerjaanRepository) SoftDeleteOwned(id, alumniID int) error {
+	result, err := r.db.Exec(`
 		UPDATE pekerjaan_alumni
 		SET is_deleted = TRUE, updated_at = NOW()
 		WHERE id = $1 AND alumni_id = $2
@@ -199,40 +391,40 @@ func SoftDeletePekerjaanUser(id, alumniID int) error {
 	}
 	rows, _ := result.RowsAffected()
 	if rows == 0 {
-		return fmt.Errorf("data tidak ditemukan atau bukan milik alumni ini")
+		return ErrPekerjaanNotOwned
 	}
 	return nil
 }
 
 // === Restore ===
-func RestorePekerjaanAdmin(id int) error {
-	_, err := database.DB.Exec(`
+func (r *pekerjaanRepository) Restore(id int) error {
+	_, err := r.db.Exec(`
 		UPDATE pekerjaan_alumni
 		SET is_deleted = FALSE, updated_at = NOW()
-		WHERE id = $1
+		WHERE id = $1 AND deleted_with_alumni = FALSE
 	`, id)
 	return err
 }
 
-func RestorePekerjaanUser(id, alumniID int) error {
-	result, err := database.DB.Exec(`
+func (r *pekerjaanRepository) RestoreOwned(id, alumniID int) error {
+	result, err := r.db.Exec(`
 		UPDATE pekerjaan_alumni
 		SET is_deleted = FALSE, updated_at = NOW()
-		WHERE id = $1 AND alumni_id = $2
+		WHERE id = $1 AND alumni_id = $2 AND deleted_with_alumni = FALSE
 	`, id, alumniID)
 	if err != nil {
 		return err
 	}
 	rows, _ := result.RowsAffected()
 	if rows == 0 {
-		return fmt.Errorf("data tidak ditemukan atau bukan milik alumni ini")
+		return ErrPekerjaanNotOwned
 	}
 	return nil
 }
 
 // === Hard Delete ===
-func HardDeletePekerjaanAdmin(id int) error {
-	result, err := database.DB.Exec(`
+func (r *pekerjaanRepository) HardDelete(id int) error {
+	result, err := r.db.Exec(`
 		DELETE FROM pekerjaan_alumni
 		WHERE id = $1 AND is_deleted = TRUE
 	`, id)
@@ -241,13 +433,13 @@ func HardDeletePekerjaanAdmin(id int) error {
 	}
 	rows, _ := result.RowsAffected()
 	if rows == 0 {
-		return fmt.Errorf("data tidak ditemukan atau belum dihapus (soft delete)")
+		return ErrPekerjaanNotTrashed
 	}
 	return nil
 }
 
-func HardDeletePekerjaanUser(id, alumniID int) error {
-	result, err := database.DB.Exec(`
+func (r *pekerjaanRepository) HardDeleteOwned(id, alumniID int) error {
+	result, err := r.db.Exec(`
 		DELETE FROM pekerjaan_alumni
 		WHERE id = $1 AND alumni_id = $2 AND is_deleted = TRUE
 	`, id, alumniID)
@@ -256,19 +448,19 @@ func HardDeletePekerjaanUser(id, alumniID int) error {
 	}
 	rows, _ := result.RowsAffected()
 	if rows == 0 {
-		return fmt.Errorf("data tidak ditemukan atau bukan milik alumni ini")
+		return ErrPekerjaanNotOwned
 	}
 	return nil
 }
 
 // === Get trashed ===
-func GetTrashedPekerjaanAdmin() ([]model.PekerjaanAlumniTrashed, error) {
-	rows, err := database.DB.Query(`
+func (r *pekerjaanRepository) GetTrashed() ([]model.PekerjaanAlumniTrashed, error) {
+	rows, err := r.db.Query(`
 		SELECT id, alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja, gaji_range,
 		       tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan,
 			   is_deleted, created_at, updated_at
 		FROM pekerjaan_alumni
-		WHERE is_deleted = TRUE
+		WHERE is_deleted = TRUE AND ` + alumniNotTrashed + `
 		ORDER BY updated_at DESC
 	`)
 	if err != nil {
@@ -296,13 +488,13 @@ func GetTrashedPekerjaanAdmin() ([]model.PekerjaanAlumniTrashed, error) {
 	return list, nil
 }
 
-func GetTrashedPekerjaanUser(alumniID int) ([]model.PekerjaanAlumniTrashed, error) {
-	rows, err := database.DB.Query(`
+func (r *pekerjaanRepository) GetTrashedByAlumniID(alumniID int) ([]model.PekerjaanAlumniTrashed, error) {
+	rows, err := r.db.Query(`
 		SELECT id, alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja, gaji_range,
 		       tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan,
 			   is_deleted, created_at, updated_at
 		FROM pekerjaan_alumni
-		WHERE is_deleted = TRUE AND alumni_id = $1
+		WHERE is_deleted = TRUE AND alumni_id = $1 AND `+alumniNotTrashed+`
 		ORDER BY updated_at DESC
 	`, alumniID)
 	if err != nil {
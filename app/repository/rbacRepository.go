@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"backendgo/apperror"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+// ErrRoleNotFound role tidak ada (atau role bawaan untuk DeleteRole)
+var ErrRoleNotFound = apperror.NotFound("rbac.role_not_found")
+
+// RBACRepository akses tabel roles, permissions, dan role_permissions
+type RBACRepository interface {
+	RolePermissionMap() (map[string][]string, error)
+	GetAllRoles() ([]model.Role, error)
+	GetRoleByName(name string) (*model.Role, error)
+	GetAllPermissions() ([]model.Permission, error)
+	CreateRole(req model.RoleRequest) error
+	UpdateRole(name string, req model.RoleRequest) error
+	DeleteRole(name string) error
+	CountUsersWithRole(name string) (int, error)
+}
+
+type rbacRepository struct {
+	db *sql.DB
+}
+
+func NewRBACRepository(db *sql.DB) RBACRepository {
+	return &rbacRepository{db: db}
+}
+
+// ===================================================
+// 🔹 Peta role → daftar permission (untuk middleware)
+// ===================================================
+func (r *rbacRepository) RolePermissionMap() (map[string][]string, error) {
+	rows, err := r.db.Query(`
+		SELECT r.name, rp.permission_code
+		FROM roles r
+		JOIN role_permissions rp ON rp.role_name = r.name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := map[string][]string{}
+	for rows.Next() {
+		var role, perm string
+		if err := rows.Scan(&role, &perm); err != nil {
+			return nil, err
+		}
+		result[role] = append(result[role], perm)
+	}
+	return result, rows.Err()
+}
+
+// ===================================================
+// 🔹 Ambil semua role beserta permission-nya
+// ===================================================
+func (r *rbacRepository) GetAllRoles() ([]model.Role, error) {
+	rows, err := r.db.Query(`
+		SELECT r.name, r.description, r.is_system, r.created_at,
+		       COALESCE(array_agg(rp.permission_code ORDER BY rp.permission_code)
+		                FILTER (WHERE rp.permission_code IS NOT NULL), '{}')
+		FROM roles r
+		LEFT JOIN role_permissions rp ON rp.role_name = r.name
+		GROUP BY r.name, r.description, r.is_system, r.created_at
+		ORDER BY r.name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.Role
+	for rows.Next() {
+		var role model.Role
+		var perms pq.StringArray
+		if err := rows.Scan(&role.Name, &role.Description, &role.IsSystem, &role.CreatedAt, &perms); err != nil {
+			return nil, err
+		}
+		role.Permissions = perms
+		list = append(list, role)
+	}
+	return list, rows.Err()
+}
+
+// ===================================================
+// 🔹 Ambil role berdasarkan nama
+// ===================================================
+func (r *rbacRepository) GetRoleByName(name string) (*model.Role, error) {
+	var role model.Role
+	var perms pq.StringArray
+	err := r.db.QueryRow(`
+		SELECT r.name, r.description, r.is_system, r.created_at,
+		       COALESCE(array_agg(rp.permission_code ORDER BY rp.permission_code)
+		                FILTER (WHERE rp.permission_code IS NOT NULL), '{}')
+		FROM roles r
+		LEFT JOIN role_permissions rp ON rp.role_name = r.name
+		WHERE r.name = $1
+		GROUP BY r.name, r.description, r.is_system, r.created_at
+	`, name).Scan(&role.Name, &role.Description, &role.IsSystem, &role.CreatedAt, &perms)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRoleNotFound
+		}
+		return nil, err
+	}
+	role.Permissions = perms
+	return &role, nil
+}
+
+// ===================================================
+// 🔹 Ambil semua permission
+// ===================================================
+func (r *rbacRepository) GetAllPermissions() ([]model.Permission, error) {
+	rows, err := r.db.Query(`SELECT code, description FROM permissions ORDER BY code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.Permission
+	for rows.Next() {
+		var p model.Permission
+		if err := rows.Scan(&p.Code, &p.Description); err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
+
+// ===================================================
+// 🔹 Buat role baru
+// ===================================================
+func (r *rbacRepository) CreateRole(req model.RoleRequest) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec(`
+		INSERT INTO roles (name, description, is_system, created_at)
+		VALUES ($1, $2, FALSE, NOW())
+	`, req.Name, req.Description); err != nil {
+		return err
+	}
+	if err = setRolePermissionsTx(tx, req.Name, req.Permissions); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ===================================================
+// 🔹 Update deskripsi + ganti seluruh permission role
+// ===================================================
+func (r *rbacRepository) UpdateRole(name string, req model.RoleRequest) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`UPDATE roles SET description = $1 WHERE name = $2`, req.Description, name)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrRoleNotFound
+	}
+	if err = setRolePermissionsTx(tx, name, req.Permissions); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func setRolePermissionsTx(tx *sql.Tx, role string, perms []string) error {
+	if _, err := tx.Exec(`DELETE FROM role_permissions WHERE role_name = $1`, role); err != nil {
+		return err
+	}
+	for _, perm := range perms {
+		if _, err := tx.Exec(`
+			INSERT INTO role_permissions (role_name, permission_code)
+			VALUES ($1, $2) ON CONFLICT DO NOTHING
+		`, role, perm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ===================================================
+// 🔹 Hapus role
+// ===================================================
+func (r *rbacRepository) DeleteRole(name string) error {
+	result, err := r.db.Exec(`DELETE FROM roles WHERE name = $1 AND is_system = FALSE`, name)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrRoleNotFound
+	}
+	return nil
+}
+
+// ===================================================
+// 🔹 Hitung user yang memakai role
+// ===================================================
+func (r *rbacRepository) CountUsersWithRole(name string) (int, error) {
+	var total int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM users WHERE role = $1`, name).Scan(&total)
+	return total, err
+}
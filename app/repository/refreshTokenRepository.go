@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"backendgo/apperror"
+	"database/sql"
+	"time"
+)
+
+// ErrRefreshTokenNotFound refresh token tidak ada di database (palsu atau sudah dihapus)
+var ErrRefreshTokenNotFound = apperror.Unauthorized("auth.refresh_invalid")
+
+// RefreshTokenRepository akses tabel refresh_tokens; satu family = satu sesi login
+type RefreshTokenRepository interface {
+	Create(userID int, tokenHash, familyID string, expiresAt time.Time) error
+	GetByHash(tokenHash string) (*model.RefreshToken, error)
+	Rotate(usedID int, newTokenHash string, expiresAt time.Time) (bool, error)
+	RevokeFamily(familyID string) error
+	RevokeAllForUser(userID int) error
+	GetActiveSession(familyID string) (*model.ActiveSession, error)
+}
+
+type refreshTokenRepository struct {
+	db *sql.DB
+}
+
+func NewRefreshTokenRepository(db *sql.DB) RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+// ===================================================
+// 🔹 Simpan refresh token baru
+// ===================================================
+func (r *refreshTokenRepository) Create(userID int, tokenHash, familyID string, expiresAt time.Time) error {
+	_, err := r.db.Exec(`
+		INSERT INTO refresh_tokens (user_id, token_hash, family_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+	`, userID, tokenHash, familyID, expiresAt)
+	return err
+}
+
+// ===================================================
+// 🔹 Ambil refresh token berdasarkan hash
+// ===================================================
+func (r *refreshTokenRepository) GetByHash(tokenHash string) (*model.RefreshToken, error) {
+	var t model.RefreshToken
+	var usedAt, revokedAt sql.NullTime
+	err := r.db.QueryRow(`
+		SELECT id, user_id, token_hash, family_id, expires_at, used_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`, tokenHash).Scan(
+		&t.ID, &t.UserID, &t.TokenHash, &t.FamilyID,
+		&t.ExpiresAt, &usedAt, &revokedAt, &t.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRefreshTokenNotFound
+		}
+		return nil, err
+	}
+	if usedAt.Valid {
+		t.UsedAt = &usedAt.Time
+	}
+	if revokedAt.Valid {
+		t.RevokedAt = &revokedAt.Time
+	}
+	return &t, nil
+}
+
+// ===================================================
+// 🔹 Rotasi refresh token
+// ===================================================
+// Token lama ditandai sudah dipakai dan token pengganti disimpan di family yang
+// sama dalam satu transaksi, jadi sesi tidak pernah tertinggal tanpa token aktif.
+// Mengembalikan false (tanpa menyimpan token baru) kalau token lama sudah
+// dipakai/dicabut lebih dulu, misalnya dua request refresh dengan token yang
+// sama datang bersamaan.
+func (r *refreshTokenRepository) Rotate(usedID int, newTokenHash string, expiresAt time.Time) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var userID int
+	var familyID string
+	err = tx.QueryRow(`
+		UPDATE refresh_tokens
+		SET used_at = NOW()
+		WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL
+		RETURNING user_id, family_id
+	`, usedID).Scan(&userID, &familyID)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if _, err = tx.Exec(`
+		INSERT INTO refresh_tokens (user_id, token_hash, family_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+	`, userID, newTokenHash, familyID, expiresAt); err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ===================================================
+// 🔹 Cabut satu sesi (semua token dalam satu family)
+// ===================================================
+func (r *refreshTokenRepository) RevokeFamily(familyID string) error {
+	_, err := r.db.Exec(`
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`, familyID)
+	return err
+}
+
+// ===================================================
+// 🔹 Cabut semua sesi milik user
+// ===================================================
+func (r *refreshTokenRepository) RevokeAllForUser(userID int) error {
+	_, err := r.db.Exec(`
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`, userID)
+	return err
+}
+
+// ===================================================
+// 🔹 Ambil sesi aktif
+// ===================================================
+// Sesi aktif kalau masih ada refresh token terbaru (belum dipakai, belum dicabut,
+// belum kadaluarsa) di family tersebut. Dipakai AuthRequired untuk menolak
+// access token dari sesi yang sudah logout. Role ikut dibaca dari tabel users
+// supaya perubahan role langsung berlaku, begitu juga alumni yang terhubung. Mengembalikan nil kalau sesi tidak aktif.
+func (r *refreshTokenRepository) GetActiveSession(familyID string) (*model.ActiveSession, error) {
+	var s model.ActiveSession
+	err := r.db.QueryRow(`
+		SELECT u.id, u.role, u.is_active, COALESCE(a.id, 0)
+		FROM refresh_tokens rt
+		JOIN users u ON u.id = rt.user_id
+		LEFT JOIN alumni a ON a.user_id = u.id
+		WHERE rt.family_id = $1
+		  AND rt.revoked_at IS NULL
+		  AND rt.used_at IS NULL
+		  AND rt.expires_at > NOW()
+		LIMIT 1
+	`, familyID).Scan(&s.UserID, &s.Role, &s.IsActive, &s.AlumniID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,277 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"backendgo/apperror"
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Error repository pendaftaran
+var (
+	// ErrGraduateNotFound NIM tidak ada di roster; pesannya sama dengan NIM/nama tidak cocok
+	// supaya isi roster tidak bisa ditebak
+	ErrGraduateNotFound         = apperror.BadRequest("registration.roster_mismatch")
+	ErrRegistrationTokenInvalid = apperror.BadRequest("registration.token_invalid")
+	ErrRegistrationNotFound     = apperror.NotFound("registration.not_found")
+	ErrRegistrationNotPending   = apperror.Conflict("registration.not_pending")
+)
+
+// RegistrationRepository akses roster lulusan (graduate_roster) dan pendaftaran mandiri (alumni_registrations)
+type RegistrationRepository interface {
+	UpsertGraduateRoster(list []model.GraduateRoster) (int, error)
+	GetGraduateByNIM(nim string) (*model.GraduateRoster, error)
+	AlumniNIMExists(nim string) (bool, error)
+	HasActive(nim string) (bool, error)
+	Create(req model.RegisterRequest, tokenHash string, expiresAt time.Time) (int, error)
+	VerifyEmail(tokenHash string) (*model.Registration, error)
+	List(status string, limit, offset int) ([]model.Registration, error)
+	Count(status string) (int, error)
+	GetByID(id int) (*model.Registration, error)
+	Approve(id, adminID int, alumni model.CreateAlumniRequest) (model.Alumni, error)
+	Reject(id, adminID int, reason string) error
+}
+
+type registrationRepository struct {
+	db *sql.DB
+}
+
+func NewRegistrationRepository(db *sql.DB) RegistrationRepository {
+	return &registrationRepository{db: db}
+}
+
+// ===================================================
+// 🔹 Roster lulusan
+// ===================================================
+
+// UpsertGraduateRoster simpan / perbarui roster lulusan berdasarkan NIM
+func (r *registrationRepository) UpsertGraduateRoster(list []model.GraduateRoster) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO graduate_roster (nim, nama, jurusan, angkatan, tahun_lulus, imported_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (nim) DO UPDATE
+		SET nama = EXCLUDED.nama, jurusan = EXCLUDED.jurusan, angkatan = EXCLUDED.angkatan,
+		    tahun_lulus = EXCLUDED.tahun_lulus, imported_at = NOW()
+	`)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	for _, g := range list {
+		if _, err := stmt.Exec(g.NIM, g.Nama, g.Jurusan, g.Angkatan, g.TahunLulus); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(list), nil
+}
+
+// GetGraduateByNIM ambil data roster berdasarkan NIM
+func (r *registrationRepository) GetGraduateByNIM(nim string) (*model.GraduateRoster, error) {
+	var g model.GraduateRoster
+	err := r.db.QueryRow(`
+		SELECT nim, nama, jurusan, angkatan, tahun_lulus, imported_at
+		FROM graduate_roster WHERE nim = $1
+	`, nim).Scan(&g.NIM, &g.Nama, &g.Jurusan, &g.Angkatan, &g.TahunLulus, &g.ImportedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrGraduateNotFound
+		}
+		return nil, err
+	}
+	return &g, nil
+}
+
+// AlumniNIMExists cek NIM sudah terdaftar sebagai alumni
+func (r *registrationRepository) AlumniNIMExists(nim string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM alumni WHERE nim = $1)`, nim).Scan(&exists)
+	return exists, err
+}
+
+// ===================================================
+// 🔹 Pendaftaran alumni
+// ===================================================
+const registrationColumns = `
+	id, nim, nama, email, status, email_verified_at, reviewed_by, reviewed_at,
+	reject_reason, alumni_id, created_at, updated_at
+`
+
+func scanRegistration(scanner interface{ Scan(...interface{}) error }) (model.Registration, error) {
+	var r model.Registration
+	var reviewedBy, alumniID sql.NullInt64
+	err := scanner.Scan(
+		&r.ID, &r.NIM, &r.Nama, &r.Email, &r.Status, &r.EmailVerifiedAt, &reviewedBy,
+		&r.ReviewedAt, &r.RejectReason, &alumniID, &r.CreatedAt, &r.UpdatedAt,
+	)
+	if reviewedBy.Valid {
+		id := int(reviewedBy.Int64)
+		r.ReviewedBy = &id
+	}
+	if alumniID.Valid {
+		id := int(alumniID.Int64)
+		r.AlumniID = &id
+	}
+	return r, err
+}
+
+// HasActiveRegistration cek NIM masih punya pendaftaran yang belum selesai
+func (r *registrationRepository) HasActive(nim string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM alumni_registrations
+			WHERE nim = $1 AND status IN ($2, $3)
+		)
+	`, nim, model.RegistrationPendingVerification, model.RegistrationPendingApproval).Scan(&exists)
+	return exists, err
+}
+
+// CreateRegistration simpan pendaftaran baru beserta hash token verifikasi email
+func (r *registrationRepository) Create(req model.RegisterRequest, tokenHash string, expiresAt time.Time) (int, error) {
+	var id int
+	err := r.db.QueryRow(`
+		INSERT INTO alumni_registrations (nim, nama, email, status, verification_token_hash,
+		                                  verification_expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING id
+	`, req.NIM, req.Nama, req.Email, model.RegistrationPendingVerification, tokenHash, expiresAt).Scan(&id)
+	return id, err
+}
+
+// VerifyRegistrationEmail tandai email terverifikasi dan pindahkan ke antrian approval.
+// Token hanya berlaku sekali dan selama belum kadaluarsa.
+func (r *registrationRepository) VerifyEmail(tokenHash string) (*model.Registration, error) {
+	row := r.db.QueryRow(`
+		UPDATE alumni_registrations
+		SET status = $1, email_verified_at = NOW(), verification_token_hash = NULL, updated_at = NOW()
+		WHERE verification_token_hash = $2
+		  AND status = $3
+		  AND verification_expires_at > NOW()
+		RETURNING `+registrationColumns,
+		model.RegistrationPendingApproval, tokenHash, model.RegistrationPendingVerification)
+	reg, err := scanRegistration(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRegistrationTokenInvalid
+		}
+		return nil, err
+	}
+	return &reg, nil
+}
+
+// GetRegistrations daftar pendaftaran (status kosong = semua)
+func (r *registrationRepository) List(status string, limit, offset int) ([]model.Registration, error) {
+	rows, err := r.db.Query(`
+		SELECT `+registrationColumns+`
+		FROM alumni_registrations
+		WHERE ($1::text = '' OR status = $1)
+		ORDER BY created_at ASC
+		LIMIT $2 OFFSET $3
+	`, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []model.Registration{}
+	for rows.Next() {
+		reg, err := scanRegistration(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, reg)
+	}
+	return list, rows.Err()
+}
+
+// CountRegistrations total pendaftaran sesuai status (untuk pagination)
+func (r *registrationRepository) Count(status string) (int, error) {
+	var total int
+	err := r.db.QueryRow(`
+		SELECT COUNT(*) FROM alumni_registrations WHERE ($1::text = '' OR status = $1)
+	`, status).Scan(&total)
+	return total, err
+}
+
+// GetRegistrationByID ambil satu pendaftaran
+func (r *registrationRepository) GetByID(id int) (*model.Registration, error) {
+	row := r.db.QueryRow(`SELECT `+registrationColumns+` FROM alumni_registrations WHERE id = $1`, id)
+	reg, err := scanRegistration(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRegistrationNotFound
+		}
+		return nil, err
+	}
+	return &reg, nil
+}
+
+// ApproveRegistration buat alumni + user dari pendaftaran dalam satu transaksi.
+// Gagal dengan ErrRegistrationNotPending kalau pendaftaran sudah diproses admin lain.
+func (r *registrationRepository) Approve(id, adminID int, alumni model.CreateAlumniRequest) (model.Alumni, error) {
+	ctx := context.Background()
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return model.Alumni{}, err
+	}
+	defer tx.Rollback()
+
+	var status string
+	err = tx.QueryRowContext(ctx, `SELECT status FROM alumni_registrations WHERE id = $1 FOR UPDATE`, id).Scan(&status)
+	if err == sql.ErrNoRows {
+		return model.Alumni{}, ErrRegistrationNotFound
+	}
+	if err != nil {
+		return model.Alumni{}, err
+	}
+	if status != model.RegistrationPendingApproval {
+		return model.Alumni{}, ErrRegistrationNotPending
+	}
+
+	newAlumni, err := createAlumniTx(ctx, tx, alumni)
+	if err != nil {
+		return model.Alumni{}, err
+	}
+
+	if _, err = tx.ExecContext(ctx, `
+		UPDATE alumni_registrations
+		SET status = $1, reviewed_by = $2, reviewed_at = NOW(), alumni_id = $3, updated_at = NOW()
+		WHERE id = $4
+	`, model.RegistrationApproved, adminID, newAlumni.ID, id); err != nil {
+		return model.Alumni{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.Alumni{}, err
+	}
+	return newAlumni, nil
+}
+
+// RejectRegistration tolak pendaftaran yang masih berjalan
+func (r *registrationRepository) Reject(id, adminID int, reason string) error {
+	result, err := r.db.Exec(`
+		UPDATE alumni_registrations
+		SET status = $1, reviewed_by = $2, reviewed_at = NOW(), reject_reason = $3,
+		    verification_token_hash = NULL, updated_at = NOW()
+		WHERE id = $4 AND status IN ($5, $6)
+	`, model.RegistrationRejected, adminID, reason, id,
+		model.RegistrationPendingVerification, model.RegistrationPendingApproval)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrRegistrationNotPending
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"backendgo/app/model"
+	"database/sql"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// SearchRepository pencarian full-text gabungan alumni + pekerjaan
+type SearchRepository interface {
+	SearchAll(term string, types []string, limit, offset int) ([]model.SearchResult, int, error)
+}
+
+type searchRepository struct {
+	db *sql.DB
+}
+
+func NewSearchRepository(db *sql.DB) SearchRepository {
+	return &searchRepository{db: db}
+}
+
+// Penanda awal / akhir potongan yang cocok dari ts_headline. Teks di-escape HTML dulu,
+// baru penanda diganti <mark>, supaya isi data tidak bisa menyisipkan HTML.
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+var headlineOptions = fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter=\" … \"",
+	highlightStart, highlightStop)
+
+// Sub-query per tipe. $1 = kata kunci, $2 = opsi ts_headline.
+// Skor = ts_rank (full-text) + word_similarity (trigram, toleran salah ketik).
+// Alumni di trash, pekerjaan yang di-soft delete, dan pekerjaan milik alumni di trash
+// tidak ikut dicari.
+const (
+	searchAlumniSQL = `
+		SELECT 'alumni' AS type, a.id, a.id AS alumni_id, a.nama AS title,
+		       a.nim || ' · ' || a.jurusan AS subtitle,
+		       ts_headline('simple', a.nama || ' ' || a.nim || ' ' || a.jurusan, q.ts, $2) AS highlight,
+		       ts_rank(a.search_vector, q.ts)
+		         + GREATEST(word_similarity(q.term, a.nama), word_similarity(q.term, a.nim)) AS score
+		FROM alumni a, q
+		WHERE a.is_deleted = FALSE
+		  AND (a.search_vector @@ q.ts OR q.term <% a.nama OR q.term <% a.nim)`
+
+	searchPekerjaanSQL = `
+		SELECT 'pekerjaan' AS type, p.id, p.alumni_id, p.nama_perusahaan AS title,
+		       p.posisi_jabatan AS subtitle,
+		       ts_headline('indonesian',
+		           p.nama_perusahaan || ' ' || p.posisi_jabatan || ' ' || coalesce(p.deskripsi_pekerjaan, ''),
+		           q.ts || q.ts_id, $2) AS highlight,
+		       ts_rank(p.search_vector, q.ts || q.ts_id)
+		         + GREATEST(word_similarity(q.term, p.nama_perusahaan), word_similarity(q.term, p.posisi_jabatan)) AS score
+		FROM pekerjaan_alumni p, q
+		WHERE p.is_deleted = FALSE
+		  AND NOT EXISTS (SELECT 1 FROM alumni a WHERE a.id = p.alumni_id AND a.is_deleted)
+		  AND (p.search_vector @@ (q.ts || q.ts_id) OR q.term <% p.nama_perusahaan OR q.term <% p.posisi_jabatan)`
+)
+
+func searchUnion(types []string) string {
+	var parts []string
+	for _, t := range types {
+		switch t {
+		case model.SearchTypeAlumni:
+			parts = append(parts, searchAlumniSQL)
+		case model.SearchTypePekerjaan:
+			parts = append(parts, searchPekerjaanSQL)
+		}
+	}
+	return `
+		WITH q AS (
+			SELECT websearch_to_tsquery('simple', $1) AS ts,
+			       websearch_to_tsquery('indonesian', $1) AS ts_id,
+			       $1::text AS term
+		)
+		SELECT * FROM (` + strings.Join(parts, "\n\t\tUNION ALL") + `
+		) AS results`
+}
+
+// ===================================================
+// 🔹 Pencarian gabungan alumni + pekerjaan (urut skor)
+// ===================================================
+func (r *searchRepository) SearchAll(term string, types []string, limit, offset int) ([]model.SearchResult, int, error) {
+	if len(types) == 0 {
+		return []model.SearchResult{}, 0, nil
+	}
+	union := searchUnion(types)
+
+	var total int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM (`+union+`) AS c`, term, headlineOptions).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.Query(union+`
+		ORDER BY score DESC, type, id
+		LIMIT $3 OFFSET $4
+	`, term, headlineOptions, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	list := []model.SearchResult{}
+	for rows.Next() {
+		var res model.SearchResult
+		if err := rows.Scan(&res.Type, &res.ID, &res.AlumniID, &res.Title, &res.Subtitle, &res.Highlight, &res.Score); err != nil {
+			return nil, 0, err
+		}
+		res.Highlight = SafeHighlight(res.Highlight)
+		list = append(list, res)
+	}
+	return list, total, rows.Err()
+}
+
+// SafeHighlight escape HTML hasil ts_headline lalu ubah penanda menjadi <mark>
+func SafeHighlight(s string) string {
+	s = html.EscapeString(s)
+	s = strings.ReplaceAll(s, highlightStart, "<mark>")
+	return strings.ReplaceAll(s, highlightStop, "</mark>")
+}
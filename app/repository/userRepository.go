@@ -2,35 +2,333 @@ package repository
 
 import (
 	"backendgo/app/model"
-	"backendgo/database"
+	"backendgo/apperror"
 	"database/sql"
-	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// Error repository user; sudah bertipe apperror sehingga service cukup meneruskannya
+var (
+	ErrUserNotFound        = apperror.NotFound("user.not_found")
+	ErrAlumniNotFound      = apperror.NotFound("alumni.not_found")
+	ErrAlumniAlreadyLinked = apperror.Conflict("user.alumni_already_linked")
+	ErrUserAlreadyLinked   = apperror.Conflict("user.already_linked")
+	ErrAlumniNotLinked     = apperror.NotFound("user.not_linked")
 )
 
-// GetUserByUsernameOrEmail ambil user dari database pakai username atau email
-func GetUserByUsernameOrEmail(identifier string) (*model.User, error) {
+// UserRepository akses data tabel users (beserta relasi ke alumni)
+type UserRepository interface {
+	GetByUsernameOrEmail(identifier string) (*model.User, error)
+	GetByID(id int) (*model.User, error)
+	GetDetailByID(id int) (*model.UserDetail, error)
+	List(f model.UserFilter, limit, offset int) ([]model.UserDetail, error)
+	Count(f model.UserFilter) (int, error)
+	IsUsernameOrEmailTaken(username, email string) (bool, error)
+	Create(username, email, passwordHash, role string) (int, error)
+	UpdatePassword(userID int, passwordHash string, mustChange bool) error
+	UpdateRole(userID int, role string) error
+	SetActive(userID int, active bool) error
+	LinkAlumni(userID, alumniID int) error
+	UnlinkAlumni(userID int) error
+	ExistingIDs(ids []int) (map[int]bool, error)
+}
+
+type userRepository struct {
+	db *sql.DB
+}
+
+func NewUserRepository(db *sql.DB) UserRepository {
+	return &userRepository{db: db}
+}
+
+// GetByUsernameOrEmail ambil user dari database pakai username atau email
+func (r *userRepository) GetByUsernameOrEmail(identifier string) (*model.User, error) {
 	query := `
-		SELECT id, username, email, password_hash, role, created_at
+		SELECT id, username, email, password_hash, role, must_change_password, totp_enabled, is_active, created_at
 		FROM users
 		WHERE username = $1 OR email = $1
 	`
 
 	var user model.User
-	err := database.DB.QueryRow(query, identifier).Scan(
+	err := r.db.QueryRow(query, identifier).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Email,
 		&user.PasswordHash, 
 		&user.Role,
+		&user.MustChangePassword,
+		&user.TOTPEnabled,
+		&user.IsActive,
 		&user.CreatedAt,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, err
 	}
 
 	return &user, nil
 }
+
+// GetByID ambil user berdasarkan ID (dipakai saat refresh token)
+func (r *userRepository) GetByID(id int) (*model.User, error) {
+	var user model.User
+	err := r.db.QueryRow(`
+		SELECT id, username, email, password_hash, role, must_change_password, totp_enabled, is_active, created_at
+		FROM users
+		WHERE id = $1
+	`, id).Scan(
+		&user.ID,
+		&user.Username,
+		&user.Email,
+		&user.PasswordHash,
+		&user.Role,
+		&user.MustChangePassword,
+		&user.TOTPEnabled,
+		&user.IsActive,
+		&user.CreatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// UpdatePassword simpan hash password baru dan set flag wajib ganti password
+func (r *userRepository) UpdatePassword(userID int, passwordHash string, mustChange bool) error {
+	result, err := r.db.Exec(`
+		UPDATE users
+		SET password_hash = $1, must_change_password = $2,
+		    password_changed_at = NOW(), updated_at = NOW()
+		WHERE id = $3
+	`, passwordHash, mustChange, userID)
+	if err != nil {
+		return err
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// ===================================================
+// 🔹 Manajemen user (admin)
+// ===================================================
+const userDetailColumns = `
+	u.id, u.username, u.email, u.role, u.is_active, u.must_change_password,
+	u.totp_enabled, a.id, u.created_at, u.updated_at
+`
+
+func scanUserDetail(scanner interface{ Scan(...interface{}) error }) (model.UserDetail, error) {
+	var u model.UserDetail
+	var alumniID sql.NullInt64
+	err := scanner.Scan(
+		&u.ID, &u.Username, &u.Email, &u.Role, &u.IsActive, &u.MustChangePassword,
+		&u.TOTPEnabled, &alumniID, &u.CreatedAt, &u.UpdatedAt,
+	)
+	if alumniID.Valid {
+		id := int(alumniID.Int64)
+		u.AlumniID = &id
+	}
+	return u, err
+}
+
+// userFilterClause menyusun WHERE dari filter; argumen dimulai dari $1
+func userFilterClause(f model.UserFilter) (string, []interface{}) {
+	where := "WHERE (u.username ILIKE $1 OR u.email ILIKE $1)"
+	args := []interface{}{"%" + f.Search + "%"}
+	if f.Role != "" {
+		args = append(args, f.Role)
+		where += fmt.Sprintf(" AND u.role = $%d", len(args))
+	}
+	if f.IsActive != nil {
+		args = append(args, *f.IsActive)
+		where += fmt.Sprintf(" AND u.is_active = $%d", len(args))
+	}
+	return where, args
+}
+
+// List daftar user dengan pencarian, filter role/status dan pagination
+func (r *userRepository) List(f model.UserFilter, limit, offset int) ([]model.UserDetail, error) {
+	where, args := userFilterClause(f)
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM users u
+		LEFT JOIN alumni a ON a.user_id = u.id
+		%s
+		ORDER BY u.id
+		LIMIT $%d OFFSET $%d
+	`, userDetailColumns, where, len(args)-1, len(args))
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []model.UserDetail{}
+	for rows.Next() {
+		u, err := scanUserDetail(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, u)
+	}
+	return list, rows.Err()
+}
+
+// Count total user sesuai filter (untuk pagination)
+func (r *userRepository) Count(f model.UserFilter) (int, error) {
+	where, args := userFilterClause(f)
+	var total int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM users u `+where, args...).Scan(&total)
+	return total, err
+}
+
+// GetDetailByID detail user beserta alumni yang terhubung
+func (r *userRepository) GetDetailByID(id int) (*model.UserDetail, error) {
+	row := r.db.QueryRow(`
+		SELECT `+userDetailColumns+`
+		FROM users u
+		LEFT JOIN alumni a ON a.user_id = u.id
+		WHERE u.id = $1
+	`, id)
+	u, err := scanUserDetail(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+// IsUsernameOrEmailTaken cek username / email sudah dipakai user lain
+func (r *userRepository) IsUsernameOrEmailTaken(username, email string) (bool, error) {
+	var taken bool
+	err := r.db.QueryRow(`
+		SELECT EXISTS (SELECT 1 FROM users WHERE username = $1 OR email = $2)
+	`, username, email).Scan(&taken)
+	return taken, err
+}
+
+// Create buat user baru; password awal wajib diganti saat login pertama
+func (r *userRepository) Create(username, email, passwordHash, role string) (int, error) {
+	var id int
+	err := r.db.QueryRow(`
+		INSERT INTO users (username, email, password_hash, role, must_change_password, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, TRUE, TRUE, NOW(), NOW())
+		RETURNING id
+	`, username, email, passwordHash, role).Scan(&id)
+	return id, err
+}
+
+// UpdateRole ganti role user
+func (r *userRepository) UpdateRole(userID int, role string) error {
+	result, err := r.db.Exec(`
+		UPDATE users SET role = $1, updated_at = NOW() WHERE id = $2
+	`, role, userID)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// SetActive aktifkan / nonaktifkan user
+func (r *userRepository) SetActive(userID int, active bool) error {
+	result, err := r.db.Exec(`
+		UPDATE users SET is_active = $1, updated_at = NOW() WHERE id = $2
+	`, active, userID)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// LinkAlumni hubungkan user ke alumni. Gagal kalau alumni sudah terhubung
+// ke user lain atau user sudah punya alumni.
+func (r *userRepository) LinkAlumni(userID, alumniID int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var current sql.NullInt64
+	err = tx.QueryRow(`SELECT user_id FROM alumni WHERE id = $1 FOR UPDATE`, alumniID).Scan(&current)
+	if err == sql.ErrNoRows {
+		return ErrAlumniNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if current.Valid && int(current.Int64) != userID {
+		return ErrAlumniAlreadyLinked
+	}
+
+	var linked bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (SELECT 1 FROM alumni WHERE user_id = $1 AND id <> $2)
+	`, userID, alumniID).Scan(&linked)
+	if err != nil {
+		return err
+	}
+	if linked {
+		return ErrUserAlreadyLinked
+	}
+
+	if _, err = tx.Exec(`UPDATE alumni SET user_id = $1, updated_at = NOW() WHERE id = $2`, userID, alumniID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UnlinkAlumni lepaskan hubungan user dengan alumni
+func (r *userRepository) UnlinkAlumni(userID int) error {
+	result, err := r.db.Exec(`
+		UPDATE alumni SET user_id = NULL, updated_at = NOW() WHERE user_id = $1
+	`, userID)
+	if err != nil {
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrAlumniNotLinked
+	}
+	return nil
+}
+
+// ExistingIDs dari daftar id, mana saja yang masih ada di tabel users
+func (r *userRepository) ExistingIDs(ids []int) (map[int]bool, error) {
+	rows, err := r.db.Query(`SELECT id FROM users WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	exists := map[int]bool{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		exists[id] = true
+	}
+	return exists, rows.Err()
+}
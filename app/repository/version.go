@@ -0,0 +1,7 @@
+package repository
+
+import "backendgo/apperror"
+
+// ErrVersionConflict versi data (ETag) yang dikirim client sudah tidak sama dengan
+// versi di database, artinya data sudah diubah orang lain sejak terakhir dibaca.
+var ErrVersionConflict = apperror.PreconditionFailed("common.version_conflict")
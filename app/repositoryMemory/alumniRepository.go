@@ -0,0 +1,365 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/config"
+	"backendgo/utils"
+	"sort"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type alumniRepository struct {
+	store *Store
+}
+
+func NewAlumniRepository(store *Store) repository.AlumniRepository {
+	return &alumniRepository{store: store}
+}
+
+// active semua alumni yang tidak di trash (padanan WHERE is_deleted = FALSE)
+func (r *alumniRepository) active() []model.Alumni {
+	list := []model.Alumni{}
+	for _, a := range r.store.alumni {
+		if !a.IsDeleted {
+			list = append(list, a.Alumni)
+		}
+	}
+	return list
+}
+
+// ===================================================
+// 🔹 Get All Alumni (terbaru dulu)
+// ===================================================
+func (r *alumniRepository) GetAll() ([]model.Alumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	list := r.active()
+	sort.SliceStable(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.After(list[j].CreatedAt)
+		}
+		return list[i].ID > list[j].ID
+	})
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list, nil
+}
+
+// ===================================================
+// 🔹 Get Alumni by ID
+// ===================================================
+func (r *alumniRepository) GetByID(id int) (model.Alumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if i := r.store.activeAlumniIndex(id); i >= 0 {
+		return r.store.alumni[i].Alumni, nil
+	}
+	return model.Alumni{}, repository.ErrAlumniNotFound
+}
+
+// ===================================================
+// 🔹 Create Alumni (+ user otomatis, sama dengan createAlumniTx)
+// ===================================================
+func (r *alumniRepository) Create(a model.CreateAlumniRequest) (model.Alumni, error) {
+	// cost minimum cukup untuk test; password tetap bisa dicek dengan bcrypt
+	hash, err := bcrypt.GenerateFromPassword([]byte(config.GetEnv("DEFAULT_ALUMNI_PASSWORD", "123456")), bcrypt.MinCost)
+	if err != nil {
+		return model.Alumni{}, err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.users {
+		if u.Username == a.Nama {
+			return model.Alumni{}, errDuplicate("users", "username")
+		}
+		if u.Email == a.Email {
+			return model.Alumni{}, errDuplicate("users", "email")
+		}
+	}
+	for _, existing := range s.alumni {
+		if existing.NIM == a.NIM {
+			return model.Alumni{}, errDuplicate("alumni", "nim")
+		}
+	}
+
+	ts := now()
+	user := userRow{User: model.User{
+		ID:                 s.nextID("users"),
+		Username:           a.Nama,
+		Email:              a.Email,
+		PasswordHash:       string(hash),
+		Role:               "user",
+		MustChangePassword: true,
+		IsActive:           true,
+		CreatedAt:          ts,
+	}, UpdatedAt: ts}
+	s.users = append(s.users, user)
+
+	alumni := model.Alumni{
+		ID:             s.nextID("alumni"),
+		UserID:         user.ID,
+		NIM:            a.NIM,
+		Nama:           a.Nama,
+		Jurusan:        a.Jurusan,
+		Angkatan:       a.Angkatan,
+		TahunLulus:     a.TahunLulus,
+		Email:          a.Email,
+		NoTelepon:      a.NoTelepon,
+		Alamat:         a.Alamat,
+		StatusKematian: a.StatusKematian,
+		CreatedAt:      ts,
+		UpdatedAt:      ts,
+		Version:        1,
+	}
+	s.alumni = append(s.alumni, alumniRow{Alumni: alumni})
+	return alumni, nil
+}
+
+// ===================================================
+// 🔹 Update Alumni
+// ===================================================
+func (r *alumniRepository) Update(a model.UpdateAlumniRequest, version int) (model.Alumni, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.activeAlumniIndex(a.ID)
+	if i < 0 {
+		return model.Alumni{}, repository.ErrAlumniNotFound
+	}
+	if version != 0 && s.alumni[i].Version != version {
+		return s.alumni[i].Alumni, repository.ErrVersionConflict
+	}
+	for _, existing := range s.alumni {
+		if existing.NIM == a.NIM && existing.ID != a.ID {
+			return model.Alumni{}, errDuplicate("alumni", "nim")
+		}
+	}
+
+	row := &s.alumni[i]
+	row.NIM, row.Nama, row.Jurusan = a.NIM, a.Nama, a.Jurusan
+	row.Angkatan, row.TahunLulus = a.Angkatan, a.TahunLulus
+	row.Email, row.NoTelepon, row.Alamat = a.Email, a.NoTelepon, a.Alamat
+	row.StatusKematian = a.StatusKematian
+	row.UpdatedAt = now()
+	row.Version++
+	return row.Alumni, nil
+}
+
+// ===================================================
+// 🔹 Soft delete, restore, hard delete
+// ===================================================
+
+// SoftDelete alumni aktif, pekerjaan yang belum di-trash ikut di-trash
+func (r *alumniRepository) SoftDelete(id int) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.activeAlumniIndex(id)
+	if i < 0 {
+		return repository.ErrAlumniNotFound
+	}
+	ts := now()
+	s.alumni[i].IsDeleted, s.alumni[i].DeletedAt = true, &ts
+	s.alumni[i].UpdatedAt = ts
+	s.alumni[i].Version++
+
+	for j := range s.pekerjaan {
+		if p := &s.pekerjaan[j]; p.AlumniID == id && !p.IsDeleted {
+			p.IsDeleted, p.DeletedWithAlumni = true, true
+			p.UpdatedAt = ts
+			p.Version++
+		}
+	}
+	return nil
+}
+
+// Restore alumni dari trash beserta pekerjaan yang ikut di-trash bersamanya
+func (r *alumniRepository) Restore(id int) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.alumniIndex(id)
+	if i < 0 || !s.alumni[i].IsDeleted {
+		return repository.ErrAlumniNotTrashed
+	}
+	ts := now()
+	s.alumni[i].IsDeleted, s.alumni[i].DeletedAt = false, nil
+	s.alumni[i].UpdatedAt = ts
+	s.alumni[i].Version++
+
+	for j := range s.pekerjaan {
+		if p := &s.pekerjaan[j]; p.AlumniID == id && p.DeletedWithAlumni {
+			p.IsDeleted, p.DeletedWithAlumni = false, false
+			p.UpdatedAt = ts
+			p.Version++
+		}
+	}
+	return nil
+}
+
+// HardDelete alumni di trash beserta akun user (kecuali admin) dan pekerjaannya
+func (r *alumniRepository) HardDelete(id int) (model.Alumni, bool, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.alumniIndex(id)
+	if i < 0 || !s.alumni[i].IsDeleted {
+		return model.Alumni{}, false, repository.ErrAlumniNotTrashed
+	}
+	deleted := s.alumni[i].Alumni
+	s.alumni = append(s.alumni[:i], s.alumni[i+1:]...)
+
+	// padanan ON DELETE CASCADE pekerjaan_alumni.alumni_id
+	kept := s.pekerjaan[:0]
+	for _, p := range s.pekerjaan {
+		if p.AlumniID != id {
+			kept = append(kept, p)
+		}
+	}
+	s.pekerjaan = kept
+
+	if u := s.userIndex(deleted.UserID); u >= 0 && s.users[u].Role == model.RoleUser {
+		s.deleteUserAt(u)
+		return deleted, true, nil
+	}
+	return deleted, false, nil
+}
+
+// ===================================================
+// 🔹 Trash (terakhir dihapus dulu)
+// ===================================================
+func (r *alumniRepository) GetTrashed() ([]model.AlumniTrashed, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var list []model.AlumniTrashed
+	for _, a := range r.store.alumni {
+		if a.IsDeleted {
+			list = append(list, a.trashed())
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		if !list[i].DeletedAt.Equal(*list[j].DeletedAt) {
+			return list[i].DeletedAt.After(*list[j].DeletedAt)
+		}
+		return list[i].ID > list[j].ID
+	})
+	return list, nil
+}
+
+func (r *alumniRepository) GetTrashedByID(id int) (model.AlumniTrashed, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.store.alumniIndex(id)
+	if i < 0 || !r.store.alumni[i].IsDeleted {
+		return model.AlumniTrashed{}, repository.ErrAlumniNotTrashed
+	}
+	return r.store.alumni[i].trashed(), nil
+}
+
+// trashed baris trash; DeletedAt selalu terisi selama IsDeleted
+func (a alumniRow) trashed() model.AlumniTrashed {
+	return model.AlumniTrashed{Alumni: a.Alumni, IsDeleted: a.IsDeleted, DeletedAt: a.DeletedAt}
+}
+
+// ===================================================
+// 🔹 Update Status Kematian
+// ===================================================
+func (r *alumniRepository) UpdateStatusKematian(id int, status bool) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.activeAlumniIndex(id)
+	if i < 0 {
+		return repository.ErrAlumniNotFound
+	}
+	s.alumni[i].StatusKematian = status
+	s.alumni[i].UpdatedAt = now()
+	s.alumni[i].Version++
+	return nil
+}
+
+// ===================================================
+// 🔹 Update kontak alumni (self-service, field nil = tidak diubah)
+// ===================================================
+func (r *alumniRepository) UpdateContact(id int, req model.UpdateMyAlumniRequest) (model.Alumni, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.activeAlumniIndex(id)
+	if i < 0 {
+		return model.Alumni{}, repository.ErrAlumniNotFound
+	}
+	row := &s.alumni[i]
+	if req.Email != nil {
+		row.Email = *req.Email
+	}
+	if req.NoTelepon != nil {
+		row.NoTelepon = *req.NoTelepon
+	}
+	if req.Alamat != nil {
+		row.Alamat = *req.Alamat
+	}
+	row.UpdatedAt = now()
+	row.Version++
+	return row.Alumni, nil
+}
+
+// ===================================================
+// 🔹 List, count, cursor, dan stream (lihat AlumniListSpec)
+// ===================================================
+func (r *alumniRepository) selectAll(q repository.ListQuery) []model.Alumni {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return repository.SelectInMemory(q, r.active(), repository.AlumniColumnValue)
+}
+
+func (r *alumniRepository) List(q repository.ListQuery, limit, offset int) ([]model.Alumni, error) {
+	return paginate(r.selectAll(q), limit, offset), nil
+}
+
+func (r *alumniRepository) Count(q repository.ListQuery) (int, error) {
+	return len(r.selectAll(q)), nil
+}
+
+func (r *alumniRepository) ListCursor(q repository.ListQuery, cur *utils.Cursor, limit int) ([]model.Alumni, utils.CursorPage, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return repository.CursorInMemory(q, r.active(), repository.AlumniColumnValue, cur, limit)
+}
+
+func (r *alumniRepository) Stream(q repository.ListQuery, fn func(model.Alumni) error) error {
+	for _, a := range r.selectAll(q) {
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paginate padanan LIMIT / OFFSET (nil kalau halaman kosong, sama dengan hasil scan SQL)
+func paginate[T any](rows []T, limit, offset int) []T {
+	if offset >= len(rows) {
+		return nil
+	}
+	end := offset + limit
+	if end > len(rows) {
+		end = len(rows)
+	}
+	return rows[offset:end]
+}
@@ -0,0 +1,32 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+)
+
+type auditRepository struct {
+	store *Store
+}
+
+func NewAuditRepository(store *Store) repository.AuditRepository {
+	return &auditRepository{store: store}
+}
+
+func (r *auditRepository) Create(entry model.AuditLog) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry.ID = s.nextID("audit_logs")
+	entry.CreatedAt = now()
+	s.auditLogs = append(s.auditLogs, entry)
+	return nil
+}
+
+// AuditLogs salinan audit log yang sudah tercatat, untuk diperiksa di test
+func (s *Store) AuditLogs() []model.AuditLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]model.AuditLog(nil), s.auditLogs...)
+}
@@ -0,0 +1,95 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/modelmongo"
+	"backendgo/app/repositoryMongo"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type fileRepository struct {
+	store *Store
+}
+
+func NewFileRepository(store *Store) repositoryMongo.FileRepository {
+	return &fileRepository{store: store}
+}
+
+func (r *fileRepository) Create(file *modelmongo.File) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// presisi milidetik seperti BSON datetime
+	file.UploadedAt = time.Now().Truncate(time.Millisecond)
+	file.ID = primitive.NewObjectID()
+	r.store.files = append(r.store.files, *file)
+	return nil
+}
+
+func (r *fileRepository) FindAll() ([]modelmongo.File, error) {
+	return r.find(func(modelmongo.File) bool { return true }), nil
+}
+
+func (r *fileRepository) FindByUser(userID int) ([]modelmongo.File, error) {
+	return r.find(func(f modelmongo.File) bool { return f.UserID == userID }), nil
+}
+
+func (r *fileRepository) find(keep func(modelmongo.File) bool) []modelmongo.File {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var files []modelmongo.File
+	for _, f := range r.store.files {
+		if keep(f) {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (r *fileRepository) Delete(id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repositoryMongo.ErrFileInvalidID
+	}
+	for i, f := range r.store.files {
+		if f.ID == objID {
+			r.store.files = append(r.store.files[:i], r.store.files[i+1:]...)
+			return nil
+		}
+	}
+	return repositoryMongo.ErrFileNotFound
+}
+
+func (r *fileRepository) SetDeletedByUser(userID int, deleted bool) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i := range r.store.files {
+		if r.store.files[i].UserID == userID {
+			r.store.files[i].IsDeleted = deleted
+		}
+	}
+	return nil
+}
+
+func (r *fileRepository) DeleteByUser(userID int) ([]modelmongo.File, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var deleted []modelmongo.File
+	kept := r.store.files[:0]
+	for _, f := range r.store.files {
+		if f.UserID == userID {
+			deleted = append(deleted, f)
+		} else {
+			kept = append(kept, f)
+		}
+	}
+	r.store.files = kept
+	return deleted, nil
+}
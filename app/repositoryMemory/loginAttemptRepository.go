@@ -0,0 +1,67 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"time"
+)
+
+type loginAttemptRepository struct {
+	store *Store
+}
+
+func NewLoginAttemptRepository(store *Store) repository.LoginAttemptRepository {
+	return &loginAttemptRepository{store: store}
+}
+
+func attemptKey(scope, key string) string {
+	return scope + "|" + key
+}
+
+func (r *loginAttemptRepository) Get(scope, key string) (*model.LoginAttempt, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	a, ok := r.store.loginAttempts[attemptKey(scope, key)]
+	if !ok {
+		return nil, nil
+	}
+	return &a, nil
+}
+
+// RecordFailure padanan INSERT ... ON CONFLICT DO UPDATE di repository PostgreSQL
+func (r *loginAttemptRepository) RecordFailure(scope, key string, window time.Duration) (model.LoginAttempt, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	ts := time.Now()
+	a, ok := r.store.loginAttempts[attemptKey(scope, key)]
+	expiredLock := a.LockedUntil != nil && a.LockedUntil.Before(ts)
+	if !ok || a.LastFailureAt.Before(ts.Add(-window)) || expiredLock {
+		a = model.LoginAttempt{Scope: scope, Key: key}
+	}
+	a.Failures++
+	a.LastFailureAt = ts
+	r.store.loginAttempts[attemptKey(scope, key)] = a
+	return a, nil
+}
+
+func (r *loginAttemptRepository) Lock(scope, key string, until time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if a, ok := r.store.loginAttempts[attemptKey(scope, key)]; ok {
+		a.LockedUntil = &until
+		r.store.loginAttempts[attemptKey(scope, key)] = a
+	}
+	return nil
+}
+
+func (r *loginAttemptRepository) Clear(scope, key string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	_, ok := r.store.loginAttempts[attemptKey(scope, key)]
+	delete(r.store.loginAttempts, attemptKey(scope, key))
+	return ok, nil
+}
@@ -0,0 +1,142 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"time"
+)
+
+type mfaRepository struct {
+	store *Store
+}
+
+func NewMFARepository(store *Store) repository.MFARepository {
+	return &mfaRepository{store: store}
+}
+
+func (r *mfaRepository) GetTOTP(userID int) (model.UserTOTP, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	t := model.UserTOTP{UserID: userID}
+	i := r.store.userIndex(userID)
+	if i < 0 {
+		return t, repository.ErrUserNotFound
+	}
+	u := r.store.users[i]
+	t.Secret, t.Enabled, t.LastCounter = u.TOTPSecret, u.TOTPEnabled, u.TOTPLastCounter
+	return t, nil
+}
+
+// setTOTP ubah kolom TOTP user; user yang tidak ada diabaikan seperti UPDATE tanpa baris
+func (r *mfaRepository) setTOTP(userID int, secret string, enabled bool, counter int64) {
+	if i := r.store.userIndex(userID); i >= 0 {
+		u := &r.store.users[i]
+		u.TOTPSecret, u.TOTPEnabled, u.TOTPLastCounter = secret, enabled, counter
+		u.UpdatedAt = now()
+	}
+}
+
+func (r *mfaRepository) SetTOTPSecret(userID int, secret string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.setTOTP(userID, secret, false, 0)
+	return nil
+}
+
+func (r *mfaRepository) EnableTOTP(userID int, counter int64, recoveryCodeHashes []string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if i := r.store.userIndex(userID); i >= 0 {
+		r.setTOTP(userID, r.store.users[i].TOTPSecret, true, counter)
+	}
+	r.replaceRecoveryCodes(userID, recoveryCodeHashes)
+	return nil
+}
+
+func (r *mfaRepository) DisableTOTP(userID int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.setTOTP(userID, "", false, 0)
+	r.replaceRecoveryCodes(userID, nil)
+	return nil
+}
+
+func (r *mfaRepository) ConsumeTOTPCounter(userID int, counter int64) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.store.userIndex(userID)
+	if i < 0 || r.store.users[i].TOTPLastCounter >= counter {
+		return false, nil
+	}
+	r.store.users[i].TOTPLastCounter = counter
+	return true, nil
+}
+
+func (r *mfaRepository) ReplaceRecoveryCodes(userID int, codeHashes []string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.replaceRecoveryCodes(userID, codeHashes)
+	return nil
+}
+
+func (r *mfaRepository) replaceRecoveryCodes(userID int, codeHashes []string) {
+	kept := r.store.recoveryCodes[:0]
+	for _, c := range r.store.recoveryCodes {
+		if c.UserID != userID {
+			kept = append(kept, c)
+		}
+	}
+	for _, hash := range codeHashes {
+		kept = append(kept, recoveryCodeRow{UserID: userID, CodeHash: hash})
+	}
+	r.store.recoveryCodes = kept
+}
+
+func (r *mfaRepository) UseRecoveryCode(userID int, codeHash string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i, c := range r.store.recoveryCodes {
+		if c.UserID == userID && c.CodeHash == codeHash && c.UsedAt == nil {
+			ts := now()
+			r.store.recoveryCodes[i].UsedAt = &ts
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *mfaRepository) CountUnusedRecoveryCodes(userID int) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	total := 0
+	for _, c := range r.store.recoveryCodes {
+		if c.UserID == userID && c.UsedAt == nil {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func (r *mfaRepository) ConsumeChallenge(userID int, jti string, expiresAt time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for used, exp := range r.store.mfaChallenges {
+		if exp.Before(time.Now()) {
+			delete(r.store.mfaChallenges, used)
+		}
+	}
+	if _, ok := r.store.mfaChallenges[jti]; ok {
+		return false, nil
+	}
+	r.store.mfaChallenges[jti] = expiresAt
+	return true, nil
+}
@@ -0,0 +1,64 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"time"
+)
+
+type passwordResetRepository struct {
+	store *Store
+}
+
+func NewPasswordResetRepository(store *Store) repository.PasswordResetRepository {
+	return &passwordResetRepository{store: store}
+}
+
+// Create token lama user yang belum dipakai ikut dibatalkan, sama seperti transaksi PostgreSQL
+func (r *passwordResetRepository) Create(userID, createdBy int, tokenHash string, expiresAt time.Time) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts := now()
+	for i, t := range s.passwordResets {
+		if t.UserID == userID && t.UsedAt == nil {
+			s.passwordResets[i].UsedAt = &ts
+		}
+	}
+	s.passwordResets = append(s.passwordResets, model.PasswordResetToken{
+		ID:        s.nextID("password_reset_tokens"),
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedBy: createdBy,
+		CreatedAt: ts,
+	})
+	return nil
+}
+
+func (r *passwordResetRepository) GetByHash(tokenHash string) (*model.PasswordResetToken, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, t := range r.store.passwordResets {
+		if t.TokenHash == tokenHash {
+			return &t, nil
+		}
+	}
+	return nil, repository.ErrResetTokenNotFound
+}
+
+func (r *passwordResetRepository) MarkUsed(id int) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i, t := range r.store.passwordResets {
+		if t.ID == id && t.UsedAt == nil {
+			ts := now()
+			r.store.passwordResets[i].UsedAt = &ts
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,252 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/modelmongo"
+	"backendgo/app/repositoryMongo"
+	"backendgo/utils"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type pekerjaanMongoRepository struct {
+	store *Store
+}
+
+func NewPekerjaanMongoRepository(store *Store) repositoryMongo.PekerjaanMongoRepository {
+	return &pekerjaanMongoRepository{store: store}
+}
+
+func (r *pekerjaanMongoRepository) Create(data modelmongo.PekerjaanAlumni) (*modelmongo.PekerjaanAlumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// presisi milidetik seperti BSON datetime
+	data.ID = primitive.NewObjectID()
+	data.CreatedAt = time.Now().Truncate(time.Millisecond)
+	data.UpdatedAt = data.CreatedAt
+	data.IsDeleted = false
+	r.store.pekerjaanMongo = append(r.store.pekerjaanMongo, data)
+	return &data, nil
+}
+
+func (r *pekerjaanMongoRepository) find(keep func(modelmongo.PekerjaanAlumni) bool) []modelmongo.PekerjaanAlumni {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	result := []modelmongo.PekerjaanAlumni{}
+	for _, p := range r.store.pekerjaanMongo {
+		if keep(p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func (r *pekerjaanMongoRepository) GetAll() ([]modelmongo.PekerjaanAlumni, error) {
+	return r.find(func(p modelmongo.PekerjaanAlumni) bool { return !p.IsDeleted }), nil
+}
+
+func (r *pekerjaanMongoRepository) GetByAlumni(alumniIDStr string) ([]modelmongo.PekerjaanAlumni, error) {
+	alumniID, err := strconv.Atoi(alumniIDStr)
+	if err != nil {
+		return nil, err
+	}
+	return r.find(func(p modelmongo.PekerjaanAlumni) bool { return p.AlumniID == alumniID && !p.IsDeleted }), nil
+}
+
+func (r *pekerjaanMongoRepository) GetTrashed() ([]modelmongo.PekerjaanAlumni, error) {
+	return r.find(func(p modelmongo.PekerjaanAlumni) bool { return p.IsDeleted }), nil
+}
+
+func (r *pekerjaanMongoRepository) GetTrashedByAlumni(alumniID int) ([]modelmongo.PekerjaanAlumni, error) {
+	return r.find(func(p modelmongo.PekerjaanAlumni) bool { return p.AlumniID == alumniID && p.IsDeleted }), nil
+}
+
+// index posisi dokumen _id = id; -1 kalau tidak ada (seperti filter yang tidak cocok)
+func (r *pekerjaanMongoRepository) index(id string) (int, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return -1, err
+	}
+	for i, p := range r.store.pekerjaanMongo {
+		if p.ID == objID {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+func (r *pekerjaanMongoRepository) GetByID(id string) (*modelmongo.PekerjaanAlumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i, err := r.index(id)
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	p := r.store.pekerjaanMongo[i]
+	return &p, nil
+}
+
+// update padanan UpdateByID: dokumen yang tidak ada bukan error
+func (r *pekerjaanMongoRepository) update(id string, apply func(p *modelmongo.PekerjaanAlumni)) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i, err := r.index(id)
+	if err != nil || i < 0 {
+		return err
+	}
+	apply(&r.store.pekerjaanMongo[i])
+	r.store.pekerjaanMongo[i].UpdatedAt = time.Now().Truncate(time.Millisecond)
+	return nil
+}
+
+func (r *pekerjaanMongoRepository) Update(id string, req modelmongo.UpdatePekerjaanRequest) error {
+	return r.update(id, func(p *modelmongo.PekerjaanAlumni) {
+		if req.NamaPerusahaan != nil {
+			p.NamaPerusahaan = *req.NamaPerusahaan
+		}
+		if req.PosisiJabatan != nil {
+			p.PosisiJabatan = *req.PosisiJabatan
+		}
+		if req.BidangIndustri != nil {
+			p.BidangIndustri = *req.BidangIndustri
+		}
+		if req.LokasiKerja != nil {
+			p.LokasiKerja = *req.LokasiKerja
+		}
+		if req.GajiRange != nil {
+			p.GajiRange = *req.GajiRange
+		}
+		if req.TanggalMulaiKerja != nil {
+			p.TanggalMulaiKerja = parseTanggal(*req.TanggalMulaiKerja)
+		}
+		if req.TanggalSelesaiKerja != nil {
+			p.TanggalSelesaiKerja = parseTanggal(*req.TanggalSelesaiKerja)
+		}
+		if req.StatusPekerjaan != nil {
+			p.StatusPekerjaan = *req.StatusPekerjaan
+		}
+		if req.DeskripsiPekerjaan != nil {
+			p.DeskripsiPekerjaan = *req.DeskripsiPekerjaan
+		}
+	})
+}
+
+// parseTanggal YYYY-MM-DD dari request; string kosong berarti tanggal dikosongkan
+func parseTanggal(s string) *time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func (r *pekerjaanMongoRepository) SoftDelete(id string) error {
+	return r.update(id, func(p *modelmongo.PekerjaanAlumni) { p.IsDeleted = true })
+}
+
+func (r *pekerjaanMongoRepository) Restore(id string) error {
+	return r.update(id, func(p *modelmongo.PekerjaanAlumni) { p.IsDeleted = false })
+}
+
+func (r *pekerjaanMongoRepository) HardDelete(id string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i, err := r.index(id)
+	if err != nil || i < 0 {
+		return err
+	}
+	r.store.pekerjaanMongo = append(r.store.pekerjaanMongo[:i], r.store.pekerjaanMongo[i+1:]...)
+	return nil
+}
+
+// Page padanan GetPekerjaanMongoPage: urut (created_at, _id) dengan arah yang sama
+func (r *pekerjaanMongoRepository) Page(cur *utils.Cursor, order string, limit int) ([]modelmongo.PekerjaanAlumni, utils.CursorPage, error) {
+	reverse := cur != nil && cur.Dir == utils.CursorPrev
+	asc := (order == "asc") != reverse
+
+	var createdAt time.Time
+	var lastID primitive.ObjectID
+	if cur != nil {
+		var err error
+		if createdAt, lastID, err = repositoryMongo.ParsePekerjaanMongoCursor(cur); err != nil {
+			return nil, utils.CursorPage{}, err
+		}
+	}
+	// before true kalau a berada sebelum b dalam urutan halaman
+	before := func(a, b modelmongo.PekerjaanAlumni) bool {
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt) == asc
+		}
+		return (a.ID.Hex() < b.ID.Hex()) == asc
+	}
+
+	list := r.find(func(p modelmongo.PekerjaanAlumni) bool {
+		if p.IsDeleted {
+			return false
+		}
+		if cur == nil {
+			return true
+		}
+		return before(modelmongo.PekerjaanAlumni{CreatedAt: createdAt, ID: lastID}, p)
+	})
+	sort.SliceStable(list, func(i, j int) bool { return before(list[i], list[j]) })
+	if len(list) > limit+1 {
+		list = list[:limit+1]
+	}
+
+	list, page := utils.BuildCursorPage(list, limit, cur, repositoryMongo.PekerjaanMongoSortKey(order), repositoryMongo.PekerjaanMongoCursorValues)
+	return list, page, nil
+}
+
+func (r *pekerjaanMongoRepository) SoftDeleteByAlumni(alumniID int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i, p := range r.store.pekerjaanMongo {
+		if p.AlumniID == alumniID && !p.IsDeleted {
+			r.store.pekerjaanMongo[i].IsDeleted = true
+			r.store.pekerjaanMongo[i].DeletedWithAlumni = true
+			r.store.pekerjaanMongo[i].UpdatedAt = time.Now().Truncate(time.Millisecond)
+		}
+	}
+	return nil
+}
+
+func (r *pekerjaanMongoRepository) RestoreByAlumni(alumniID int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i, p := range r.store.pekerjaanMongo {
+		if p.AlumniID == alumniID && p.DeletedWithAlumni {
+			r.store.pekerjaanMongo[i].IsDeleted = false
+			r.store.pekerjaanMongo[i].DeletedWithAlumni = false
+			r.store.pekerjaanMongo[i].UpdatedAt = time.Now().Truncate(time.Millisecond)
+		}
+	}
+	return nil
+}
+
+func (r *pekerjaanMongoRepository) DeleteByAlumni(alumniID int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	kept := r.store.pekerjaanMongo[:0]
+	for _, p := range r.store.pekerjaanMongo {
+		if p.AlumniID != alumniID {
+			kept = append(kept, p)
+		}
+	}
+	r.store.pekerjaanMongo = kept
+	return nil
+}
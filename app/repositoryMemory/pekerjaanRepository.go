@@ -0,0 +1,301 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/utils"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type pekerjaanRepository struct {
+	store *Store
+}
+
+func NewPekerjaanRepository(store *Store) repository.PekerjaanRepository {
+	return &pekerjaanRepository{store: store}
+}
+
+// rows semua pekerjaan termasuk yang di-soft delete, kecuali milik alumni yang ada
+// di trash (sama seperti query SQL-nya)
+func (r *pekerjaanRepository) rows(keep func(pekerjaanRow) bool) []model.PekerjaanAlumni {
+	list := []model.PekerjaanAlumni{}
+	for _, p := range r.store.pekerjaan {
+		if !r.store.alumniTrashed(p.AlumniID) && (keep == nil || keep(p)) {
+			list = append(list, p.PekerjaanAlumni)
+		}
+	}
+	return list
+}
+
+func newestFirst(list []model.PekerjaanAlumni) []model.PekerjaanAlumni {
+	sort.SliceStable(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.After(list[j].CreatedAt)
+		}
+		return list[i].ID > list[j].ID
+	})
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
+
+// ===================================================
+// 🔹 Get All / By ID / By Alumni
+// ===================================================
+func (r *pekerjaanRepository) GetAll() ([]model.PekerjaanAlumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return newestFirst(r.rows(nil)), nil
+}
+
+func (r *pekerjaanRepository) GetByID(id int) (model.PekerjaanAlumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if i := r.store.pekerjaanIndex(id); i >= 0 {
+		return r.store.pekerjaan[i].PekerjaanAlumni, nil
+	}
+	return model.PekerjaanAlumni{}, sql.ErrNoRows
+}
+
+func (r *pekerjaanRepository) GetByAlumniID(alumniID int) ([]model.PekerjaanAlumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return newestFirst(r.rows(func(p pekerjaanRow) bool { return p.AlumniID == alumniID })), nil
+}
+
+// ===================================================
+// 🔹 Create / Update / Delete
+// ===================================================
+func (r *pekerjaanRepository) Create(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// padanan FOREIGN KEY alumni_id
+	if s.alumniIndex(p.AlumniID) < 0 {
+		return p, &pq.Error{
+			Code:    "23503",
+			Message: "insert or update on table \"pekerjaan_alumni\" violates foreign key constraint",
+			Table:   "pekerjaan_alumni",
+		}
+	}
+	p.ID = s.nextID("pekerjaan_alumni")
+	p.Version = 1
+	p.CreatedAt = p.CreatedAt.Truncate(time.Microsecond)
+	p.UpdatedAt = p.UpdatedAt.Truncate(time.Microsecond)
+	s.pekerjaan = append(s.pekerjaan, pekerjaanRow{PekerjaanAlumni: p})
+	return p, nil
+}
+
+// set kolom yang bisa diubah; alumni_id dan created_at tetap, version naik
+// (padanan trigger trg_pekerjaan_version)
+func (row *pekerjaanRow) set(p model.PekerjaanAlumni) {
+	row.NamaPerusahaan, row.PosisiJabatan = p.NamaPerusahaan, p.PosisiJabatan
+	row.BidangIndustri, row.LokasiKerja, row.GajiRange = p.BidangIndustri, p.LokasiKerja, p.GajiRange
+	row.TanggalMulaiKerja, row.TanggalSelesaiKerja = p.TanggalMulaiKerja, p.TanggalSelesaiKerja
+	row.StatusPekerjaan, row.DeskripsiPekerjaan = p.StatusPekerjaan, p.DeskripsiPekerjaan
+	row.UpdatedAt = p.UpdatedAt.Truncate(time.Microsecond)
+	row.Version++
+}
+
+func (r *pekerjaanRepository) Update(p model.PekerjaanAlumni, version int) (model.PekerjaanAlumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.store.pekerjaanIndex(p.ID)
+	if i < 0 {
+		return p, sql.ErrNoRows
+	}
+	if version != 0 && r.store.pekerjaan[i].Version != version {
+		return r.store.pekerjaan[i].PekerjaanAlumni, repository.ErrVersionConflict
+	}
+	r.store.pekerjaan[i].set(p)
+	return r.store.pekerjaan[i].PekerjaanAlumni, nil
+}
+
+func (r *pekerjaanRepository) UpdateOwned(p model.PekerjaanAlumni) (model.PekerjaanAlumni, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.owned(p.ID, p.AlumniID)
+	if i < 0 || r.store.pekerjaan[i].IsDeleted {
+		return p, repository.ErrPekerjaanNotOwned
+	}
+	r.store.pekerjaan[i].set(p)
+	return r.store.pekerjaan[i].PekerjaanAlumni, nil
+}
+
+func (r *pekerjaanRepository) Delete(id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if i := r.store.pekerjaanIndex(id); i >= 0 {
+		r.store.pekerjaan = append(r.store.pekerjaan[:i], r.store.pekerjaan[i+1:]...)
+	}
+	return nil
+}
+
+// owned index pekerjaan id milik alumniID, -1 kalau tidak ada / bukan miliknya
+func (r *pekerjaanRepository) owned(id, alumniID int) int {
+	i := r.store.pekerjaanIndex(id)
+	if i < 0 || r.store.pekerjaan[i].AlumniID != alumniID {
+		return -1
+	}
+	return i
+}
+
+// ===================================================
+// 🔹 List, count, cursor, dan stream (lihat PekerjaanListSpec)
+// ===================================================
+func (r *pekerjaanRepository) selectAll(q repository.ListQuery) []model.PekerjaanAlumni {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return repository.SelectInMemory(q, r.rows(nil), repository.PekerjaanColumnValue)
+}
+
+func (r *pekerjaanRepository) List(q repository.ListQuery, limit, offset int) ([]model.PekerjaanAlumni, error) {
+	return paginate(r.selectAll(q), limit, offset), nil
+}
+
+func (r *pekerjaanRepository) Count(q repository.ListQuery) (int, error) {
+	return len(r.selectAll(q)), nil
+}
+
+func (r *pekerjaanRepository) ListCursor(q repository.ListQuery, cur *utils.Cursor, limit int) ([]model.PekerjaanAlumni, utils.CursorPage, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return repository.CursorInMemory(q, r.rows(nil), repository.PekerjaanColumnValue, cur, limit)
+}
+
+func (r *pekerjaanRepository) Stream(q repository.ListQuery, fn func(model.PekerjaanAlumni) error) error {
+	for _, p := range r.selectAll(q) {
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ===================================================
+// 🔹 Soft delete, restore, hard delete
+// ===================================================
+func (r *pekerjaanRepository) setDeleted(i int, deleted bool) {
+	r.store.pekerjaan[i].IsDeleted = deleted
+	r.store.pekerjaan[i].UpdatedAt = now()
+	r.store.pekerjaan[i].Version++
+}
+
+func (r *pekerjaanRepository) SoftDelete(id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if i := r.store.pekerjaanIndex(id); i >= 0 {
+		r.setDeleted(i, true)
+	}
+	return nil
+}
+
+func (r *pekerjaanRepository) SoftDeleteOwned(id, alumniID int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.owned(id, alumniID)
+	if i < 0 {
+		return repository.ErrPekerjaanNotOwned
+	}
+	r.setDeleted(i, true)
+	return nil
+}
+
+func (r *pekerjaanRepository) Restore(id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if i := r.store.pekerjaanIndex(id); i >= 0 && !r.store.pekerjaan[i].DeletedWithAlumni {
+		r.setDeleted(i, false)
+	}
+	return nil
+}
+
+func (r *pekerjaanRepository) RestoreOwned(id, alumniID int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.owned(id, alumniID)
+	if i < 0 || r.store.pekerjaan[i].DeletedWithAlumni {
+		return repository.ErrPekerjaanNotOwned
+	}
+	r.setDeleted(i, false)
+	return nil
+}
+
+func (r *pekerjaanRepository) HardDelete(id int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.store.pekerjaanIndex(id)
+	if i < 0 || !r.store.pekerjaan[i].IsDeleted {
+		return repository.ErrPekerjaanNotTrashed
+	}
+	r.store.pekerjaan = append(r.store.pekerjaan[:i], r.store.pekerjaan[i+1:]...)
+	return nil
+}
+
+func (r *pekerjaanRepository) HardDeleteOwned(id, alumniID int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.owned(id, alumniID)
+	if i < 0 || !r.store.pekerjaan[i].IsDeleted {
+		return repository.ErrPekerjaanNotOwned
+	}
+	r.store.pekerjaan = append(r.store.pekerjaan[:i], r.store.pekerjaan[i+1:]...)
+	return nil
+}
+
+// ===================================================
+// 🔹 Trash (terakhir dihapus dulu)
+// ===================================================
+func (r *pekerjaanRepository) trashed(keep func(pekerjaanRow) bool) []model.PekerjaanAlumniTrashed {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var list []model.PekerjaanAlumniTrashed
+	for _, p := range r.store.pekerjaan {
+		if !p.IsDeleted || r.store.alumniTrashed(p.AlumniID) || !keep(p) {
+			continue
+		}
+		list = append(list, model.PekerjaanAlumniTrashed{
+			ID:                  p.ID,
+			AlumniID:            p.AlumniID,
+			NamaPerusahaan:      p.NamaPerusahaan,
+			PosisiJabatan:       p.PosisiJabatan,
+			BidangIndustri:      p.BidangIndustri,
+			LokasiKerja:         p.LokasiKerja,
+			GajiRange:           p.GajiRange,
+			TanggalMulaiKerja:   p.TanggalMulaiKerja,
+			TanggalSelesaiKerja: p.TanggalSelesaiKerja,
+			StatusPekerjaan:     p.StatusPekerjaan,
+			DeskripsiPekerjaan:  p.DeskripsiPekerjaan,
+			IsDeleted:           true,
+			CreatedAt:           p.CreatedAt,
+			UpdatedAt:           p.UpdatedAt,
+		})
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].UpdatedAt.After(list[j].UpdatedAt) })
+	return list
+}
+
+func (r *pekerjaanRepository) GetTrashed() ([]model.PekerjaanAlumniTrashed, error) {
+	return r.trashed(func(pekerjaanRow) bool { return true }), nil
+}
+
+func (r *pekerjaanRepository) GetTrashedByAlumniID(alumniID int) ([]model.PekerjaanAlumniTrashed, error) {
+	return r.trashed(func(p pekerjaanRow) bool { return p.AlumniID == alumniID }), nil
+}
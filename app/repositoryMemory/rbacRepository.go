@@ -0,0 +1,176 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"sort"
+)
+
+type rbacRepository struct {
+	store *Store
+}
+
+// NewRBACRepository role dan permission di memori. Store baru sudah berisi role bawaan
+// dan permission yang dibuat migrasi (lihat seedRBAC).
+func NewRBACRepository(store *Store) repository.RBACRepository {
+	return &rbacRepository{store: store}
+}
+
+// seedRBAC padanan data awal migrasi 0006 / 0008 / 0012: admin dapat semua permission,
+// user hanya baca alumni dan pekerjaan
+func seedRBAC(s *Store) {
+	s.permissions = []model.Permission{
+		{Code: model.PermAlumniRead, Description: "Melihat data alumni"},
+		{Code: model.PermAlumniWrite, Description: "Menambah dan mengubah data alumni"},
+		{Code: model.PermAlumniDelete, Description: "Menghapus data alumni"},
+		{Code: model.PermAlumniHardDelete, Description: "Menghapus permanen alumni beserta akun user dan datanya"},
+		{Code: model.PermPekerjaanRead, Description: "Melihat data pekerjaan alumni"},
+		{Code: model.PermPekerjaanWrite, Description: "Menambah dan mengubah pekerjaan semua alumni"},
+		{Code: model.PermPekerjaanDelete, Description: "Menghapus pekerjaan semua alumni"},
+		{Code: model.PermPekerjaanManageAll, Description: "Soft delete, restore dan melihat trash pekerjaan semua alumni"},
+		{Code: model.PermPekerjaanHardDelete, Description: "Menghapus permanen pekerjaan semua alumni"},
+		{Code: model.PermFilesReadAll, Description: "Melihat file milik semua user"},
+		{Code: model.PermFilesManageAll, Description: "Mengupload dan menghapus file milik user lain"},
+		{Code: model.PermUsersManage, Description: "Mengelola akun user (reset password, unlock, ganti role)"},
+		{Code: model.PermRolesManage, Description: "Mengelola role dan permission"},
+		{Code: model.PermRegistrationsManage, Description: "Mengelola roster lulusan dan menyetujui / menolak pendaftaran alumni"},
+	}
+	sort.Slice(s.permissions, func(i, j int) bool { return s.permissions[i].Code < s.permissions[j].Code })
+
+	all := make([]string, len(s.permissions))
+	for i, p := range s.permissions {
+		all[i] = p.Code
+	}
+	ts := now()
+	s.roles = []model.Role{
+		{Name: model.RoleAdmin, Description: "Administrator, akses penuh", Permissions: all, IsSystem: true, CreatedAt: ts},
+		{Name: model.RoleUser, Description: "Alumni, akses data miliknya sendiri", Permissions: []string{model.PermAlumniRead, model.PermPekerjaanRead}, IsSystem: true, CreatedAt: ts},
+	}
+}
+
+func (s *Store) roleIndex(name string) int {
+	for i, r := range s.roles {
+		if r.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// rolePermissions padanan role_permissions: tanpa duplikat, urut seperti array_agg ORDER BY
+func rolePermissions(perms []string) []string {
+	seen := map[string]bool{}
+	list := []string{}
+	for _, p := range perms {
+		if !seen[p] {
+			seen[p] = true
+			list = append(list, p)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (r *rbacRepository) RolePermissionMap() (map[string][]string, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	result := map[string][]string{}
+	for _, role := range r.store.roles {
+		if len(role.Permissions) > 0 {
+			result[role.Name] = append([]string(nil), role.Permissions...)
+		}
+	}
+	return result, nil
+}
+
+func (r *rbacRepository) GetAllRoles() ([]model.Role, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var list []model.Role
+	for _, role := range r.store.roles {
+		role.Permissions = append([]string{}, role.Permissions...)
+		list = append(list, role)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+func (r *rbacRepository) GetRoleByName(name string) (*model.Role, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.store.roleIndex(name)
+	if i < 0 {
+		return nil, repository.ErrRoleNotFound
+	}
+	role := r.store.roles[i]
+	role.Permissions = append([]string{}, role.Permissions...)
+	return &role, nil
+}
+
+func (r *rbacRepository) GetAllPermissions() ([]model.Permission, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	return append([]model.Permission(nil), r.store.permissions...), nil
+}
+
+func (r *rbacRepository) CreateRole(req model.RoleRequest) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.roleIndex(req.Name) >= 0 {
+		return errDuplicate("roles", "name")
+	}
+	s.roles = append(s.roles, model.Role{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: rolePermissions(req.Permissions),
+		CreatedAt:   now(),
+	})
+	return nil
+}
+
+func (r *rbacRepository) UpdateRole(name string, req model.RoleRequest) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.roleIndex(name)
+	if i < 0 {
+		return repository.ErrRoleNotFound
+	}
+	s.roles[i].Description = req.Description
+	s.roles[i].Permissions = rolePermissions(req.Permissions)
+	return nil
+}
+
+// DeleteRole role bawaan tidak ikut terhapus, sama seperti WHERE is_system = FALSE
+func (r *rbacRepository) DeleteRole(name string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.roleIndex(name)
+	if i < 0 || s.roles[i].IsSystem {
+		return repository.ErrRoleNotFound
+	}
+	s.roles = append(s.roles[:i], s.roles[i+1:]...)
+	return nil
+}
+
+func (r *rbacRepository) CountUsersWithRole(name string) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	total := 0
+	for _, u := range r.store.users {
+		if u.Role == name {
+			total++
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,117 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"time"
+)
+
+type refreshTokenRepository struct {
+	store *Store
+}
+
+func NewRefreshTokenRepository(store *Store) repository.RefreshTokenRepository {
+	return &refreshTokenRepository{store: store}
+}
+
+func (r *refreshTokenRepository) Create(userID int, tokenHash, familyID string, expiresAt time.Time) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshTokens = append(s.refreshTokens, model.RefreshToken{
+		ID:        s.nextID("refresh_tokens"),
+		UserID:    userID,
+		TokenHash: tokenHash,
+		FamilyID:  familyID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now(),
+	})
+	return nil
+}
+
+func (r *refreshTokenRepository) GetByHash(tokenHash string) (*model.RefreshToken, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, t := range r.store.refreshTokens {
+		if t.TokenHash == tokenHash {
+			return &t, nil
+		}
+	}
+	return nil, repository.ErrRefreshTokenNotFound
+}
+
+// Rotate tandai token lama dan simpan token pengganti di bawah satu lock, padanan transaksi PostgreSQL
+func (r *refreshTokenRepository) Rotate(usedID int, newTokenHash string, expiresAt time.Time) (bool, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, t := range s.refreshTokens {
+		if t.ID != usedID || t.UsedAt != nil || t.RevokedAt != nil {
+			continue
+		}
+		ts := now()
+		s.refreshTokens[i].UsedAt = &ts
+		s.refreshTokens = append(s.refreshTokens, model.RefreshToken{
+			ID:        s.nextID("refresh_tokens"),
+			UserID:    t.UserID,
+			TokenHash: newTokenHash,
+			FamilyID:  t.FamilyID,
+			ExpiresAt: expiresAt,
+			CreatedAt: ts,
+		})
+		return true, nil
+	}
+	return false, nil
+}
+
+// revoke padanan UPDATE ... SET revoked_at = NOW() WHERE <keep> AND revoked_at IS NULL
+func (r *refreshTokenRepository) revoke(keep func(model.RefreshToken) bool) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	ts := now()
+	for i, t := range r.store.refreshTokens {
+		if keep(t) && t.RevokedAt == nil {
+			r.store.refreshTokens[i].RevokedAt = &ts
+		}
+	}
+}
+
+func (r *refreshTokenRepository) RevokeFamily(familyID string) error {
+	r.revoke(func(t model.RefreshToken) bool { return t.FamilyID == familyID })
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeAllForUser(userID int) error {
+	r.revoke(func(t model.RefreshToken) bool { return t.UserID == userID })
+	return nil
+}
+
+// GetActiveSession padanan JOIN users + LEFT JOIN alumni pada token terbaru yang masih aktif
+func (r *refreshTokenRepository) GetActiveSession(familyID string) (*model.ActiveSession, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.refreshTokens {
+		if t.FamilyID != familyID || t.RevokedAt != nil || t.UsedAt != nil || !t.ExpiresAt.After(time.Now()) {
+			continue
+		}
+		u := s.userIndex(t.UserID)
+		if u < 0 {
+			continue
+		}
+		session := model.ActiveSession{UserID: t.UserID, Role: s.users[u].Role, IsActive: s.users[u].IsActive}
+		for _, a := range s.alumni {
+			if a.UserID == t.UserID {
+				session.AlumniID = a.ID
+				break
+			}
+		}
+		return &session, nil
+	}
+	return nil, nil
+}
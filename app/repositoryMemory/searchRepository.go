@@ -0,0 +1,76 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"sort"
+	"strings"
+)
+
+type searchRepository struct {
+	store *Store
+}
+
+// NewSearchRepository pencarian sederhana (substring, tanpa peringkat full-text) dengan
+// aturan data yang sama dengan query PostgreSQL: alumni di trash, pekerjaan yang
+// di-soft delete, dan pekerjaan milik alumni di trash tidak ikut dicari.
+func NewSearchRepository(store *Store) repository.SearchRepository {
+	return &searchRepository{store: store}
+}
+
+// highlight tandai kemunculan pertama term di text dengan penanda ts_headline
+func highlight(text, term string) string {
+	i := strings.Index(strings.ToLower(text), term)
+	if i < 0 {
+		return repository.SafeHighlight(text)
+	}
+	return repository.SafeHighlight(text[:i] + "\x02" + text[i:i+len(term)] + "\x03" + text[i+len(term):])
+}
+
+func (r *searchRepository) SearchAll(term string, types []string, limit, offset int) ([]model.SearchResult, int, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	term = strings.ToLower(strings.TrimSpace(term))
+	list := []model.SearchResult{}
+	for _, t := range types {
+		switch t {
+		case model.SearchTypeAlumni:
+			for _, a := range s.alumni {
+				text := a.Nama + " " + a.NIM + " " + a.Jurusan
+				if a.IsDeleted || !strings.Contains(strings.ToLower(text), term) {
+					continue
+				}
+				list = append(list, model.SearchResult{
+					Type: t, ID: a.ID, AlumniID: a.ID, Title: a.Nama,
+					Subtitle: a.NIM + " · " + a.Jurusan, Highlight: highlight(text, term), Score: 1,
+				})
+			}
+		case model.SearchTypePekerjaan:
+			for _, p := range s.pekerjaan {
+				text := p.NamaPerusahaan + " " + p.PosisiJabatan + " " + p.DeskripsiPekerjaan
+				if p.IsDeleted || s.alumniTrashed(p.AlumniID) || !strings.Contains(strings.ToLower(text), term) {
+					continue
+				}
+				list = append(list, model.SearchResult{
+					Type: t, ID: p.ID, AlumniID: p.AlumniID, Title: p.NamaPerusahaan,
+					Subtitle: p.PosisiJabatan, Highlight: highlight(text, term), Score: 1,
+				})
+			}
+		}
+	}
+
+	// ORDER BY score DESC, type, id
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Type != list[j].Type {
+			return list[i].Type < list[j].Type
+		}
+		return list[i].ID < list[j].ID
+	})
+	page := paginate(list, limit, offset)
+	if page == nil {
+		page = []model.SearchResult{}
+	}
+	return page, len(list), nil
+}
@@ -0,0 +1,168 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/modelmongo"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Store tabel users, alumni, pekerjaan_alumni, tabel sesi/login/2FA/reset password/audit, role/permission, dan koleksi
+// files / pekerjaan_alumni MongoDB di memori. Repository yang dibuat dari Store yang
+// sama saling terhubung seperti foreign key di PostgreSQL, mis. alumni baru ikut
+// membuat user dan hapus permanen alumni ikut menghapus user dan pekerjaannya.
+// Dipakai untuk test tanpa database; semua method aman dipakai bersamaan.
+type Store struct {
+	mu        sync.Mutex
+	users     []userRow
+	alumni    []alumniRow
+	pekerjaan []pekerjaanRow
+	files     []modelmongo.File
+	// koleksi pekerjaan_alumni di MongoDB, terpisah dari tabel pekerjaan_alumni
+	pekerjaanMongo []modelmongo.PekerjaanAlumni
+
+	refreshTokens  []model.RefreshToken
+	passwordResets []model.PasswordResetToken
+	loginAttempts  map[string]model.LoginAttempt // key scope + "|" + key
+	recoveryCodes  []recoveryCodeRow
+	mfaChallenges  map[string]time.Time // jti → expires_at
+	auditLogs      []model.AuditLog
+
+	roles       []model.Role
+	permissions []model.Permission
+
+	seq map[string]int
+}
+
+type userRow struct {
+	model.User
+	UpdatedAt       time.Time
+	TOTPSecret      string
+	TOTPLastCounter int64
+}
+
+type recoveryCodeRow struct {
+	UserID   int
+	CodeHash string
+	UsedAt   *time.Time
+}
+
+type alumniRow struct {
+	model.Alumni
+	IsDeleted bool
+	DeletedAt *time.Time
+}
+
+type pekerjaanRow struct {
+	model.PekerjaanAlumni
+	IsDeleted         bool
+	DeletedWithAlumni bool
+}
+
+func NewStore() *Store {
+	s := &Store{
+		loginAttempts: map[string]model.LoginAttempt{},
+		mfaChallenges: map[string]time.Time{},
+		seq:           map[string]int{},
+	}
+	seedRBAC(s)
+	return s
+}
+
+// nextID padanan SERIAL: id per tabel dimulai dari 1
+func (s *Store) nextID(table string) int {
+	s.seq[table]++
+	return s.seq[table]
+}
+
+// now presisi mikrodetik seperti kolom TIMESTAMP PostgreSQL
+func now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+// errDuplicate padanan pelanggaran UNIQUE constraint (pq.Error 23505 → 409 di ErrorHandler)
+func errDuplicate(table, column string) error {
+	constraint := fmt.Sprintf("%s_%s_key", table, column)
+	return &pq.Error{
+		Code:       "23505",
+		Message:    fmt.Sprintf("duplicate key value violates unique constraint %q", constraint),
+		Table:      table,
+		Constraint: constraint,
+	}
+}
+
+func (s *Store) userIndex(id int) int {
+	for i, u := range s.users {
+		if u.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// alumniIndex index alumni id, termasuk yang ada di trash
+func (s *Store) alumniIndex(id int) int {
+	for i, a := range s.alumni {
+		if a.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// activeAlumniIndex index alumni id yang tidak di trash
+func (s *Store) activeAlumniIndex(id int) int {
+	i := s.alumniIndex(id)
+	if i >= 0 && s.alumni[i].IsDeleted {
+		return -1
+	}
+	return i
+}
+
+// alumniTrashed padanan alumni_id IN (SELECT id FROM alumni WHERE is_deleted = TRUE)
+func (s *Store) alumniTrashed(id int) bool {
+	i := s.alumniIndex(id)
+	return i >= 0 && s.alumni[i].IsDeleted
+}
+
+// deleteUserAt hapus user beserta baris yang ON DELETE CASCADE ke users
+func (s *Store) deleteUserAt(i int) {
+	id := s.users[i].ID
+	s.users = append(s.users[:i], s.users[i+1:]...)
+
+	tokens := s.refreshTokens[:0]
+	for _, t := range s.refreshTokens {
+		if t.UserID != id {
+			tokens = append(tokens, t)
+		}
+	}
+	s.refreshTokens = tokens
+
+	resets := s.passwordResets[:0]
+	for _, t := range s.passwordResets {
+		if t.UserID != id {
+			resets = append(resets, t)
+		}
+	}
+	s.passwordResets = resets
+
+	codes := s.recoveryCodes[:0]
+	for _, c := range s.recoveryCodes {
+		if c.UserID != id {
+			codes = append(codes, c)
+		}
+	}
+	s.recoveryCodes = codes
+}
+
+func (s *Store) pekerjaanIndex(id int) int {
+	for i, p := range s.pekerjaan {
+		if p.ID == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,245 @@
+package repositoryMemory
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"strings"
+)
+
+type userRepository struct {
+	store *Store
+}
+
+func NewUserRepository(store *Store) repository.UserRepository {
+	return &userRepository{store: store}
+}
+
+// ===================================================
+// 🔹 Lookup user
+// ===================================================
+func (r *userRepository) GetByUsernameOrEmail(identifier string) (*model.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, u := range r.store.users {
+		if u.Username == identifier || u.Email == identifier {
+			user := u.User
+			return &user, nil
+		}
+	}
+	return nil, repository.ErrUserNotFound
+}
+
+func (r *userRepository) GetByID(id int) (*model.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.store.userIndex(id)
+	if i < 0 {
+		return nil, repository.ErrUserNotFound
+	}
+	user := r.store.users[i].User
+	return &user, nil
+}
+
+// detail padanan LEFT JOIN alumni a ON a.user_id = u.id
+func (r *userRepository) detail(u userRow) model.UserDetail {
+	d := model.UserDetail{
+		ID:                 u.ID,
+		Username:           u.Username,
+		Email:              u.Email,
+		Role:               u.Role,
+		IsActive:           u.IsActive,
+		MustChangePassword: u.MustChangePassword,
+		TOTPEnabled:        u.TOTPEnabled,
+		CreatedAt:          u.CreatedAt,
+		UpdatedAt:          u.UpdatedAt,
+	}
+	for _, a := range r.store.alumni {
+		if a.UserID == u.ID {
+			id := a.ID
+			d.AlumniID = &id
+			break
+		}
+	}
+	return d
+}
+
+func (r *userRepository) GetDetailByID(id int) (*model.UserDetail, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.store.userIndex(id)
+	if i < 0 {
+		return nil, repository.ErrUserNotFound
+	}
+	d := r.detail(r.store.users[i])
+	return &d, nil
+}
+
+// ===================================================
+// 🔹 Manajemen user (admin)
+// ===================================================
+
+// filter padanan userFilterClause (users disimpan urut id)
+func (r *userRepository) filter(f model.UserFilter) []model.UserDetail {
+	term := strings.ToLower(f.Search)
+	list := []model.UserDetail{}
+	for _, u := range r.store.users {
+		if !strings.Contains(strings.ToLower(u.Username), term) && !strings.Contains(strings.ToLower(u.Email), term) {
+			continue
+		}
+		if f.Role != "" && u.Role != f.Role {
+			continue
+		}
+		if f.IsActive != nil && u.IsActive != *f.IsActive {
+			continue
+		}
+		list = append(list, r.detail(u))
+	}
+	return list
+}
+
+func (r *userRepository) List(f model.UserFilter, limit, offset int) ([]model.UserDetail, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	list := paginate(r.filter(f), limit, offset)
+	if list == nil {
+		list = []model.UserDetail{}
+	}
+	return list, nil
+}
+
+func (r *userRepository) Count(f model.UserFilter) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return len(r.filter(f)), nil
+}
+
+func (r *userRepository) IsUsernameOrEmailTaken(username, email string) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, u := range r.store.users {
+		if u.Username == username || u.Email == email {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *userRepository) Create(username, email, passwordHash, role string) (int, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.users {
+		if u.Username == username {
+			return 0, errDuplicate("users", "username")
+		}
+		if u.Email == email {
+			return 0, errDuplicate("users", "email")
+		}
+	}
+	ts := now()
+	user := userRow{User: model.User{
+		ID:                 s.nextID("users"),
+		Username:           username,
+		Email:              email,
+		PasswordHash:       passwordHash,
+		Role:               role,
+		MustChangePassword: true,
+		IsActive:           true,
+		CreatedAt:          ts,
+	}, UpdatedAt: ts}
+	s.users = append(s.users, user)
+	return user.ID, nil
+}
+
+// update jalankan fn pada user id, ErrUserNotFound kalau tidak ada
+func (r *userRepository) update(id int, fn func(u *userRow)) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	i := r.store.userIndex(id)
+	if i < 0 {
+		return repository.ErrUserNotFound
+	}
+	fn(&r.store.users[i])
+	r.store.users[i].UpdatedAt = now()
+	return nil
+}
+
+func (r *userRepository) UpdatePassword(userID int, passwordHash string, mustChange bool) error {
+	return r.update(userID, func(u *userRow) {
+		u.PasswordHash, u.MustChangePassword = passwordHash, mustChange
+	})
+}
+
+func (r *userRepository) UpdateRole(userID int, role string) error {
+	return r.update(userID, func(u *userRow) { u.Role = role })
+}
+
+func (r *userRepository) SetActive(userID int, active bool) error {
+	return r.update(userID, func(u *userRow) { u.IsActive = active })
+}
+
+// ===================================================
+// 🔹 Relasi user ↔ alumni
+// ===================================================
+func (r *userRepository) LinkAlumni(userID, alumniID int) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.alumniIndex(alumniID)
+	if i < 0 {
+		return repository.ErrAlumniNotFound
+	}
+	if current := s.alumni[i].UserID; current != 0 && current != userID {
+		return repository.ErrAlumniAlreadyLinked
+	}
+	for _, a := range s.alumni {
+		if a.UserID == userID && a.ID != alumniID {
+			return repository.ErrUserAlreadyLinked
+		}
+	}
+	s.alumni[i].UserID = userID
+	s.alumni[i].UpdatedAt = now()
+	s.alumni[i].Version++
+	return nil
+}
+
+func (r *userRepository) UnlinkAlumni(userID int) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	linked := false
+	for i := range s.alumni {
+		if s.alumni[i].UserID == userID {
+			s.alumni[i].UserID = 0
+			s.alumni[i].UpdatedAt = now()
+			s.alumni[i].Version++
+			linked = true
+		}
+	}
+	if !linked {
+		return repository.ErrAlumniNotLinked
+	}
+	return nil
+}
+
+func (r *userRepository) ExistingIDs(ids []int) (map[int]bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	exists := map[int]bool{}
+	for _, id := range ids {
+		if r.store.userIndex(id) >= 0 {
+			exists[id] = true
+		}
+	}
+	return exists, nil
+}
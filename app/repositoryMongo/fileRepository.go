@@ -2,8 +2,8 @@ package repositoryMongo
 
 import (
 	"backendgo/app/modelmongo"
+	"backendgo/apperror"
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -12,11 +12,22 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// Error repository file
+var (
+	ErrFileInvalidID = apperror.BadRequest("common.invalid_id")
+	ErrFileNotFound  = apperror.NotFound("file.not_found")
+)
+
+// FileRepository akses koleksi files. FindAll dan FindByUser ikut mengembalikan file
+// yang di-trash (is_deleted), penyaringan dilakukan di FileService supaya rekonsiliasi
+// tidak menganggap file di trash sebagai file yatim.
 type FileRepository interface {
 	Create(file *modelmongo.File) error
 	FindAll() ([]modelmongo.File, error)
 	FindByUser(userID int) ([]modelmongo.File, error)
 	Delete(id string) error
+	SetDeletedByUser(userID int, deleted bool) error
+	DeleteByUser(userID int) ([]modelmongo.File, error)
 }
 
 type fileRepository struct {
@@ -88,16 +99,42 @@ func (r *fileRepository) Delete(id string) error {
 
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return errors.New("ID tidak valid")
+		return ErrFileInvalidID
 	}
 
 	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objID})
 	if err != nil {
-		return fmt.Errorf("gagal menghapus file: %v", err)
+		return fmt.Errorf("gagal menghapus file: %w", err)
 	}
 
 	if result.DeletedCount == 0 {
-		return errors.New("file tidak ditemukan")
+		return ErrFileNotFound
 	}
 	return nil
 }
+
+// SetDeletedByUser tandai / pulihkan semua file milik user (trash alumni)
+func (r *fileRepository) SetDeletedByUser(userID int, deleted bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.UpdateMany(ctx, bson.M{"user_id": userID}, bson.M{"$set": bson.M{"is_deleted": deleted}})
+	return err
+}
+
+// DeleteByUser hapus semua record file milik user, mengembalikan record yang dihapus
+// supaya file fisiknya bisa ikut dihapus
+func (r *fileRepository) DeleteByUser(userID int) ([]modelmongo.File, error) {
+	files, err := r.FindByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID}); err != nil {
+		return nil, fmt.Errorf("gagal menghapus file: %w", err)
+	}
+	return files, nil
+}
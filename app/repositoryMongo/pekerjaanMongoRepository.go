@@ -2,23 +2,52 @@ package repositoryMongo
 
 import (
 	"backendgo/app/modelmongo"
-	"backendgo/database"
+	"backendgo/apperror"
+	"backendgo/utils"
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// Ambil koleksi MongoDB
-func getPekerjaanCollection() *mongo.Collection {
-	return database.MongoDB.Collection("pekerjaan_alumni")
+// PekerjaanMongoRepository akses koleksi pekerjaan_alumni di MongoDB
+type PekerjaanMongoRepository interface {
+	Create(data modelmongo.PekerjaanAlumni) (*modelmongo.PekerjaanAlumni, error)
+	GetAll() ([]modelmongo.PekerjaanAlumni, error)
+	GetByID(id string) (*modelmongo.PekerjaanAlumni, error)
+	GetByAlumni(alumniIDStr string) ([]modelmongo.PekerjaanAlumni, error)
+	Update(id string, req modelmongo.UpdatePekerjaanRequest) error
+	SoftDelete(id string) error
+	Restore(id string) error
+	HardDelete(id string) error
+	GetTrashed() ([]modelmongo.PekerjaanAlumni, error)
+	GetTrashedByAlumni(alumniID int) ([]modelmongo.PekerjaanAlumni, error)
+	Page(cur *utils.Cursor, order string, limit int) ([]modelmongo.PekerjaanAlumni, utils.CursorPage, error)
+	SoftDeleteByAlumni(alumniID int) error
+	RestoreByAlumni(alumniID int) error
+	DeleteByAlumni(alumniID int) error
+}
+
+type pekerjaanMongoRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPekerjaanMongoRepository(db *mongo.Database) PekerjaanMongoRepository {
+	if db == nil {
+		panic("❌ MongoDB belum terhubung (db == nil) saat membuat PekerjaanMongoRepository")
+	}
+	return &pekerjaanMongoRepository{
+		collection: db.Collection("pekerjaan_alumni"),
+	}
 }
 
 // -------------------- CREATE --------------------
-func CreatePekerjaanMongo(data modelmongo.PekerjaanAlumni) (*modelmongo.PekerjaanAlumni, error) {
+func (r *pekerjaanMongoRepository) Create(data modelmongo.PekerjaanAlumni) (*modelmongo.PekerjaanAlumni, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -27,7 +56,7 @@ func CreatePekerjaanMongo(data modelmongo.PekerjaanAlumni) (*modelmongo.Pekerjaa
 	data.UpdatedAt = time.Now()
 	data.IsDeleted = false
 
-	_, err := getPekerjaanCollection().InsertOne(ctx, data)
+	_, err := r.collection.InsertOne(ctx, data)
 	if err != nil {
 		return nil, err
 	}
@@ -35,12 +64,12 @@ func CreatePekerjaanMongo(data modelmongo.PekerjaanAlumni) (*modelmongo.Pekerjaa
 }
 
 // -------------------- GET ALL (Non Deleted) --------------------
-func GetAllPekerjaanMongo() ([]modelmongo.PekerjaanAlumni, error) {
+func (r *pekerjaanMongoRepository) GetAll() ([]modelmongo.PekerjaanAlumni, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	filter := bson.M{"is_deleted": false}
-	cursor, err := getPekerjaanCollection().Find(ctx, filter)
+	cursor, err := r.collection.Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +83,7 @@ func GetAllPekerjaanMongo() ([]modelmongo.PekerjaanAlumni, error) {
 }
 
 // -------------------- GET BY ID --------------------
-func GetPekerjaanByIDMongo(id string) (*modelmongo.PekerjaanAlumni, error) {
+func (r *pekerjaanMongoRepository) GetByID(id string) (*modelmongo.PekerjaanAlumni, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -64,7 +93,7 @@ func GetPekerjaanByIDMongo(id string) (*modelmongo.PekerjaanAlumni, error) {
 	}
 
 	var pekerjaan modelmongo.PekerjaanAlumni
-	err = getPekerjaanCollection().FindOne(ctx, bson.M{"_id": objID}).Decode(&pekerjaan)
+	err = r.collection.FindOne(ctx, bson.M{"_id": objID}).Decode(&pekerjaan)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +101,7 @@ func GetPekerjaanByIDMongo(id string) (*modelmongo.PekerjaanAlumni, error) {
 }
 
 // -------------------- UPDATE --------------------
-func UpdatePekerjaanMongo(id string, req modelmongo.UpdatePekerjaanRequest) error {
+func (r *pekerjaanMongoRepository) Update(id string, req modelmongo.UpdatePekerjaanRequest) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -97,12 +126,20 @@ func UpdatePekerjaanMongo(id string, req modelmongo.UpdatePekerjaanRequest) erro
 	if req.GajiRange != nil {
 		updateFields["gaji_range"] = *req.GajiRange
 	}
-	if req.TanggalMulaiKerja != nil {
-		updateFields["tanggal_mulai_kerja"] = *req.TanggalMulaiKerja
-	}
-	if req.TanggalSelesaiKerja != nil {
-		updateFields["tanggal_selesai_kerja"] = *req.TanggalSelesaiKerja
+	// Tanggal disimpan sebagai BSON date seperti saat create; string kosong menghapus tanggalnya
+	unsetFields := bson.M{}
+	setTanggal := func(field string, value *string) {
+		if value == nil {
+			return
+		}
+		if t, err := time.Parse("2006-01-02", strings.TrimSpace(*value)); err == nil {
+			updateFields[field] = t
+		} else {
+			unsetFields[field] = ""
+		}
 	}
+	setTanggal("tanggal_mulai_kerja", req.TanggalMulaiKerja)
+	setTanggal("tanggal_selesai_kerja", req.TanggalSelesaiKerja)
 	if req.StatusPekerjaan != nil {
 		updateFields["status_pekerjaan"] = *req.StatusPekerjaan
 	}
@@ -112,12 +149,15 @@ func UpdatePekerjaanMongo(id string, req modelmongo.UpdatePekerjaanRequest) erro
 	updateFields["updated_at"] = time.Now()
 
 	update := bson.M{"$set": updateFields}
-	_, err = getPekerjaanCollection().UpdateByID(ctx, objID, update)
+	if len(unsetFields) > 0 {
+		update["$unset"] = unsetFields
+	}
+	_, err = r.collection.UpdateByID(ctx, objID, update)
 	return err
 }
 
 // -------------------- SOFT DELETE --------------------
-func SoftDeletePekerjaanMongo(id string) error {
+func (r *pekerjaanMongoRepository) SoftDelete(id string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -132,12 +172,12 @@ func SoftDeletePekerjaanMongo(id string) error {
 			"updated_at": time.Now(),
 		},
 	}
-	_, err = getPekerjaanCollection().UpdateByID(ctx, objID, update)
+	_, err = r.collection.UpdateByID(ctx, objID, update)
 	return err
 }
 
 // -------------------- RESTORE --------------------
-func RestorePekerjaanMongo(id string) error {
+func (r *pekerjaanMongoRepository) Restore(id string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -152,12 +192,12 @@ func RestorePekerjaanMongo(id string) error {
 			"updated_at": time.Now(),
 		},
 	}
-	_, err = getPekerjaanCollection().UpdateByID(ctx, objID, update)
+	_, err = r.collection.UpdateByID(ctx, objID, update)
 	return err
 }
 
 // -------------------- HARD DELETE --------------------
-func HardDeletePekerjaanMongo(id string) error {
+func (r *pekerjaanMongoRepository) HardDelete(id string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -166,17 +206,70 @@ func HardDeletePekerjaanMongo(id string) error {
 		return err
 	}
 
-	_, err = getPekerjaanCollection().DeleteOne(ctx, bson.M{"_id": objID})
+	_, err = r.collection.DeleteOne(ctx, bson.M{"_id": objID})
+	return err
+}
+
+// -------------------- TRASH IKUT ALUMNI --------------------
+// Sama seperti tabel pekerjaan_alumni di PostgreSQL: dokumen yang ikut di-trash bersama
+// alumni ditandai deleted_with_alumni, restore alumni hanya memulihkan dokumen itu.
+func (r *pekerjaanMongoRepository) SoftDeleteByAlumni(alumniID int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"alumni_id": alumniID, "is_deleted": false},
+		bson.M{"$set": bson.M{"is_deleted": true, "deleted_with_alumni": true, "updated_at": time.Now()}},
+	)
+	return err
+}
+
+func (r *pekerjaanMongoRepository) RestoreByAlumni(alumniID int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"alumni_id": alumniID, "deleted_with_alumni": true},
+		bson.M{"$set": bson.M{"is_deleted": false, "deleted_with_alumni": false, "updated_at": time.Now()}},
+	)
+	return err
+}
+
+// DeleteByAlumni hapus permanen semua dokumen milik alumni (hard delete alumni)
+func (r *pekerjaanMongoRepository) DeleteByAlumni(alumniID int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.DeleteMany(ctx, bson.M{"alumni_id": alumniID})
 	return err
 }
 
 // -------------------- GET TRASHED --------------------
-func GetTrashedPekerjaanMongo() ([]modelmongo.PekerjaanAlumni, error) {
+func (r *pekerjaanMongoRepository) GetTrashed() ([]modelmongo.PekerjaanAlumni, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	filter := bson.M{"is_deleted": true}
-	cursor, err := getPekerjaanCollection().Find(ctx, filter)
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []modelmongo.PekerjaanAlumni
+	if err := cursor.All(ctx, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// -------------------- GET TRASHED BY ALUMNI --------------------
+func (r *pekerjaanMongoRepository) GetTrashedByAlumni(alumniID int) ([]modelmongo.PekerjaanAlumni, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"is_deleted": true, "alumni_id": alumniID}
+	cursor, err := r.collection.Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +283,7 @@ func GetTrashedPekerjaanMongo() ([]modelmongo.PekerjaanAlumni, error) {
 }
 
 // -------------------- GET BY ALUMNI --------------------
-func GetPekerjaanByAlumniMongo(alumniIDStr string) ([]modelmongo.PekerjaanAlumni, error) {
+func (r *pekerjaanMongoRepository) GetByAlumni(alumniIDStr string) ([]modelmongo.PekerjaanAlumni, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -204,7 +297,7 @@ func GetPekerjaanByAlumniMongo(alumniIDStr string) ([]modelmongo.PekerjaanAlumni
 		"is_deleted": false,
 	}
 
-	cursor, err := getPekerjaanCollection().Find(ctx, filter)
+	cursor, err := r.collection.Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -216,3 +309,74 @@ func GetPekerjaanByAlumniMongo(alumniIDStr string) ([]modelmongo.PekerjaanAlumni
 	}
 	return results, nil
 }
+
+// -------------------- CURSOR PAGINATION --------------------
+// Urutan created_at lalu _id (arah sama) supaya stabil untuk data dengan created_at sama.
+func PekerjaanMongoSortKey(order string) string {
+	return "created_at:" + order + ",_id:" + order
+}
+
+func (r *pekerjaanMongoRepository) Page(cur *utils.Cursor, order string, limit int) ([]modelmongo.PekerjaanAlumni, utils.CursorPage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	reverse := cur != nil && cur.Dir == utils.CursorPrev
+	asc := (order == "asc") != reverse
+	dir, op := -1, "$lt"
+	if asc {
+		dir, op = 1, "$gt"
+	}
+
+	filter := bson.M{"is_deleted": false}
+	if cur != nil {
+		createdAt, lastID, err := ParsePekerjaanMongoCursor(cur)
+		if err != nil {
+			return nil, utils.CursorPage{}, err
+		}
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{op: createdAt}},
+			bson.M{"created_at": createdAt, "_id": bson.M{op: lastID}},
+		}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: dir}, {Key: "_id", Value: dir}}).
+		SetLimit(int64(limit + 1))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, utils.CursorPage{}, err
+	}
+	defer cursor.Close(ctx)
+
+	result := []modelmongo.PekerjaanAlumni{}
+	if err := cursor.All(ctx, &result); err != nil {
+		return nil, utils.CursorPage{}, err
+	}
+
+	result, page := utils.BuildCursorPage(result, limit, cur, PekerjaanMongoSortKey(order), PekerjaanMongoCursorValues)
+	return result, page, nil
+}
+
+// PekerjaanMongoCursorKinds tipe nilai cursor: created_at lalu _id
+var PekerjaanMongoCursorKinds = []utils.CursorKind{utils.CursorRFC3339, utils.CursorObjectID}
+
+// PekerjaanMongoCursorValues nilai cursor satu dokumen: created_at lalu _id
+func PekerjaanMongoCursorValues(p modelmongo.PekerjaanAlumni) []string {
+	return []string{p.CreatedAt.UTC().Format(time.RFC3339Nano), p.ID.Hex()}
+}
+
+// ParsePekerjaanMongoCursor kebalikan PekerjaanMongoCursorValues; cursor yang diubah client → 400
+func ParsePekerjaanMongoCursor(cur *utils.Cursor) (time.Time, primitive.ObjectID, error) {
+	if len(cur.Values) != 2 {
+		return time.Time{}, primitive.NilObjectID, apperror.BadRequest("list.invalid_cursor")
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, cur.Values[0])
+	if err != nil {
+		return time.Time{}, primitive.NilObjectID, apperror.BadRequest("list.invalid_cursor")
+	}
+	lastID, err := primitive.ObjectIDFromHex(cur.Values[1])
+	if err != nil {
+		return time.Time{}, primitive.NilObjectID, apperror.BadRequest("list.invalid_cursor")
+	}
+	return createdAt, lastID, nil
+}
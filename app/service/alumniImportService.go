@@ -0,0 +1,301 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/config"
+	"backendgo/i18n"
+	"backendgo/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AlumniImportService handler import massal alumni (dipakai juga oleh CLI)
+type AlumniImportService struct {
+	imports repository.AlumniImportRepository
+	audit   repository.AuditRepository
+}
+
+func NewAlumniImportService(imports repository.AlumniImportRepository, audit repository.AuditRepository) *AlumniImportService {
+	return &AlumniImportService{imports: imports, audit: audit}
+}
+
+// ImportAlumniService godoc
+// @Summary Import alumni dari CSV / XLSX
+// @Description Import massal alumni. Alumni dengan NIM yang sudah ada diperbarui, NIM baru dibuat beserta akun user-nya. Import bersifat all-or-nothing: jika ada baris tidak valid, tidak ada data yang disimpan. Gunakan dry_run=true untuk memvalidasi tanpa menyimpan. Laporan error bisa diunduh lewat report_id.
+// @Tags Alumni
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "File .csv atau .xlsx (baris pertama = header)"
+// @Param mapping formData string false "Pemetaan kolom dalam JSON, mis. {\"nim\":\"NIM Mahasiswa\",\"nama\":\"Nama Lengkap\"}"
+// @Param dry_run query bool false "Hanya validasi, tidak menyimpan"
+// @Success 200 {object} model.AlumniImportResult "Hasil import / dry-run"
+// @Failure 400 {object} model.ErrorResponse "File atau mapping tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 422 {object} model.ErrorResponse "Ada baris tidak valid, tidak ada data yang disimpan (details = model.AlumniImportResult)"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/alumni/import [post]
+func (s *AlumniImportService) ImportAlumniService(c *fiber.Ctx) error {
+	dryRun := c.QueryBool("dry_run", false)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return apperror.BadRequest("common.file_required")
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return apperror.BadRequest("import.file_unreadable")
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return apperror.BadRequest("import.file_unreadable")
+	}
+
+	mapping := map[string]string{}
+	if raw := strings.TrimSpace(c.FormValue("mapping")); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+			return apperror.BadRequest("import.mapping_invalid")
+		}
+	}
+
+	records, err := utils.ReadSpreadsheet(fileHeader.Filename, content)
+	if err != nil {
+		return apperror.BadRequest(err.Error())
+	}
+
+	actorID := c.Locals("user_id").(int)
+	result, err := s.RunAlumniImport(&actorID, fileHeader.Filename, records, mapping, dryRun)
+	if err != nil {
+		// 422: hasil validasi per baris dikirim sebagai details
+		if failure := apperror.As(err); failure != nil && failure.Status == fiber.StatusUnprocessableEntity {
+			return failure.WithDetails(result)
+		}
+		return err
+	}
+
+	if dryRun {
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": i18n.T(c, "import.dry_run"),
+			"data":    result,
+		})
+	}
+	writeAudit(s.audit, c, model.AuditAlumniImported, fileHeader.Filename, map[string]interface{}{
+		"created": result.Created,
+		"updated": result.Updated,
+	})
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "import.success"),
+		"data":    result,
+	})
+}
+
+// RunAlumniImport validasi lalu simpan baris spreadsheet (baris pertama = header).
+// Dipakai endpoint import dan CLI; actorID nil berarti dijalankan dari CLI.
+// Laporan error disimpan dan ID-nya dikembalikan di result.ReportID.
+func (s *AlumniImportService) RunAlumniImport(actorID *int, filename string, records [][]string, mapping map[string]string, dryRun bool) (model.AlumniImportResult, error) {
+	if maxRows := config.GetInt("ALUMNI_IMPORT_MAX_ROWS", 5000); len(records)-1 > maxRows {
+		return model.AlumniImportResult{}, apperror.BadRequest("import.too_many_rows", maxRows)
+	}
+
+	rows, rowErrors, err := utils.MapAlumniImportRows(records, mapping)
+	if err != nil {
+		return model.AlumniImportResult{}, apperror.BadRequest(err.Error())
+	}
+
+	totalRows := len(rows) + countFailedRows(rowErrors)
+	existing, conflicts, err := s.checkAlumniImportRows(rows)
+	if err != nil {
+		return model.AlumniImportResult{}, apperror.Internal("alumni.check_failed").Wrap(err)
+	}
+	rowErrors = append(rowErrors, conflicts...)
+
+	result := model.AlumniImportResult{
+		DryRun:    dryRun,
+		TotalRows: totalRows,
+		Failed:    countFailedRows(rowErrors),
+		Errors:    rowErrors,
+	}
+	if result.Errors == nil {
+		result.Errors = []model.ImportRowError{}
+	}
+	if dryRun || len(rowErrors) > 0 {
+		// Prediksi hasil untuk baris yang lolos validasi
+		for _, r := range rows {
+			if hasRowError(rowErrors, r.Row) {
+				continue
+			}
+			if _, ok := existing[r.Data.NIM]; ok {
+				result.Updated++
+			} else {
+				result.Created++
+			}
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		result.ReportID = s.saveAlumniImportReport(actorID, filename, rowErrors)
+	}
+	if dryRun {
+		return result, nil
+	}
+	if len(rowErrors) > 0 {
+		return result, apperror.Validation("import.invalid_rows", nil)
+	}
+
+	created, updated, err := s.imports.Import(rows)
+	if err != nil {
+		var rowErr *repository.AlumniImportError
+		if errors.As(err, &rowErr) {
+			log.Println("Import alumni gagal:", err)
+			result.Errors = []model.ImportRowError{{
+				Row:     rowErr.Row,
+				NIM:     rowErr.NIM,
+				Message: "gagal disimpan, periksa duplikasi data",
+			}}
+			result.Failed = 1
+			result.ReportID = s.saveAlumniImportReport(actorID, filename, result.Errors)
+			return result, apperror.Validation("import.save_failed", nil)
+		}
+		return result, apperror.Internal("import.save_data_failed").Wrap(err)
+	}
+
+	result.Created, result.Updated = created, updated
+	return result, nil
+}
+
+// checkAlumniImportRows cek baris yang akan membuat alumni baru: akun user dibuat dengan
+// username = nama dan email alumni, jadi keduanya tidak boleh bentrok dengan user lain.
+func (s *AlumniImportService) checkAlumniImportRows(rows []model.AlumniImportRow) (map[string]int, []model.ImportRowError, error) {
+	nims := make([]string, 0, len(rows))
+	for _, r := range rows {
+		nims = append(nims, r.Data.NIM)
+	}
+	existing, err := s.imports.GetAlumniIDsByNIM(nims)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var usernames, emails []string
+	for _, r := range rows {
+		if _, ok := existing[r.Data.NIM]; !ok {
+			usernames = append(usernames, r.Data.Nama)
+			emails = append(emails, r.Data.Email)
+		}
+	}
+	if len(usernames) == 0 {
+		return existing, nil, nil
+	}
+	takenUsernames, takenEmails, err := s.imports.GetTakenUserIdentities(usernames, emails)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var errs []model.ImportRowError
+	fileUsernames, fileEmails := map[string]int{}, map[string]int{}
+	for _, r := range rows {
+		if _, ok := existing[r.Data.NIM]; ok {
+			continue
+		}
+		switch {
+		case takenUsernames[r.Data.Nama]:
+			errs = append(errs, model.ImportRowError{Row: r.Row, NIM: r.Data.NIM, Column: "nama", Message: "sudah dipakai sebagai username user lain"})
+		case fileUsernames[r.Data.Nama] > 0:
+			errs = append(errs, model.ImportRowError{Row: r.Row, NIM: r.Data.NIM, Column: "nama", Message: fmt.Sprintf("duplikat dengan baris %d (dipakai sebagai username)", fileUsernames[r.Data.Nama])})
+		}
+		switch {
+		case takenEmails[r.Data.Email]:
+			errs = append(errs, model.ImportRowError{Row: r.Row, NIM: r.Data.NIM, Column: "email", Message: "sudah dipakai user lain"})
+		case fileEmails[r.Data.Email] > 0:
+			errs = append(errs, model.ImportRowError{Row: r.Row, NIM: r.Data.NIM, Column: "email", Message: fmt.Sprintf("duplikat dengan baris %d", fileEmails[r.Data.Email])})
+		}
+		if fileUsernames[r.Data.Nama] == 0 {
+			fileUsernames[r.Data.Nama] = r.Row
+		}
+		if fileEmails[r.Data.Email] == 0 {
+			fileEmails[r.Data.Email] = r.Row
+		}
+	}
+	return existing, errs, nil
+}
+
+func (s *AlumniImportService) saveAlumniImportReport(actorID *int, filename string, errs []model.ImportRowError) string {
+	content, err := utils.BuildImportErrorReport(errs)
+	if err != nil {
+		log.Println("Gagal membuat laporan import:", err)
+		return ""
+	}
+	id, err := s.imports.CreateReport(actorID, filename, content)
+	if err != nil {
+		log.Println("Gagal menyimpan laporan import:", err)
+		return ""
+	}
+	return id
+}
+
+func countFailedRows(errs []model.ImportRowError) int {
+	rows := map[int]bool{}
+	for _, e := range errs {
+		rows[e.Row] = true
+	}
+	return len(rows)
+}
+
+func hasRowError(errs []model.ImportRowError, row int) bool {
+	for _, e := range errs {
+		if e.Row == row {
+			return true
+		}
+	}
+	return false
+}
+
+// DownloadAlumniImportReportService godoc
+// @Summary Unduh laporan error import alumni
+// @Description Mengunduh laporan error import alumni dalam format CSV.
+// @Tags Alumni
+// @Security BearerAuth
+// @Produce text/csv
+// @Param id path string true "ID laporan (report_id dari hasil import)"
+// @Success 200 {file} file "Laporan error (CSV)"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "Laporan tidak ditemukan"
+// @Router /api/alumni/import/reports/{id} [get]
+func (s *AlumniImportService) DownloadAlumniImportReportService(c *fiber.Ctx) error {
+	report, err := s.imports.GetReport(c.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSuffix(report.Filename, ".csv")
+	name = strings.TrimSuffix(name, ".xlsx")
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s-errors.csv"`, sanitizeDownloadName(name)))
+	return c.Send(report.Content)
+}
+
+func sanitizeDownloadName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '"' || r == '\\' || r == '/' || r < 0x20 {
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" {
+		return "import"
+	}
+	return name
+}
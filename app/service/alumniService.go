@@ -3,10 +3,36 @@ package service
 import (
 	"backendgo/app/model"
 	"backendgo/app/repository"
+	"backendgo/app/repositoryMongo"
+	"backendgo/apperror"
+	"backendgo/i18n"
+	"backendgo/middleware"
+	"errors"
 	"github.com/gofiber/fiber/v2"
+	"log"
+	"os"
 	"strconv"
 )
 
+// AlumniService handler CRUD, trash, list, dan export alumni. files dan pekerjaanMongo
+// nil kalau MongoDB tidak terhubung; trash alumni tetap jalan tanpa ikut menandai
+// file dan pekerjaan di MongoDB.
+type AlumniService struct {
+	alumni         repository.AlumniRepository
+	users          repository.UserRepository
+	files          repositoryMongo.FileRepository
+	pekerjaanMongo repositoryMongo.PekerjaanMongoRepository
+}
+
+func NewAlumniService(
+	alumni repository.AlumniRepository,
+	users repository.UserRepository,
+	files repositoryMongo.FileRepository,
+	pekerjaanMongo repositoryMongo.PekerjaanMongoRepository,
+) *AlumniService {
+	return &AlumniService{alumni: alumni, users: users, files: files, pekerjaanMongo: pekerjaanMongo}
+}
+
 
 // @Summary Ambil semua data alumni
 // @Description Mengambil daftar lengkap semua alumni dari database (hanya bisa diakses user yang login)
@@ -14,13 +40,13 @@ import (
 // @Produce json
 // @Security BearerAuth
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data alumni"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Terjadi kesalahan server"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Terjadi kesalahan server"
 // @Router /api/alumni [get]
-func GetAllAlumniService(c *fiber.Ctx) error {
-	data, err := repository.GetAllAlumni()
+func (s *AlumniService) GetAllAlumniService(c *fiber.Ctx) error {
+	data, err := s.alumni.GetAll()
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 	return c.JSON(fiber.Map{"success": true, "data": data})
 }
@@ -33,21 +59,26 @@ func GetAllAlumniService(c *fiber.Ctx) error {
 // @Security BearerAuth
 // @Param id path int true "ID Alumni"
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data alumni"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 404 {object} map[string]string "Alumni tidak ditemukan"
+// @Header 200 {string} ETag "Versi data, kirim balik lewat If-Match saat update"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ditemukan"
 // @Router /api/alumni/{id} [get]
-func GetAlumniByIDService(c *fiber.Ctx) error {
+func (s *AlumniService) GetAlumniByIDService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
-	data, err := repository.GetAlumniByID(id)
+	data, err := s.alumni.GetByID(id)
+	if errors.Is(err, repository.ErrAlumniNotFound) {
+		return err
+	}
 	if err != nil {
-		return c.Status(404).JSON(fiber.Map{"error": "Alumni tidak ditemukan"})
+		return apperror.Internal("alumni.fetch_failed").Wrap(err)
 	}
 
+	setETag(c, data.Version)
 	return c.JSON(fiber.Map{"success": true, "data": data})
 }
 
@@ -60,85 +91,340 @@ func GetAlumniByIDService(c *fiber.Ctx) error {
 // @Security BearerAuth
 // @Param body body model.CreateAlumniRequest true "Data alumni baru"
 // @Success 201 {object} map[string]interface{} "Alumni berhasil dibuat"
-// @Failure 400 {object} map[string]string "Body request tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal menyimpan ke database"
+// @Failure 400 {object} model.ErrorResponse "Body request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Gagal menyimpan ke database"
 // @Router /api/alumni [post]
-func CreateAlumniService(c *fiber.Ctx) error {
+func (s *AlumniService) CreateAlumniService(c *fiber.Ctx) error {
 	var input model.CreateAlumniRequest
 	if err := c.BodyParser(&input); err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "Body request tidak valid"})
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if err := middleware.Validate(&input); err != nil {
+		return err
 	}
 
-	data, err := repository.CreateAlumni(input)
+	data, err := s.alumni.Create(input)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
 	return c.Status(201).JSON(fiber.Map{
 		"success": true,
-		"message": "Alumni berhasil dibuat",
+		"message": i18n.T(c, "alumni.created"),
 		"data":    data,
 	})
 }
 
 
 // @Summary Update data alumni
-// @Description Memperbarui data alumni berdasarkan ID (hanya bisa diakses user yang login)
+// @Description Memperbarui seluruh data alumni berdasarkan ID (hanya bisa diakses user yang login). Kirim If-Match berisi ETag terakhir supaya perubahan orang lain tidak tertimpa.
 // @Tags Alumni
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "ID Alumni"
+// @Param If-Match header string false "ETag dari respon GET / PUT / PATCH sebelumnya"
 // @Param body body model.UpdateAlumniRequest true "Data alumni yang diperbarui"
 // @Success 200 {object} map[string]interface{} "Data alumni diperbarui"
-// @Failure 400 {object} map[string]string "Body atau ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal memperbarui data"
+// @Header 200 {string} ETag "Versi data terbaru"
+// @Failure 400 {object} model.ErrorResponse "Body, ID, atau If-Match tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ditemukan"
+// @Failure 412 {object} model.ErrorResponse "Data sudah diubah pengguna lain (If-Match tidak cocok)"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Gagal memperbarui data"
 // @Router /api/alumni/{id} [put]
-func UpdateAlumniService(c *fiber.Ctx) error {
+func (s *AlumniService) UpdateAlumniService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
 	}
 
 	var input model.UpdateAlumniRequest
 	if err := c.BodyParser(&input); err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "Body request tidak valid"})
+		return apperror.BadRequest("common.invalid_body")
+	}
+	return s.saveAlumni(c, id, input, version)
+}
+
+// PatchAlumniService godoc
+// @Summary Update sebagian data alumni
+// @Description Mengubah sebagian data alumni dengan JSON Merge Patch (RFC 7386): field yang tidak dikirim tetap, null mengosongkan field. Hasil akhirnya divalidasi seperti PUT. Tanpa If-Match, patch diterapkan pada versi yang baru dibaca dan tetap ditolak (412) kalau data berubah di tengah jalan.
+// @Tags Alumni
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID Alumni"
+// @Param If-Match header string false "ETag dari respon GET / PUT / PATCH sebelumnya"
+// @Param body body model.UpdateAlumniRequest true "Field alumni yang diubah (application/merge-patch+json atau application/json)"
+// @Success 200 {object} map[string]interface{} "Data alumni diperbarui"
+// @Header 200 {string} ETag "Versi data terbaru"
+// @Failure 400 {object} model.ErrorResponse "Body, ID, atau If-Match tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ditemukan"
+// @Failure 412 {object} model.ErrorResponse "Data sudah diubah pengguna lain (If-Match tidak cocok)"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Gagal memperbarui data"
+// @Router /api/alumni/{id} [patch]
+func (s *AlumniService) PatchAlumniService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+
+	current, err := s.alumni.GetByID(id)
+	if errors.Is(err, repository.ErrAlumniNotFound) {
+		return err
+	}
+	if err != nil {
+		return apperror.Internal("alumni.fetch_failed").Wrap(err)
+	}
+	if err := checkIfMatch(c, current.Version); err != nil {
+		return err
+	}
+
+	input, err := mergePatch(c, model.UpdateAlumniRequest{
+		NIM:            current.NIM,
+		Nama:           current.Nama,
+		Jurusan:        current.Jurusan,
+		Angkatan:       current.Angkatan,
+		TahunLulus:     current.TahunLulus,
+		Email:          current.Email,
+		NoTelepon:      current.NoTelepon,
+		Alamat:         current.Alamat,
+		StatusKematian: current.StatusKematian,
+	})
+	if err != nil {
+		return err
+	}
+	return s.saveAlumni(c, id, input, current.Version)
+}
+
+// saveAlumni validasi lalu simpan data alumni lengkap (PUT / hasil PATCH)
+func (s *AlumniService) saveAlumni(c *fiber.Ctx, id int, input model.UpdateAlumniRequest, version int) error {
+	if err := middleware.Validate(&input); err != nil {
+		return err
 	}
 	input.ID = id
 
-	updated, err := repository.UpdateAlumni(input)
+	updated, err := s.alumni.Update(input, version)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
-	return c.JSON(fiber.Map{"success": true, "message": "Data alumni diperbarui", "data": updated})
+	setETag(c, updated.Version)
+	return c.JSON(fiber.Map{"success": true, "message": i18n.T(c, "alumni.updated"), "data": updated})
 }
 
 
-// @Summary Hapus data alumni
-// @Description Menghapus data alumni berdasarkan ID (hanya bisa diakses user yang login)
+// @Summary Hapus data alumni (pindah ke trash)
+// @Description Sama dengan PUT /api/alumni/{id}/soft-delete: alumni dipindah ke trash dan bisa di-restore. Hapus permanen lewat DELETE /api/alumni/{id}/hard-delete.
 // @Tags Alumni
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "ID Alumni"
-// @Success 200 {object} map[string]string "Alumni berhasil dihapus"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal menghapus data alumni"
+// @Success 200 {object} map[string]string "Alumni dipindahkan ke trash"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal menghapus data alumni"
 // @Router /api/alumni/{id} [delete]
-func DeleteAlumniService(c *fiber.Ctx) error {
+func (s *AlumniService) DeleteAlumniService(c *fiber.Ctx) error {
+	return s.SoftDeleteAlumniService(c)
+}
+
+// SoftDeleteAlumniService godoc
+// @Summary Soft delete alumni
+// @Description Memindahkan alumni ke trash. Alumni di trash tidak muncul di daftar, pencarian, dan export; pekerjaan (PostgreSQL dan MongoDB) dan file miliknya ikut di-trash. File milik akun dengan role selain user (mis. admin atau staf) yang ditautkan tidak ikut di-trash.
+// @Tags Alumni
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID Alumni"
+// @Success 200 {object} map[string]string "Alumni dipindahkan ke trash"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal melakukan soft delete"
+// @Router /api/alumni/{id}/soft-delete [put]
+func (s *AlumniService) SoftDeleteAlumniService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+
+	current, err := s.alumni.GetByID(id)
+	if errors.Is(err, repository.ErrAlumniNotFound) {
+		return err
+	}
+	if err != nil {
+		return apperror.Internal("alumni.fetch_failed").Wrap(err)
+	}
+	if err := s.alumni.SoftDelete(id); err != nil {
+		return err // ErrAlumniNotFound → 404
+	}
+	s.setPekerjaanMongoDeleted(id, true)
+	if s.userFollowsAlumni(current.UserID) {
+		s.setFilesDeleted(current.UserID, true)
+	}
+
+	return c.JSON(fiber.Map{"success": true, "message": i18n.T(c, "alumni.soft_deleted_id", id)})
+}
+
+// RestoreAlumniService godoc
+// @Summary Restore alumni
+// @Description Mengembalikan alumni dari trash beserta pekerjaan dan file yang ikut di-trash bersamanya. Pekerjaan yang sudah di-trash sendiri sebelumnya tetap di trash.
+// @Tags Alumni
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID Alumni"
+// @Success 200 {object} map[string]string "Alumni berhasil di-restore"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ada di trash"
+// @Failure 500 {object} model.ErrorResponse "Gagal melakukan restore"
+// @Router /api/alumni/{id}/restore [put]
+func (s *AlumniService) RestoreAlumniService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+
+	trashed, err := s.alumni.GetTrashedByID(id)
+	if errors.Is(err, repository.ErrAlumniNotTrashed) {
+		return err
+	}
+	if err != nil {
+		return apperror.Internal("alumni.fetch_failed").Wrap(err)
+	}
+	if err := s.alumni.Restore(id); err != nil {
+		return err // ErrAlumniNotTrashed → 404
+	}
+	s.setPekerjaanMongoDeleted(id, false)
+	if s.userFollowsAlumni(trashed.UserID) {
+		s.setFilesDeleted(trashed.UserID, false)
+	}
+
+	return c.JSON(fiber.Map{"success": true, "message": i18n.T(c, "alumni.restored_id", id)})
+}
+
+// HardDeleteAlumniService godoc
+// @Summary Hard delete alumni
+// @Description Menghapus permanen alumni yang ada di trash beserta akun user, pekerjaan, dan file miliknya. Akun dengan role selain user (mis. admin atau staf) yang ditautkan ke alumni beserta file-nya tidak ikut dihapus.
+// @Tags Alumni
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID Alumni"
+// @Success 200 {object} map[string]string "Alumni dihapus permanen"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ada di trash"
+// @Failure 500 {object} model.ErrorResponse "Gagal melakukan hard delete"
+// @Router /api/alumni/{id}/hard-delete [delete]
+func (s *AlumniService) HardDeleteAlumniService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
-	if err := repository.DeleteAlumni(id); err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	deleted, userDeleted, err := s.alumni.HardDelete(id)
+	if err != nil {
+		return err // ErrAlumniNotTrashed → 404
+	}
+	s.deletePekerjaanMongo(id)
+	// file milik akun yang tetap ada (role selain user) tidak ikut dihapus
+	if userDeleted {
+		s.deleteFiles(deleted.UserID)
+	}
+
+	return c.JSON(fiber.Map{"success": true, "message": i18n.T(c, "alumni.hard_deleted_id", id)})
+}
+
+// GetTrashedAlumniService godoc
+// @Summary Ambil alumni di trash
+// @Description Menampilkan alumni yang sudah di-soft delete, terakhir dihapus dulu
+// @Tags Alumni
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Berhasil mengambil data trash alumni"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal mengambil data"
+// @Router /api/alumni/trashed [get]
+func (s *AlumniService) GetTrashedAlumniService(c *fiber.Ctx) error {
+	data, err := s.alumni.GetTrashed()
+	if err != nil {
+		return err
 	}
+	return c.JSON(fiber.Map{"success": true, "data": data})
+}
 
-	return c.JSON(fiber.Map{"success": true, "message": "Alumni berhasil dihapus"})
+// userFollowsAlumni true kalau akun user ikut dihapus bersama alumninya saat hard delete.
+// Hanya akun alumni biasa (role user); akun admin / staf yang ditautkan tetap ada,
+// jadi file-nya tidak ikut di-trash.
+func (s *AlumniService) userFollowsAlumni(userID int) bool {
+	if userID == 0 || s.users == nil {
+		return false
+	}
+	user, err := s.users.GetByID(userID)
+	return err == nil && user.Role == model.RoleUser
+}
+
+// setPekerjaanMongoDeleted trash / pulihkan pekerjaan alumni di MongoDB. Seperti file,
+// gagal di sini hanya dicatat dan tidak membatalkan trash alumni.
+func (s *AlumniService) setPekerjaanMongoDeleted(alumniID int, deleted bool) {
+	if s.pekerjaanMongo == nil {
+		return
+	}
+	var err error
+	if deleted {
+		err = s.pekerjaanMongo.SoftDeleteByAlumni(alumniID)
+	} else {
+		err = s.pekerjaanMongo.RestoreByAlumni(alumniID)
+	}
+	if err != nil {
+		log.Println("Gagal menandai pekerjaan MongoDB alumni:", err)
+	}
+}
+
+// deletePekerjaanMongo hapus permanen pekerjaan MongoDB milik alumni yang dihapus permanen
+func (s *AlumniService) deletePekerjaanMongo(alumniID int) {
+	if s.pekerjaanMongo == nil {
+		return
+	}
+	if err := s.pekerjaanMongo.DeleteByAlumni(alumniID); err != nil {
+		log.Println("Gagal menghapus pekerjaan MongoDB alumni:", err)
+	}
+}
+
+// setFilesDeleted tandai / pulihkan file milik user alumni. Gagal di sini tidak
+// membatalkan trash alumni, file tetap bisa dibereskan lewat rekonsiliasi file.
+func (s *AlumniService) setFilesDeleted(userID int, deleted bool) {
+	if s.files == nil || userID == 0 {
+		return
+	}
+	if err := s.files.SetDeletedByUser(userID, deleted); err != nil {
+		log.Println("Gagal menandai file alumni:", err)
+	}
+}
+
+// deleteFiles hapus record dan file fisik milik user alumni yang dihapus permanen
+func (s *AlumniService) deleteFiles(userID int) {
+	if s.files == nil || userID == 0 {
+		return
+	}
+	files, err := s.files.DeleteByUser(userID)
+	if err != nil {
+		log.Println("Gagal menghapus file alumni:", err)
+		return
+	}
+	for _, f := range files {
+		os.Remove(f.FilePath)
+	}
 }
 
 // UpdateStatusKematianService godoc
@@ -151,71 +437,97 @@ func DeleteAlumniService(c *fiber.Ctx) error {
 // @Param id path int true "ID Alumni"
 // @Param body body model.UpdateStatusKematianRequest true "Status kematian alumni"
 // @Success 200 {object} map[string]string "Status kematian diperbarui"
-// @Failure 400 {object} map[string]string "Body atau ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal memperbarui status kematian"
+// @Failure 400 {object} model.ErrorResponse "Body atau ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal memperbarui status kematian"
 // @Router /api/alumni/{id}/kematian [put]
-func UpdateStatusKematianService(c *fiber.Ctx) error {
+func (s *AlumniService) UpdateStatusKematianService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
 	var req model.UpdateStatusKematianRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "Body tidak valid"})
+		return apperror.BadRequest("common.invalid_body")
 	}
 
-	if err := repository.UpdateStatusKematian(id, req.StatusKematian); err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "Gagal update status kematian"})
+	if err := s.alumni.UpdateStatusKematian(id, req.StatusKematian); err != nil {
+		if errors.Is(err, repository.ErrAlumniNotFound) {
+			return err
+		}
+		return apperror.Internal("alumni.death_status_failed").Wrap(err)
 	}
 
-	return c.JSON(fiber.Map{"success": true, "message": "Status kematian diperbarui"})
+	return c.JSON(fiber.Map{"success": true, "message": i18n.T(c, "alumni.death_status_updated")})
 }
 
 
 
 // @Summary Ambil data alumni dengan pagination dan pencarian
-// @Description Mengambil daftar alumni dengan fitur pencarian, sorting, dan pagination (hanya bisa diakses user yang login)
+// @Description Mengambil daftar alumni dengan fitur pencarian, sorting, dan pagination (hanya bisa diakses user yang login). Mendukung pagination offset (page) atau cursor (pagination=cursor / cursor).
 // @Tags Alumni
 // @Produce json
 // @Security BearerAuth
 // @Param page query int false "Nomor halaman (default 1)"
-// @Param limit query int false "Jumlah data per halaman (default 10)"
-// @Param sortBy query string false "Kolom pengurutan (default id)"
+// @Param limit query int false "Jumlah data per halaman (default 10, maksimal 100)"
+// @Param pagination query string false "cursor = pakai cursor pagination (meta berisi next_cursor / prev_cursor, tanpa total)"
+// @Param cursor query string false "next_cursor / prev_cursor dari respons sebelumnya"
+// @Param sort query string false "Multi kolom, mis. -angkatan,nama (prefix - = desc). Kolom: id, nim, nama, jurusan, angkatan, tahun_lulus, email, status_kematian, created_at, updated_at"
+// @Param sortBy query string false "Kolom pengurutan tunggal (default id)"
 // @Param order query string false "Urutan (asc/desc)"
 // @Param search query string false "Kata kunci pencarian"
+// @Param jurusan query string false "Filter jurusan"
+// @Param angkatan_min query int false "Angkatan minimal"
+// @Param angkatan_max query int false "Angkatan maksimal"
+// @Param tahun_lulus_min query int false "Tahun lulus minimal"
+// @Param tahun_lulus_max query int false "Tahun lulus maksimal"
+// @Param status_kematian query bool false "Filter status kematian"
+// @Param created_from query string false "Dibuat sejak (YYYY-MM-DD)"
+// @Param created_to query string false "Dibuat sampai (YYYY-MM-DD)"
 // @Success 200 {object} model.AlumniResponse "Data alumni dengan pagination"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal mengambil data"
+// @Failure 400 {object} model.ErrorResponse "Parameter sort / filter tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal mengambil data"
 // @Router /api/alumni/pagination [get]
-func GetAlumniWithPaginationService(c *fiber.Ctx) error {
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	limit, _ := strconv.Atoi(c.Query("limit", "10"))
-	sortBy := c.Query("sortBy", "id")
-	order := c.Query("order", "asc")
-	search := c.Query("search", "")
+func (s *AlumniService) GetAlumniWithPaginationService(c *fiber.Ctx) error {
+	q, err := repository.ParseListQuery(repository.AlumniListSpec, c.Query)
+	if err != nil {
+		return err
+	}
 
+	if isCursorMode(c) {
+		cur, limit, err := parseListCursor(c, q)
+		if err != nil {
+			return err
+		}
+		data, page, err := s.alumni.ListCursor(q, cur, limit)
+		if err != nil {
+			return err
+		}
+		return c.JSON(fiber.Map{"data": data, "meta": cursorMeta(q, limit, page)})
+	}
+
+	page, limit, err := parsePage(c)
+	if err != nil {
+		return err
+	}
 	offset := (page - 1) * limit
 
-	data, err := repository.GetAlumniRepo(search, sortBy, order, limit, offset)
+	data, err := s.alumni.List(q, limit, offset)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
-	total, _ := repository.CountAlumniRepo(search)
+	total, err := s.alumni.Count(q)
+	if err != nil {
+		return err
+	}
 
 	response := model.AlumniResponse{
 		Data: data,
-		Meta: model.MetaInfo{
-			Page:   page,
-			Limit:  limit,
-			Total:  total,
-			Pages:  (total + limit - 1) / limit,
-			SortBy: sortBy,
-			Order:  order,
-			Search: search,
-		},
+		Meta: listMeta(q, page, limit, total),
 	}
 	return c.JSON(response)
 }
@@ -3,74 +3,184 @@ package service
 import (
 	"backendgo/app/model"
 	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/i18n"
 	"backendgo/utils"
+	"errors"
+	"log"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
+// AuthService handler login, sesi, password, dan 2FA untuk user yang sedang login
+type AuthService struct {
+	users    repository.UserRepository
+	tokens   repository.RefreshTokenRepository
+	attempts repository.LoginAttemptRepository
+	mfa      repository.MFARepository
+	resets   repository.PasswordResetRepository
+	audit    repository.AuditRepository
+}
+
+func NewAuthService(
+	users repository.UserRepository,
+	tokens repository.RefreshTokenRepository,
+	attempts repository.LoginAttemptRepository,
+	mfa repository.MFARepository,
+	resets repository.PasswordResetRepository,
+	audit repository.AuditRepository,
+) *AuthService {
+	return &AuthService{users: users, tokens: tokens, attempts: attempts, mfa: mfa, resets: resets, audit: audit}
+}
+
+// issueTokens membuat access token dan refresh token baru dalam satu family sesi
+func (s *AuthService) issueTokens(user model.User, familyID string) (model.LoginResponse, error) {
+	resp, err := newTokens(user, familyID)
+	if err != nil {
+		return model.LoginResponse{}, err
+	}
+
+	err = s.tokens.Create(
+		user.ID,
+		utils.HashToken(resp.RefreshToken),
+		familyID,
+		time.Now().Add(utils.RefreshTokenTTL()),
+	)
+	if err != nil {
+		return model.LoginResponse{}, err
+	}
+	return resp, nil
+}
+
+// newTokens buat access token dan refresh token tanpa menyimpan refresh token-nya
+func newTokens(user model.User, familyID string) (model.LoginResponse, error) {
+	token, expiresAt, err := utils.GenerateToken(user, familyID)
+	if err != nil {
+		return model.LoginResponse{}, err
+	}
+
+	refreshToken, err := utils.GenerateOpaqueToken(32)
+	if err != nil {
+		return model.LoginResponse{}, err
+	}
+
+	return model.LoginResponse{
+		User: model.User{
+			ID:                 user.ID,
+			Username:           user.Username,
+			Email:              user.Email,
+			Role:               user.Role,
+			CreatedAt:          user.CreatedAt,
+			MustChangePassword: user.MustChangePassword,
+			TOTPEnabled:        user.TOTPEnabled,
+			IsActive:           user.IsActive,
+		},
+		Token:        token,
+		ExpiresAt:    expiresAt,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
 // LoginService godoc
 // @Summary Login user
-// @Description Autentikasi user menggunakan username/email dan password, kemudian menghasilkan JWT token
+// @Description Autentikasi user menggunakan username/email dan password, kemudian menghasilkan access token (JWT berumur pendek) dan refresh token. Jika 2FA aktif, yang dikembalikan adalah mfa_token untuk dilanjutkan ke /api/login/mfa.
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param body body model.LoginRequest true "Data login (username dan password)"
 // @Success 200 {object} model.LoginResponse
-// @Failure 400 {object} map[string]string "Request tidak valid"
-// @Failure 401 {object} map[string]string "Username atau password salah"
-// @Failure 500 {object} map[string]string "Kesalahan server"
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Username atau password salah"
+// @Failure 403 {object} model.ErrorResponse "Akun dinonaktifkan"
+// @Failure 429 {object} model.ErrorResponse "Terlalu banyak percobaan login gagal (lihat header Retry-After)"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
 // @Router /api/login [post]
-func LoginService(c *fiber.Ctx) error {
+func (s *AuthService) LoginService(c *fiber.Ctx) error {
 	var req model.LoginRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Request body tidak valid",
-		})
+		return apperror.BadRequest("common.invalid_body")
 	}
 
 	if req.Username == "" || req.Password == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Username dan password harus diisi",
-		})
+		return apperror.BadRequest("auth.credentials_required")
+	}
+
+	// Batasi brute-force per IP dan per akun
+	ip := c.IP()
+	ipPolicy := utils.IPLoginThrottle()
+	accountPolicy := utils.AccountLoginThrottle()
+
+	retryAt, err := s.loginRetryAt(repository.LoginScopeIP, ip, ipPolicy)
+	if err != nil {
+		return apperror.Internal("auth.login_check_failed").Wrap(err)
+	}
+	if !retryAt.IsZero() {
+		return tooManyLoginAttempts(c, retryAt)
 	}
 
 	// Ambil user dari DB via repository
-	user, err := repository.GetUserByUsernameOrEmail(req.Username)
+	// user tidak ditemukan tetap lewat jalur gagal login biasa (counter + 401)
+	user, err := s.users.GetByUsernameOrEmail(req.Username)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		user = nil
+	} else if err != nil {
+		return apperror.Internal("user.fetch_failed").Wrap(err)
+	}
+	accountKey := loginAccountKey(user, req.Username)
+
+	retryAt, err = s.loginRetryAt(repository.LoginScopeAccount, accountKey, accountPolicy)
 	if err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": "Username atau password salah",
-		})
+		return apperror.Internal("auth.login_check_failed").Wrap(err)
+	}
+	if !retryAt.IsZero() {
+		return tooManyLoginAttempts(c, retryAt)
 	}
 
 	// Validasi password
-	if !utils.CheckPassword(req.Password, user.PasswordHash) {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": "Username atau password salah",
-		})
+	if user == nil || !utils.CheckPassword(req.Password, user.PasswordHash) {
+		s.registerLoginFailure(repository.LoginScopeAccount, accountKey, ip, accountPolicy, user)
+		s.registerLoginFailure(repository.LoginScopeIP, ip, ip, ipPolicy, user)
+		return apperror.Unauthorized("auth.invalid_credentials")
 	}
 
-	// Generate JWT
-	token, err := utils.GenerateToken(*user)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Gagal generate token",
+	if !user.IsActive {
+		return apperror.Forbidden("auth.account_disabled")
+	}
+
+	// 2FA aktif → token baru diberikan setelah kode TOTP diverifikasi di /api/login/mfa.
+	// Counter gagal akun baru direset di sana, supaya login ulang dengan password yang
+	// benar tidak membuka kesempatan baru untuk menebak kode 2FA.
+	if user.TOTPEnabled {
+		mfaToken, expiresAt, err := utils.GenerateMFAToken(user.ID)
+		if err != nil {
+			return apperror.Internal("auth.token_failed").Wrap(err)
+		}
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": i18n.T(c, "auth.mfa_required"),
+			"data": model.MFAChallengeResponse{
+				MFARequired: true,
+				MFAToken:    mfaToken,
+				ExpiresAt:   expiresAt,
+			},
 		})
 	}
 
-	resp := model.LoginResponse{
-		User: model.User{
-			ID:        user.ID,
-			Username:  user.Username,
-			Email:     user.Email,
-			Role:      user.Role,
-			CreatedAt: user.CreatedAt,
-		},
-		Token: token,
+	if _, err := s.attempts.Clear(repository.LoginScopeAccount, accountKey); err != nil {
+		log.Println("Gagal reset percobaan login:", err)
+	}
+
+	// Generate access token + refresh token (sesi baru)
+	resp, err := s.issueTokens(*user, uuid.New().String())
+	if err != nil {
+		return apperror.Internal("auth.token_failed").Wrap(err)
 	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"message": "Login berhasil",
+		"message": i18n.T(c, "auth.login_success"),
 		"data":    resp,
 	})
 }
@@ -82,16 +192,16 @@ func LoginService(c *fiber.Ctx) error {
 // @Security BearerAuth
 // @Produce json
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil profil"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
 // @Router /api/profile [get]
-func GetProfileService(c *fiber.Ctx) error {
+func (s *AuthService) GetProfileService(c *fiber.Ctx) error {
 	userID := c.Locals("user_id").(int)
 	username := c.Locals("username").(string)
 	role := c.Locals("role").(string)
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"message": "Profile berhasil diambil",
+		"message": i18n.T(c, "auth.profile_fetched"),
 		"data": fiber.Map{
 			"user_id":  userID,
 			"username": username,
@@ -99,3 +209,127 @@ func GetProfileService(c *fiber.Ctx) error {
 		},
 	})
 }
+
+// RefreshTokenService godoc
+// @Summary Refresh access token
+// @Description Menukar refresh token dengan access token dan refresh token baru (rotasi). Refresh token lama tidak bisa dipakai lagi; jika dipakai ulang, seluruh sesi terkait akan dicabut.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body model.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} model.LoginResponse
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Refresh token tidak valid, kadaluarsa, atau sudah dipakai"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/token/refresh [post]
+func (s *AuthService) RefreshTokenService(c *fiber.Ctx) error {
+	var req model.RefreshTokenRequest
+	if err := c.BodyParser(&req); err != nil || req.RefreshToken == "" {
+		return apperror.BadRequest("auth.refresh_required")
+	}
+
+	stored, err := s.tokens.GetByHash(utils.HashToken(req.RefreshToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return err
+		}
+		return apperror.Internal("auth.refresh_failed").Wrap(err)
+	}
+
+	if stored.RevokedAt != nil {
+		return apperror.Unauthorized("auth.refresh_revoked")
+	}
+
+	// Token yang sudah pernah dirotasi dipakai lagi → kemungkinan bocor,
+	// cabut seluruh family supaya pemegang token curian ikut ter-logout.
+	if stored.UsedAt != nil {
+		return s.rejectRefreshTokenReuse(stored)
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		return apperror.Unauthorized("auth.refresh_expired")
+	}
+
+	user, err := s.users.GetByID(stored.UserID)
+	if err != nil {
+		return apperror.Unauthorized("user.not_found")
+	}
+	if !user.IsActive {
+		return apperror.Forbidden("auth.account_disabled")
+	}
+
+	resp, err := newTokens(*user, stored.FamilyID)
+	if err != nil {
+		return apperror.Internal("auth.token_failed").Wrap(err)
+	}
+
+	// Tandai token lama + simpan token baru sekaligus; false berarti request lain
+	// sudah merotasi token ini lebih dulu
+	rotated, err := s.tokens.Rotate(stored.ID, utils.HashToken(resp.RefreshToken), time.Now().Add(utils.RefreshTokenTTL()))
+	if err != nil {
+		return apperror.Internal("auth.refresh_failed").Wrap(err)
+	}
+	if !rotated {
+		return s.rejectRefreshTokenReuse(stored)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "auth.token_refreshed"),
+		"data":    resp,
+	})
+}
+
+func (s *AuthService) rejectRefreshTokenReuse(stored *model.RefreshToken) error {
+	log.Printf("Refresh token reuse terdeteksi: user_id=%d family=%s\n", stored.UserID, stored.FamilyID)
+	if err := s.tokens.RevokeFamily(stored.FamilyID); err != nil {
+		log.Println("Gagal mencabut family refresh token:", err)
+	}
+	return apperror.Unauthorized("auth.refresh_reused")
+}
+
+// LogoutService godoc
+// @Summary Logout
+// @Description Mencabut sesi yang sedang dipakai. Access token dan refresh token dari sesi ini tidak bisa dipakai lagi.
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Logout berhasil"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/logout [post]
+func (s *AuthService) LogoutService(c *fiber.Ctx) error {
+	sessionID := c.Locals("session_id").(string)
+
+	if err := s.tokens.RevokeFamily(sessionID); err != nil {
+		return apperror.Internal("auth.logout_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "auth.logout_success"),
+	})
+}
+
+// LogoutAllService godoc
+// @Summary Logout dari semua perangkat
+// @Description Mencabut semua sesi milik user yang sedang login, termasuk sesi saat ini.
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Semua sesi berhasil dicabut"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/logout-all [post]
+func (s *AuthService) LogoutAllService(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	if err := s.tokens.RevokeAllForUser(userID); err != nil {
+		return apperror.Internal("auth.logout_all_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "auth.logout_all_success"),
+	})
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/utils"
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExportTable kolom + sumber data satu jenis export, dipakai endpoint export dan CLI
+type ExportTable struct {
+	Name    string
+	Title   string
+	Spec    *repository.ListSpec
+	Columns []string
+	Widths  []float64
+	Rows    func(q repository.ListQuery, row func([]string) error) error
+}
+
+// AlumniExport tabel export alumni dengan data dari repo
+func AlumniExport(repo repository.AlumniRepository) ExportTable {
+	return ExportTable{
+		Name:    "alumni",
+		Title:   "Data Alumni",
+		Spec:    repository.AlumniListSpec,
+		Columns: []string{"NIM", "Nama", "Jurusan", "Angkatan", "Tahun Lulus", "Email", "No. Telepon", "Alamat", "Status"},
+		Widths:  []float64{1.2, 2.2, 2, 0.9, 1, 2.2, 1.5, 3, 0.8},
+		Rows: func(q repository.ListQuery, row func([]string) error) error {
+			return repo.Stream(q, func(a model.Alumni) error {
+				status := "Hidup"
+				if a.StatusKematian {
+					status = "Wafat"
+				}
+				return row([]string{
+					a.NIM, a.Nama, a.Jurusan, strconv.Itoa(a.Angkatan), strconv.Itoa(a.TahunLulus),
+					a.Email, a.NoTelepon, a.Alamat, status,
+				})
+			})
+		},
+	}
+}
+
+// PekerjaanExport tabel export pekerjaan dengan data dari repo
+func PekerjaanExport(repo repository.PekerjaanRepository) ExportTable {
+	return ExportTable{
+		Name:    "pekerjaan",
+		Title:   "Data Pekerjaan Alumni",
+		Spec:    repository.PekerjaanListSpec,
+		Columns: []string{"ID", "ID Alumni", "Perusahaan", "Posisi", "Bidang Industri", "Lokasi", "Gaji", "Mulai", "Selesai", "Status"},
+		Widths:  []float64{0.6, 0.8, 2.2, 2, 1.8, 1.6, 1.4, 1, 1, 1},
+		Rows: func(q repository.ListQuery, row func([]string) error) error {
+			return repo.Stream(q, func(p model.PekerjaanAlumni) error {
+				selesai := ""
+				if p.TanggalSelesaiKerja != nil {
+					selesai = p.TanggalSelesaiKerja.Format("2006-01-02")
+				}
+				return row([]string{
+					strconv.Itoa(p.ID), strconv.Itoa(p.AlumniID), p.NamaPerusahaan, p.PosisiJabatan,
+					p.BidangIndustri, p.LokasiKerja, p.GajiRange, p.TanggalMulaiKerja.Format("2006-01-02"),
+					selesai, p.StatusPekerjaan,
+				})
+			})
+		},
+	}
+}
+
+// ValidExportFormat format export yang didukung: csv, xlsx, pdf
+func ValidExportFormat(format string) bool {
+	return format == utils.ExportCSV || format == utils.ExportXLSX || format == utils.ExportPDF
+}
+
+// WriteExport tulis seluruh isi tabel (sesuai filter q) ke w
+func WriteExport(w io.Writer, t ExportTable, format string, q repository.ListQuery) error {
+	tw, err := utils.NewTableWriter(format, w, t.Title, t.Widths)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(t.Columns); err != nil {
+		return err
+	}
+	if err := t.Rows(q, tw.WriteRow); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// streamExport kirim file export secara streaming. Query database dijalankan di dalam
+// stream writer, jadi error di tengah jalan hanya bisa dicatat di log (header sudah terkirim).
+func streamExport(c *fiber.Ctx, t ExportTable) error {
+	format := strings.ToLower(c.Query("format", utils.ExportCSV))
+	if !ValidExportFormat(format) {
+		return apperror.BadRequest("alumni.export_format_invalid")
+	}
+	q, err := repository.ParseListQuery(t.Spec, c.Query)
+	if err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", t.Name, time.Now().Format("20060102-150405"), format)
+	c.Set(fiber.HeaderContentType, utils.ExportContentType(format))
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		err := WriteExport(w, t, format, q)
+		if err == nil {
+			err = w.Flush()
+		}
+		if err != nil {
+			log.Printf("Export %s gagal: %v\n", t.Name, err)
+		}
+	})
+	return nil
+}
+
+// ExportAlumniService godoc
+// @Summary Export data alumni
+// @Description Mengunduh data alumni dalam format CSV, XLSX, atau PDF. Filter, pencarian, dan sort sama dengan /api/alumni/list (lihat parameter di sana); data dikirim secara streaming.
+// @Tags Alumni
+// @Security BearerAuth
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce application/pdf
+// @Param format query string false "csv (default) / xlsx / pdf"
+// @Param sort query string false "Multi kolom, mis. -angkatan,nama"
+// @Param sortBy query string false "Kolom pengurutan (default id)"
+// @Param order query string false "Urutan (asc/desc)"
+// @Param search query string false "Kata kunci pencarian"
+// @Success 200 {file} file "File export"
+// @Failure 400 {object} model.ErrorResponse "Parameter tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Router /api/alumni/export [get]
+func (s *AlumniService) ExportAlumniService(c *fiber.Ctx) error {
+	return streamExport(c, AlumniExport(s.alumni))
+}
+
+// ExportPekerjaanService godoc
+// @Summary Export data pekerjaan alumni
+// @Description Mengunduh data pekerjaan alumni dalam format CSV, XLSX, atau PDF. Filter, pencarian, dan sort sama dengan /api/pekerjaan/list (lihat parameter di sana); data dikirim secara streaming.
+// @Tags Pekerjaan
+// @Security BearerAuth
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce application/pdf
+// @Param format query string false "csv (default) / xlsx / pdf"
+// @Param sort query string false "Multi kolom, mis. -tanggal_mulai_kerja,nama_perusahaan"
+// @Param sortBy query string false "Kolom pengurutan (default created_at)"
+// @Param order query string false "Urutan (asc/desc)"
+// @Param search query string false "Kata kunci pencarian"
+// @Success 200 {file} file "File export"
+// @Failure 400 {object} model.ErrorResponse "Parameter tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Router /api/pekerjaan/export [get]
+func (s *PekerjaanService) ExportPekerjaanService(c *fiber.Ctx) error {
+	return streamExport(c, PekerjaanExport(s.pekerjaan))
+}
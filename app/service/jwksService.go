@@ -0,0 +1,19 @@
+package service
+
+import (
+	"backendgo/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// JWKSService godoc
+// @Summary JSON Web Key Set
+// @Description Daftar kunci publik untuk memverifikasi access token (RS256/EdDSA). Aplikasi lain cukup mengambil kunci dari sini berdasarkan header `kid`, tanpa perlu secret.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} utils.JWKSet
+// @Router /.well-known/jwks.json [get]
+func JWKSService(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(utils.DefaultKeyStore().JWKS())
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/i18n"
+	"backendgo/utils"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// loginAccountKey kunci pembatasan per akun. Username yang tidak terdaftar tetap
+// dibatasi memakai identifier-nya supaya respon tidak membocorkan akun mana yang ada.
+func loginAccountKey(user *model.User, identifier string) string {
+	if user != nil {
+		return fmt.Sprintf("user:%d", user.ID)
+	}
+	return "name:" + strings.ToLower(strings.TrimSpace(identifier))
+}
+
+// loginRetryAt waktu paling cepat login boleh dicoba lagi (nol = boleh sekarang)
+func (s *AuthService) loginRetryAt(scope, key string, policy utils.LoginThrottle) (time.Time, error) {
+	attempt, err := s.attempts.Get(scope, key)
+	if err != nil || attempt == nil {
+		return time.Time{}, err
+	}
+	return policy.RetryAt(attempt.Failures, attempt.LastFailureAt, attempt.LockedUntil, time.Now()), nil
+}
+
+// registerLoginFailure mencatat login gagal dan mengunci akun/IP kalau sudah melewati batas
+func (s *AuthService) registerLoginFailure(scope, key, ip string, policy utils.LoginThrottle, user *model.User) {
+	attempt, err := s.attempts.RecordFailure(scope, key, policy.FailureWindow)
+	if err != nil {
+		log.Println("Gagal mencatat login gagal:", err)
+		return
+	}
+	if attempt.LockedUntil != nil || !policy.ShouldLock(attempt.Failures) {
+		return
+	}
+
+	until := time.Now().Add(policy.LockoutDuration)
+	if err := s.attempts.Lock(scope, key, until); err != nil {
+		log.Println("Gagal mengunci login:", err)
+		return
+	}
+
+	action := model.AuditLoginAccountLocked
+	if scope == repository.LoginScopeIP {
+		action = model.AuditLoginIPLocked
+	}
+	detail := map[string]interface{}{
+		"failures":     attempt.Failures,
+		"locked_until": until,
+	}
+	if user != nil {
+		detail["user_id"] = user.ID
+		detail["username"] = user.Username
+	}
+	log.Printf("🔒 Login dikunci: %s=%s sampai %s\n", scope, key, until.Format(time.RFC3339))
+	if err := s.audit.Create(model.AuditLog{
+		Action: action,
+		Target: key,
+		IP:     ip,
+		Detail: detail,
+	}); err != nil {
+		log.Println("Gagal menyimpan audit log:", err)
+	}
+}
+
+func tooManyLoginAttempts(c *fiber.Ctx, retryAt time.Time) error {
+	seconds := int(math.Ceil(time.Until(retryAt).Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(seconds))
+	return apperror.TooManyRequests("auth.login_locked", seconds).
+		WithCode(apperror.CodeLoginLocked).
+		WithDetails(fiber.Map{"retry_after": seconds})
+}
+
+// UnlockUserService godoc
+// @Summary Buka kunci login user (admin)
+// @Description Menghapus catatan login gagal dan lockout untuk akun user sehingga user bisa langsung login lagi. Aksi ini dicatat di audit log.
+// @Tags Users
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "ID User"
+// @Success 200 {object} map[string]interface{} "Akun berhasil dibuka"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "User tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/users/{id}/unlock [post]
+func (s *UserService) UnlockUserService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+	adminID := c.Locals("user_id").(int)
+
+	user, err := s.users.GetByID(id)
+	if err != nil {
+		return apperror.NotFound("user.not_found")
+	}
+
+	key := loginAccountKey(user, "")
+	cleared, err := s.attempts.Clear(repository.LoginScopeAccount, key)
+	if err != nil {
+		return apperror.Internal("auth.unlock_failed").Wrap(err)
+	}
+
+	if err := s.audit.Create(model.AuditLog{
+		ActorUserID: &adminID,
+		Action:      model.AuditLoginAccountUnlocked,
+		Target:      key,
+		IP:          c.IP(),
+		Detail:      map[string]interface{}{"user_id": user.ID, "username": user.Username, "had_failures": cleared},
+	}); err != nil {
+		log.Println("Gagal menyimpan audit log:", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "auth.unlock_success"),
+	})
+}
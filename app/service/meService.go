@@ -0,0 +1,265 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/i18n"
+	"backendgo/middleware"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Field alumni yang hanya boleh diubah admin lewat /api/alumni
+var alumniAdminOnlyFields = map[string]bool{
+	"id": true, "user_id": true, "nim": true, "nama": true, "jurusan": true,
+	"angkatan": true, "tahun_lulus": true, "status_kematian": true,
+}
+
+// MeService handler self-service untuk alumni yang sedang login
+type MeService struct {
+	alumni    repository.AlumniRepository
+	pekerjaan repository.PekerjaanRepository
+}
+
+func NewMeService(alumni repository.AlumniRepository, pekerjaan repository.PekerjaanRepository) *MeService {
+	return &MeService{alumni: alumni, pekerjaan: pekerjaan}
+}
+
+// GetMyAlumniService godoc
+// @Summary Ambil data alumni sendiri
+// @Description Mengambil data alumni yang terhubung dengan akun yang sedang login.
+// @Tags Me
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} model.Alumni
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 404 {object} model.ErrorResponse "Alumni tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/me/alumni [get]
+func (s *MeService) GetMyAlumniService(c *fiber.Ctx) error {
+	alumniID, ok := middleware.AlumniID(c)
+	if !ok {
+		return middleware.ErrAlumniNotLinked
+	}
+
+	alumni, err := s.alumni.GetByID(alumniID)
+	if errors.Is(err, repository.ErrAlumniNotFound) {
+		return err
+	}
+	if err != nil {
+		return apperror.Internal("alumni.fetch_failed").Wrap(err)
+	}
+	return c.JSON(fiber.Map{"success": true, "data": alumni})
+}
+
+// UpdateMyAlumniService godoc
+// @Summary Ubah kontak alumni sendiri
+// @Description Alumni mengubah email, no_telepon, dan alamat miliknya sendiri. Field lain (nim, nama, angkatan, status_kematian, dll) hanya bisa diubah admin dan akan ditolak.
+// @Tags Me
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param body body model.UpdateMyAlumniRequest true "Data kontak yang diubah"
+// @Success 200 {object} model.Alumni
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Field hanya bisa diubah admin / akun belum terhubung"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/me/alumni [patch]
+func (s *MeService) UpdateMyAlumniService(c *fiber.Ctx) error {
+	alumniID, ok := middleware.AlumniID(c)
+	if !ok {
+		return middleware.ErrAlumniNotLinked
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(c.Body(), &raw); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	var forbidden []string
+	for field := range raw {
+		if alumniAdminOnlyFields[field] {
+			forbidden = append(forbidden, field)
+		}
+	}
+	if len(forbidden) > 0 {
+		sort.Strings(forbidden)
+		return apperror.Forbidden("alumni.admin_only_fields", strings.Join(forbidden, ", "))
+	}
+
+	var req model.UpdateMyAlumniRequest
+	if err := json.Unmarshal(c.Body(), &req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if req.Email == nil && req.NoTelepon == nil && req.Alamat == nil {
+		return apperror.BadRequest("common.nothing_to_update")
+	}
+	if err := middleware.Validate(&req); err != nil {
+		return err
+	}
+	if req.Email != nil {
+		email := strings.TrimSpace(*req.Email)
+		req.Email = &email
+	}
+
+	updated, err := s.alumni.UpdateContact(alumniID, req)
+	if errors.Is(err, repository.ErrAlumniNotFound) {
+		return err
+	}
+	if err != nil {
+		return apperror.Internal("alumni.update_failed").Wrap(err)
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "alumni.contact_updated"),
+		"data":    updated,
+	})
+}
+
+// GetMyPekerjaanService godoc
+// @Summary Ambil riwayat pekerjaan sendiri
+// @Description Menampilkan semua pekerjaan milik alumni yang sedang login.
+// @Tags Me
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Berhasil mengambil data pekerjaan"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/me/pekerjaan [get]
+func (s *MeService) GetMyPekerjaanService(c *fiber.Ctx) error {
+	alumniID, ok := middleware.AlumniID(c)
+	if !ok {
+		return middleware.ErrAlumniNotLinked
+	}
+
+	data, err := s.pekerjaan.GetByAlumniID(alumniID)
+	if err != nil {
+		return apperror.Internal("pekerjaan.fetch_failed").Wrap(err)
+	}
+	return c.JSON(fiber.Map{"success": true, "data": data})
+}
+
+// CreateMyPekerjaanService godoc
+// @Summary Tambah pekerjaan sendiri
+// @Description Alumni menambahkan riwayat pekerjaan miliknya sendiri. alumni_id diambil dari akun yang login.
+// @Tags Me
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param body body model.MyPekerjaanRequest true "Data pekerjaan"
+// @Success 201 {object} model.PekerjaanAlumni
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/me/pekerjaan [post]
+func (s *MeService) CreateMyPekerjaanService(c *fiber.Ctx) error {
+	alumniID, ok := middleware.AlumniID(c)
+	if !ok {
+		return middleware.ErrAlumniNotLinked
+	}
+
+	var req model.MyPekerjaanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if err := middleware.Validate(&req); err != nil {
+		return err
+	}
+	mulai, selesai, err := parseTanggalKerja(req.TanggalMulaiKerja, req.TanggalSelesaiKerja)
+	if err != nil {
+		return err
+	}
+
+	newData, err := s.pekerjaan.Create(model.PekerjaanAlumni{
+		AlumniID:            alumniID,
+		NamaPerusahaan:      req.NamaPerusahaan,
+		PosisiJabatan:       req.PosisiJabatan,
+		BidangIndustri:      req.BidangIndustri,
+		LokasiKerja:         req.LokasiKerja,
+		GajiRange:           req.GajiRange,
+		TanggalMulaiKerja:   mulai,
+		TanggalSelesaiKerja: selesai,
+		StatusPekerjaan:     req.StatusPekerjaan,
+		DeskripsiPekerjaan:  req.DeskripsiPekerjaan,
+		CreatedAt:           time.Now(),
+		UpdatedAt:           time.Now(),
+	})
+	if err != nil {
+		return apperror.Internal("pekerjaan.save_failed").Wrap(err)
+	}
+	return c.Status(201).JSON(fiber.Map{"success": true, "data": newData})
+}
+
+// UpdateMyPekerjaanService godoc
+// @Summary Ubah pekerjaan sendiri
+// @Description Alumni mengubah riwayat pekerjaan miliknya sendiri. Pekerjaan milik alumni lain atau yang sudah di-soft delete tidak bisa diubah.
+// @Tags Me
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID Pekerjaan"
+// @Param body body model.MyPekerjaanRequest true "Data pekerjaan"
+// @Success 200 {object} model.PekerjaanAlumni
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 404 {object} model.ErrorResponse "Data tidak ditemukan atau bukan milik alumni ini"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/me/pekerjaan/{id} [put]
+func (s *MeService) UpdateMyPekerjaanService(c *fiber.Ctx) error {
+	alumniID, ok := middleware.AlumniID(c)
+	if !ok {
+		return middleware.ErrAlumniNotLinked
+	}
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+
+	var req model.MyPekerjaanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if err := middleware.Validate(&req); err != nil {
+		return err
+	}
+	mulai, selesai, err := parseTanggalKerja(req.TanggalMulaiKerja, req.TanggalSelesaiKerja)
+	if err != nil {
+		return err
+	}
+
+	updated, err := s.pekerjaan.UpdateOwned(model.PekerjaanAlumni{
+		ID:                  id,
+		AlumniID:            alumniID,
+		NamaPerusahaan:      req.NamaPerusahaan,
+		PosisiJabatan:       req.PosisiJabatan,
+		BidangIndustri:      req.BidangIndustri,
+		LokasiKerja:         req.LokasiKerja,
+		GajiRange:           req.GajiRange,
+		TanggalMulaiKerja:   mulai,
+		TanggalSelesaiKerja: selesai,
+		StatusPekerjaan:     req.StatusPekerjaan,
+		DeskripsiPekerjaan:  req.DeskripsiPekerjaan,
+		UpdatedAt:           time.Now(),
+	})
+	if errors.Is(err, repository.ErrPekerjaanNotOwned) {
+		return err
+	}
+	if err != nil {
+		return apperror.Internal("pekerjaan.update_failed").Wrap(err)
+	}
+	return c.JSON(fiber.Map{"success": true, "data": updated})
+}
@@ -0,0 +1,388 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/config"
+	"backendgo/i18n"
+	"backendgo/utils"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const recoveryCodeCount = 10
+
+// verifyUserTOTP mencocokkan kode TOTP user dan menolak kode yang sudah pernah dipakai
+func (s *AuthService) verifyUserTOTP(totp model.UserTOTP, code string) (bool, error) {
+	if totp.Secret == "" {
+		return false, nil
+	}
+	ok, counter := utils.ValidateTOTP(totp.Secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	return s.mfa.ConsumeTOTPCounter(totp.UserID, counter)
+}
+
+// newRecoveryCodes membuat recovery code baru beserta hash-nya untuk disimpan
+func newRecoveryCodes() ([]string, []string, error) {
+	codes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = utils.HashToken(code)
+	}
+	return codes, hashes, nil
+}
+
+// MFAStatusService godoc
+// @Summary Status 2FA
+// @Description Menampilkan apakah 2FA (TOTP) aktif untuk user yang sedang login, sisa recovery code, dan apakah 2FA diwajibkan.
+// @Tags MFA
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Status 2FA"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/mfa/status [get]
+func (s *AuthService) MFAStatusService(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	user, err := s.users.GetByID(userID)
+	if err != nil {
+		return err
+	}
+	remaining, err := s.mfa.CountUnusedRecoveryCodes(userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"totp_enabled":            user.TOTPEnabled,
+			"recovery_codes_left":     remaining,
+			"mfa_required":            utils.MFARequired(user.Role),
+			"mfa_enrollment_required": utils.MFAEnrollmentRequired(*user),
+		},
+	})
+}
+
+// MFAEnrollService godoc
+// @Summary Mulai enrollment 2FA (TOTP)
+// @Description Membuat secret TOTP baru dan provisioning URI (otpauth://) untuk ditampilkan sebagai QR code di aplikasi authenticator. 2FA baru aktif setelah kode diverifikasi lewat /api/mfa/totp/verify.
+// @Tags MFA
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} model.TOTPEnrollResponse
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 409 {object} model.ErrorResponse "2FA sudah aktif"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/mfa/totp/enroll [post]
+func (s *AuthService) MFAEnrollService(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+	username := c.Locals("username").(string)
+
+	totp, err := s.mfa.GetTOTP(userID)
+	if err != nil {
+		return err
+	}
+	if totp.Enabled {
+		return apperror.Conflict("mfa.already_enabled_reenroll")
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return apperror.Internal("mfa.secret_failed").Wrap(err)
+	}
+	if err := s.mfa.SetTOTPSecret(userID, secret); err != nil {
+		return apperror.Internal("mfa.secret_save_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "mfa.enroll_started"),
+		"data": model.TOTPEnrollResponse{
+			Secret:          secret,
+			ProvisioningURI: utils.TOTPProvisioningURI(config.GetEnv("MFA_ISSUER", "Alumni Portal"), username, secret),
+		},
+	})
+}
+
+// MFAVerifyService godoc
+// @Summary Verifikasi & aktifkan 2FA
+// @Description Mengaktifkan 2FA setelah kode TOTP pertama benar. Recovery code dikembalikan sekali ini saja, beserta token baru untuk sesi ini.
+// @Tags MFA
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param body body model.TOTPCodeRequest true "Kode TOTP 6 digit"
+// @Success 200 {object} model.TOTPVerifyResponse
+// @Failure 400 {object} model.ErrorResponse "Kode salah atau enrollment belum dimulai"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 409 {object} model.ErrorResponse "2FA sudah aktif"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/mfa/totp/verify [post]
+func (s *AuthService) MFAVerifyService(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	var req model.TOTPCodeRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return apperror.BadRequest("mfa.code_required")
+	}
+
+	totp, err := s.mfa.GetTOTP(userID)
+	if err != nil {
+		return err
+	}
+	if totp.Enabled {
+		return apperror.Conflict("mfa.already_enabled")
+	}
+	if totp.Secret == "" {
+		return apperror.BadRequest("mfa.enrollment_not_started")
+	}
+
+	ok, counter := utils.ValidateTOTP(totp.Secret, req.Code, time.Now())
+	if !ok {
+		return apperror.BadRequest("mfa.wrong_code")
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return apperror.Internal("mfa.recovery_failed").Wrap(err)
+	}
+	if err := s.mfa.EnableTOTP(userID, counter, hashes); err != nil {
+		return apperror.Internal("mfa.enable_failed").Wrap(err)
+	}
+
+	// Token lama mungkin masih membawa klaim mfa_enroll, ganti dengan sesi baru
+	user, err := s.users.GetByID(userID)
+	if err != nil {
+		return err
+	}
+	sessionID := c.Locals("session_id").(string)
+	if err := s.tokens.RevokeFamily(sessionID); err != nil {
+		log.Println("Gagal mencabut sesi lama setelah aktivasi 2FA:", err)
+	}
+	tokens, err := s.issueTokens(*user, uuid.New().String())
+	if err != nil {
+		return apperror.Internal("auth.token_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "mfa.enabled"),
+		"data": model.TOTPVerifyResponse{
+			RecoveryCodes: codes,
+			Tokens:        &tokens,
+		},
+	})
+}
+
+// MFADisableService godoc
+// @Summary Nonaktifkan 2FA
+// @Description Menonaktifkan 2FA dengan konfirmasi kode TOTP. Tidak diizinkan untuk admin jika 2FA diwajibkan oleh kebijakan.
+// @Tags MFA
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param body body model.TOTPCodeRequest true "Kode TOTP 6 digit"
+// @Success 200 {object} map[string]interface{} "2FA dinonaktifkan"
+// @Failure 400 {object} model.ErrorResponse "Kode salah atau 2FA belum aktif"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "2FA wajib untuk admin"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/mfa/totp/disable [post]
+func (s *AuthService) MFADisableService(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+	role := c.Locals("role").(string)
+
+	if utils.MFARequired(role) {
+		return apperror.Forbidden("mfa.required_for_admin")
+	}
+
+	var req model.TOTPCodeRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return apperror.BadRequest("mfa.code_required")
+	}
+
+	totp, err := s.mfa.GetTOTP(userID)
+	if err != nil {
+		return err
+	}
+	if !totp.Enabled {
+		return apperror.BadRequest("mfa.not_enabled")
+	}
+	ok, err := s.verifyUserTOTP(totp, req.Code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return apperror.BadRequest("mfa.wrong_code")
+	}
+
+	if err := s.mfa.DisableTOTP(userID); err != nil {
+		return apperror.Internal("mfa.disable_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{"success": true, "message": i18n.T(c, "mfa.disabled")})
+}
+
+// MFARecoveryCodesService godoc
+// @Summary Buat ulang recovery code
+// @Description Mengganti semua recovery code dengan yang baru (yang lama tidak berlaku). Butuh konfirmasi kode TOTP.
+// @Tags MFA
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param body body model.TOTPCodeRequest true "Kode TOTP 6 digit"
+// @Success 200 {object} model.TOTPVerifyResponse
+// @Failure 400 {object} model.ErrorResponse "Kode salah atau 2FA belum aktif"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/mfa/recovery-codes [post]
+func (s *AuthService) MFARecoveryCodesService(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	var req model.TOTPCodeRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return apperror.BadRequest("mfa.code_required")
+	}
+
+	totp, err := s.mfa.GetTOTP(userID)
+	if err != nil {
+		return err
+	}
+	if !totp.Enabled {
+		return apperror.BadRequest("mfa.not_enabled")
+	}
+	ok, err := s.verifyUserTOTP(totp, req.Code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return apperror.BadRequest("mfa.wrong_code")
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return apperror.Internal("mfa.recovery_failed").Wrap(err)
+	}
+	if err := s.mfa.ReplaceRecoveryCodes(userID, hashes); err != nil {
+		return apperror.Internal("mfa.recovery_save_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "mfa.recovery_regenerated"),
+		"data":    model.TOTPVerifyResponse{RecoveryCodes: codes},
+	})
+}
+
+// LoginMFAService godoc
+// @Summary Login langkah kedua (2FA)
+// @Description Menukar mfa_token dari /api/login dengan access token dan refresh token, memakai kode TOTP atau salah satu recovery code. mfa_token hanya bisa dipakai sekali, termasuk kalau kodenya salah.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body model.MFALoginRequest true "mfa_token dan kode TOTP / recovery code"
+// @Success 200 {object} model.LoginResponse
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token MFA tidak valid, sudah dipakai, atau kode salah"
+// @Failure 429 {object} model.ErrorResponse "Terlalu banyak percobaan gagal"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/login/mfa [post]
+func (s *AuthService) LoginMFAService(c *fiber.Ctx) error {
+	var req model.MFALoginRequest
+	if err := c.BodyParser(&req); err != nil || req.MFAToken == "" {
+		return apperror.BadRequest("mfa.token_required")
+	}
+	if req.Code == "" && req.RecoveryCode == "" {
+		return apperror.BadRequest("mfa.code_or_recovery_required")
+	}
+
+	challenge, err := utils.ValidateMFAToken(req.MFAToken)
+	if err != nil {
+		return apperror.Unauthorized("mfa.token_invalid")
+	}
+
+	// Token challenge hanya berlaku sekali dan dipakai sebelum kode dicek: replay ditolak
+	// tanpa menghabiskan recovery code, dan request bersamaan tidak bisa memakai beberapa kode
+	fresh, err := s.mfa.ConsumeChallenge(challenge.UserID, challenge.JTI, challenge.ExpiresAt)
+	if err != nil {
+		return apperror.Internal("auth.login_check_failed").Wrap(err)
+	}
+	if !fresh {
+		return apperror.Unauthorized("mfa.token_invalid")
+	}
+
+	// Kode 2FA ikut dibatasi seperti password (per IP dan per akun) supaya tidak bisa ditebak
+	ip := c.IP()
+	ipPolicy := utils.IPLoginThrottle()
+	retryAt, err := s.loginRetryAt(repository.LoginScopeIP, ip, ipPolicy)
+	if err != nil {
+		return apperror.Internal("auth.login_check_failed").Wrap(err)
+	}
+	if !retryAt.IsZero() {
+		return tooManyLoginAttempts(c, retryAt)
+	}
+
+	user, err := s.users.GetByID(challenge.UserID)
+	if err != nil || !user.TOTPEnabled || !user.IsActive {
+		return apperror.Unauthorized("mfa.token_invalid")
+	}
+
+	accountPolicy := utils.AccountLoginThrottle()
+	accountKey := loginAccountKey(user, "")
+	retryAt, err = s.loginRetryAt(repository.LoginScopeAccount, accountKey, accountPolicy)
+	if err != nil {
+		return apperror.Internal("auth.login_check_failed").Wrap(err)
+	}
+	if !retryAt.IsZero() {
+		return tooManyLoginAttempts(c, retryAt)
+	}
+
+	var ok bool
+	if req.Code != "" {
+		totp, err := s.mfa.GetTOTP(user.ID)
+		if err != nil {
+			return err
+		}
+		ok, err = s.verifyUserTOTP(totp, req.Code)
+		if err != nil {
+			return err
+		}
+	} else {
+		ok, err = s.mfa.UseRecoveryCode(user.ID, utils.HashToken(utils.NormalizeRecoveryCode(req.RecoveryCode)))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !ok {
+		s.registerLoginFailure(repository.LoginScopeAccount, accountKey, ip, accountPolicy, user)
+		s.registerLoginFailure(repository.LoginScopeIP, ip, ip, ipPolicy, user)
+		return apperror.Unauthorized("mfa.wrong_code")
+	}
+
+	if _, err := s.attempts.Clear(repository.LoginScopeAccount, accountKey); err != nil {
+		log.Println("Gagal reset percobaan login:", err)
+	}
+
+	resp, err := s.issueTokens(*user, uuid.New().String())
+	if err != nil {
+		return apperror.Internal("auth.token_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "auth.login_success"),
+		"data":    resp,
+	})
+}
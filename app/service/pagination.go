@@ -0,0 +1,101 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/config"
+	"backendgo/utils"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// maxListLimit batas limit per halaman untuk endpoint list
+func maxListLimit() int {
+	return config.GetInt("LIST_MAX_LIMIT", 100)
+}
+
+// parseLimit baca limit dari query (default 10, 1..LIST_MAX_LIMIT)
+func parseLimit(c *fiber.Ctx) (int, error) {
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if max := maxListLimit(); err != nil || limit < 1 || limit > max {
+		return 0, apperror.BadRequest("list.invalid_limit", max)
+	}
+	return limit, nil
+}
+
+// parsePage baca page & limit dari query (page minimal 1)
+func parsePage(c *fiber.Ctx) (page, limit int, err error) {
+	page, err = strconv.Atoi(c.Query("page", "1"))
+	if err != nil || page < 1 {
+		return 0, 0, apperror.BadRequest("list.invalid_page")
+	}
+	limit, err = parseLimit(c)
+	return page, limit, err
+}
+
+// isCursorMode cursor pagination dipakai jika ada ?cursor=... atau ?pagination=cursor
+func isCursorMode(c *fiber.Ctx) bool {
+	return c.Query("cursor") != "" || c.Query("pagination") == "cursor"
+}
+
+// parseListCursor baca limit + cursor untuk mode cursor pagination (cursor kosong = halaman pertama)
+func parseListCursor(c *fiber.Ctx, q repository.ListQuery) (*utils.Cursor, int, error) {
+	limit, err := parseLimit(c)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := q.CheckCursorSort(); err != nil {
+		return nil, 0, err
+	}
+	raw := c.Query("cursor")
+	if raw == "" {
+		return nil, limit, nil
+	}
+	cur, err := utils.DecodeCursor(raw, q.SortKey(), q.CursorKinds())
+	if err != nil {
+		return nil, 0, err
+	}
+	return cur, limit, nil
+}
+
+// listMeta meta pagination + sort & filter yang dipakai
+func listMeta(q repository.ListQuery, page, limit, total int) model.MetaInfo {
+	meta := model.MetaInfo{
+		Page:    page,
+		Limit:   limit,
+		Total:   total,
+		Pages:   (total + limit - 1) / limit,
+		Search:  q.Search,
+		Sort:    q.Sort,
+		Filters: q.Filters,
+	}
+	if len(q.Sort) > 0 {
+		meta.SortBy, meta.Order = q.Sort[0].Column, q.Sort[0].Order
+	}
+	return meta
+}
+
+// cursorMeta meta untuk mode cursor pagination
+func cursorMeta(q repository.ListQuery, limit int, page utils.CursorPage) model.CursorMeta {
+	meta := model.CursorMeta{
+		Limit:      limit,
+		NextCursor: optionalCursor(page.Next),
+		PrevCursor: optionalCursor(page.Prev),
+		Search:     q.Search,
+		Sort:       q.Sort,
+		Filters:    q.Filters,
+	}
+	if len(q.Sort) > 0 {
+		meta.SortBy, meta.Order = q.Sort[0].Column, q.Sort[0].Order
+	}
+	return meta
+}
+
+func optionalCursor(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/config"
+	"backendgo/i18n"
+	"backendgo/mailer"
+	"backendgo/utils"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// MinPasswordLength panjang minimal password (API dan CLI)
+const MinPasswordLength = 8
+
+// validateNewPassword error 400 kalau password baru tidak memenuhi aturan
+func validateNewPassword(password string) error {
+	if len(password) < MinPasswordLength {
+		return apperror.BadRequest("password.too_short", MinPasswordLength)
+	}
+	return nil
+}
+
+// ChangePasswordService godoc
+// @Summary Ganti password sendiri
+// @Description Mengganti password user yang sedang login. Password lama wajib benar. Setelah berhasil, semua sesi lain dicabut dan token baru dikembalikan.
+// @Tags Auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param body body model.ChangePasswordRequest true "Password lama dan baru"
+// @Success 200 {object} model.LoginResponse
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Password lama salah"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/profile/password [put]
+func (s *AuthService) ChangePasswordService(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	var req model.ChangePasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if req.OldPassword == "" || req.NewPassword == "" {
+		return apperror.BadRequest("password.fields_required")
+	}
+	if err := validateNewPassword(req.NewPassword); err != nil {
+		return err
+	}
+	if req.OldPassword == req.NewPassword {
+		return apperror.BadRequest("password.same_as_old")
+	}
+
+	user, err := s.users.GetByID(userID)
+	if err != nil {
+		return apperror.Unauthorized("user.not_found")
+	}
+	if !utils.CheckPassword(req.OldPassword, user.PasswordHash) {
+		return apperror.Unauthorized("password.wrong_old")
+	}
+
+	hash, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		return apperror.Internal("password.hash_failed").Wrap(err)
+	}
+	if err := s.users.UpdatePassword(userID, hash, false); err != nil {
+		return apperror.Internal("password.save_failed").Wrap(err)
+	}
+
+	// Sesi lama (termasuk yang mungkin dipegang orang lain) tidak berlaku lagi
+	if err := s.tokens.RevokeAllForUser(userID); err != nil {
+		log.Println("Gagal mencabut sesi setelah ganti password:", err)
+	}
+
+	user.MustChangePassword = false
+	resp, err := s.issueTokens(*user, uuid.New().String())
+	if err != nil {
+		return apperror.Internal("auth.token_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "password.changed"),
+		"data":    resp,
+	})
+}
+
+// AdminResetPasswordService godoc
+// @Summary Reset password user (admin)
+// @Description Membuat token reset password sekali pakai dan mengirim link reset ke email user. Token sebelumnya yang belum dipakai otomatis dibatalkan.
+// @Tags Users
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "ID User"
+// @Success 200 {object} map[string]interface{} "Link reset password dikirim"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "User tidak ditemukan"
+// @Failure 409 {object} model.ErrorResponse "Akun user dinonaktifkan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/users/{id}/password-reset [post]
+func (s *UserService) AdminResetPasswordService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+	adminID := c.Locals("user_id").(int)
+
+	user, err := s.users.GetByID(id)
+	if err != nil {
+		return apperror.NotFound("user.not_found")
+	}
+	// akun nonaktif tidak boleh mendapat password baru lewat link reset
+	if !user.IsActive {
+		return apperror.Conflict("password.reset_account_disabled")
+	}
+
+	token, err := utils.GenerateOpaqueToken(32)
+	if err != nil {
+		return apperror.Internal("password.reset_token_failed").Wrap(err)
+	}
+	ttl := config.GetDuration("PASSWORD_RESET_TTL", 24*time.Hour)
+	expiresAt := time.Now().Add(ttl)
+
+	if err := s.resets.Create(user.ID, adminID, utils.HashToken(token), expiresAt); err != nil {
+		return apperror.Internal("password.reset_token_save_failed").Wrap(err)
+	}
+
+	link := strings.ReplaceAll(
+		config.GetEnv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password?token={token}"),
+		"{token}", token,
+	)
+	err = mailer.Default().Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Reset password akun alumni",
+		Body: fmt.Sprintf(
+			"Halo %s,\n\nAdmin telah meminta reset password untuk akun Anda.\n"+
+				"Buka link berikut untuk membuat password baru (berlaku sampai %s):\n\n%s\n\n"+
+				"Abaikan email ini jika Anda tidak merasa membutuhkannya.\n",
+			user.Username, expiresAt.Format("02-01-2006 15:04"), link,
+		),
+	})
+	if err != nil {
+		log.Println("Gagal mengirim email reset password:", err)
+		return apperror.Internal("password.reset_email_failed")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "password.reset_link_sent"),
+		"data": fiber.Map{
+			"user_id":    user.ID,
+			"email":      user.Email,
+			"expires_at": expiresAt,
+		},
+	})
+}
+
+// ResetPasswordService godoc
+// @Summary Reset password dengan token
+// @Description Membuat password baru memakai token sekali pakai dari email reset password. Semua sesi user dicabut setelah berhasil.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body model.ResetPasswordRequest true "Token reset dan password baru"
+// @Success 200 {object} map[string]interface{} "Password berhasil direset"
+// @Failure 400 {object} model.ErrorResponse "Token tidak valid, kadaluarsa, atau password tidak memenuhi syarat"
+// @Failure 403 {object} model.ErrorResponse "Akun dinonaktifkan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/password/reset [post]
+func (s *AuthService) ResetPasswordService(c *fiber.Ctx) error {
+	var req model.ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if req.Token == "" || req.NewPassword == "" {
+		return apperror.BadRequest("password.reset_fields_required")
+	}
+	if err := validateNewPassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	stored, err := s.resets.GetByHash(utils.HashToken(req.Token))
+	if err != nil && !errors.Is(err, repository.ErrResetTokenNotFound) {
+		return apperror.Internal("password.reset_token_process_failed").Wrap(err)
+	}
+	if err != nil || stored.UsedAt != nil || time.Now().After(stored.ExpiresAt) {
+		return apperror.BadRequest("password.reset_token_invalid")
+	}
+
+	// token milik akun yang sudah dinonaktifkan tidak dipakai (dan tidak dihabiskan)
+	user, err := s.users.GetByID(stored.UserID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return apperror.BadRequest("password.reset_token_invalid")
+	}
+	if err != nil {
+		return apperror.Internal("password.reset_token_process_failed").Wrap(err)
+	}
+	if !user.IsActive {
+		return apperror.Forbidden("auth.account_disabled")
+	}
+
+	marked, err := s.resets.MarkUsed(stored.ID)
+	if err != nil {
+		return apperror.Internal("password.reset_token_process_failed").Wrap(err)
+	}
+	if !marked {
+		return apperror.BadRequest("password.reset_token_invalid")
+	}
+
+	hash, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		return apperror.Internal("password.hash_failed").Wrap(err)
+	}
+	if err := s.users.UpdatePassword(stored.UserID, hash, false); err != nil {
+		return apperror.Internal("password.save_failed").Wrap(err)
+	}
+	if err := s.tokens.RevokeAllForUser(stored.UserID); err != nil {
+		log.Println("Gagal mencabut sesi setelah reset password:", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "password.reset_success"),
+	})
+}
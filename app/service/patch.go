@@ -0,0 +1,71 @@
+package service
+
+import (
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/utils"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ===================================================
+// 🔹 PATCH (JSON Merge Patch) + optimistic concurrency
+// Versi baris dikirim sebagai ETag, mis. ETag: "3". Client yang mengirim
+// If-Match: "3" saat PUT / PATCH mendapat 412 kalau data sudah diubah orang lain.
+// ===================================================
+
+// setETag kirim versi data sebagai ETag
+func setETag(c *fiber.Ctx, version int) {
+	c.Set(fiber.HeaderETag, strconv.Quote(strconv.Itoa(version)))
+}
+
+// ifMatchVersion versi dari header If-Match; 0 kalau header kosong atau "*" (tanpa cek).
+// Awalan W/ diterima karena proxy kadang melemahkan ETag.
+func ifMatchVersion(c *fiber.Ctx) (int, error) {
+	header := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, apperror.BadRequest("common.invalid_if_match")
+	}
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version < 1 {
+		return 0, apperror.BadRequest("common.invalid_if_match")
+	}
+	return version, nil
+}
+
+// checkIfMatch 412 kalau If-Match ada dan tidak sama dengan versi data saat ini
+func checkIfMatch(c *fiber.Ctx, current int) error {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+	if version != 0 && version != current {
+		return repository.ErrVersionConflict
+	}
+	return nil
+}
+
+// mergePatch terapkan body request (JSON Merge Patch) ke current. Field yang dihapus
+// lewat null jadi nilai kosong, jadi hasilnya tetap harus divalidasi seperti PUT.
+func mergePatch[T any](c *fiber.Ctx, current T) (T, error) {
+	var patched T
+	doc, err := json.Marshal(current)
+	if err != nil {
+		return patched, err
+	}
+	merged, err := utils.ApplyMergePatch(doc, c.Body())
+	if err != nil {
+		return patched, apperror.BadRequest("common.invalid_patch")
+	}
+	if err := json.Unmarshal(merged, &patched); err != nil {
+		return patched, apperror.BadRequest("common.invalid_body")
+	}
+	return patched, nil
+}
@@ -3,7 +3,11 @@ package service
 import (
 	"backendgo/app/model"
 	"backendgo/app/repository"
-	"fmt"
+	"backendgo/apperror"
+	"backendgo/i18n"
+	"backendgo/middleware"
+	"database/sql"
+	"errors"
 	"log"
 	"strconv"
 	"time"
@@ -13,6 +17,33 @@ import (
 
 
 
+// PekerjaanService handler CRUD, list, trash, dan export pekerjaan alumni
+type PekerjaanService struct {
+	pekerjaan repository.PekerjaanRepository
+}
+
+func NewPekerjaanService(pekerjaan repository.PekerjaanRepository) *PekerjaanService {
+	return &PekerjaanService{pekerjaan: pekerjaan}
+}
+
+// parseTanggalKerja parse tanggal mulai / selesai kerja (YYYY-MM-DD), error 400 kalau formatnya salah
+func parseTanggalKerja(mulaiStr string, selesaiStr *string) (time.Time, *time.Time, error) {
+	mulai, err := time.Parse("2006-01-02", mulaiStr)
+	if err != nil {
+		return time.Time{}, nil, apperror.BadRequest("pekerjaan.start_date_invalid")
+	}
+
+	var selesai *time.Time
+	if selesaiStr != nil && *selesaiStr != "" {
+		t, err := time.Parse("2006-01-02", *selesaiStr)
+		if err != nil {
+			return time.Time{}, nil, apperror.BadRequest("pekerjaan.end_date_invalid")
+		}
+		selesai = &t
+	}
+	return mulai, selesai, nil
+}
+
 // GetAllPekerjaanService godoc
 // @Summary Ambil semua data pekerjaan
 // @Description Mengambil semua data pekerjaan dari database (hanya bisa diakses user yang login)
@@ -20,13 +51,13 @@ import (
 // @Security BearerAuth
 // @Produce json
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data pekerjaan"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Terjadi kesalahan server"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Terjadi kesalahan server"
 // @Router /api/pekerjaan [get]
-func GetAllPekerjaanService(c *fiber.Ctx) error {
-	data, err := repository.GetAllPekerjaan()
+func (s *PekerjaanService) GetAllPekerjaanService(c *fiber.Ctx) error {
+	data, err := s.pekerjaan.GetAll()
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"success": false, "error": err.Error()})
+		return err
 	}
 	return c.JSON(fiber.Map{"success": true, "data": data})
 }
@@ -40,19 +71,21 @@ func GetAllPekerjaanService(c *fiber.Ctx) error {
 // @Produce json
 // @Param id path int true "ID Pekerjaan"
 // @Success 200 {object} map[string]interface{} "Data pekerjaan ditemukan"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 404 {object} map[string]string "Data pekerjaan tidak ditemukan"
+// @Header 200 {string} ETag "Versi data, kirim balik lewat If-Match saat update"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Data pekerjaan tidak ditemukan"
 // @Router /api/pekerjaan/{id} [get]
-func GetPekerjaanByIDService(c *fiber.Ctx) error {
+func (s *PekerjaanService) GetPekerjaanByIDService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+		return apperror.BadRequest("common.invalid_id")
 	}
-	data, err := repository.GetPekerjaanByID(id)
+	data, err := s.pekerjaan.GetByID(id)
 	if err != nil {
-		return c.Status(404).JSON(fiber.Map{"error": "Pekerjaan tidak ditemukan"})
+		return apperror.NotFound("pekerjaan.not_found")
 	}
+	setETag(c, data.Version)
 	return c.JSON(fiber.Map{"success": true, "data": data})
 }
 
@@ -65,18 +98,18 @@ func GetPekerjaanByIDService(c *fiber.Ctx) error {
 // @Produce json
 // @Param alumni_id path int true "ID Alumni"
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data pekerjaan alumni"
-// @Failure 400 {object} map[string]string "ID alumni tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Kesalahan server"
+// @Failure 400 {object} model.ErrorResponse "ID alumni tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
 // @Router /api/pekerjaan/alumni/{alumni_id} [get]
-func GetPekerjaanByAlumniIDService(c *fiber.Ctx) error {
+func (s *PekerjaanService) GetPekerjaanByAlumniIDService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("alumni_id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "invalid alumni_id"})
+		return apperror.BadRequest("alumni.id_invalid")
 	}
-	data, err := repository.GetPekerjaanByAlumniID(id)
+	data, err := s.pekerjaan.GetByAlumniID(id)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 	return c.JSON(fiber.Map{"success": true, "data": data})
 }
@@ -91,28 +124,23 @@ func GetPekerjaanByAlumniIDService(c *fiber.Ctx) error {
 // @Produce json
 // @Param body body model.CreatePekerjaanRequest true "Data pekerjaan baru"
 // @Success 201 {object} map[string]interface{} "Pekerjaan berhasil dibuat"
-// @Failure 400 {object} map[string]string "Body request tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal menyimpan data pekerjaan"
+// @Failure 400 {object} model.ErrorResponse "Body request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Gagal menyimpan data pekerjaan"
 // @Router /api/pekerjaan [post]
-func CreatePekerjaanService(c *fiber.Ctx) error {
+func (s *PekerjaanService) CreatePekerjaanService(c *fiber.Ctx) error {
 	var req model.CreatePekerjaanRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "Body tidak valid", "detail": err.Error()})
+		return apperror.BadRequest("common.invalid_body").Wrap(err)
 	}
-
-	mulai, err := time.Parse("2006-01-02", req.TanggalMulaiKerja)
-	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "Format tanggal_mulai_kerja harus YYYY-MM-DD"})
+	if err := middleware.Validate(&req); err != nil {
+		return err
 	}
 
-	var selesai *time.Time
-	if req.TanggalSelesaiKerja != nil && *req.TanggalSelesaiKerja != "" {
-		t, err := time.Parse("2006-01-02", *req.TanggalSelesaiKerja)
-		if err != nil {
-			return c.Status(400).JSON(fiber.Map{"error": "Format tanggal_selesai_kerja harus YYYY-MM-DD"})
-		}
-		selesai = &t
+	mulai, selesai, err := parseTanggalKerja(req.TanggalMulaiKerja, req.TanggalSelesaiKerja)
+	if err != nil {
+		return err
 	}
 
 	data := model.PekerjaanAlumni{
@@ -130,10 +158,10 @@ func CreatePekerjaanService(c *fiber.Ctx) error {
 		UpdatedAt:           time.Now(),
 	}
 
-	newData, err := repository.CreatePekerjaan(data)
+	newData, err := s.pekerjaan.Create(data)
 	if err != nil {
 		log.Println("Service error CreatePekerjaan:", err)
-		return c.Status(500).JSON(fiber.Map{"error": "Gagal insert", "detail": err.Error()})
+		return apperror.Internal("alumni.create_failed").Wrap(err)
 	}
 	return c.Status(201).JSON(fiber.Map{"success": true, "data": newData})
 }
@@ -141,41 +169,105 @@ func CreatePekerjaanService(c *fiber.Ctx) error {
 
 // UpdatePekerjaanService godoc
 // @Summary Update data pekerjaan
-// @Description Memperbarui data pekerjaan berdasarkan ID (hanya bisa diakses user yang login)
+// @Description Memperbarui seluruh data pekerjaan berdasarkan ID (hanya bisa diakses user yang login). Kirim If-Match berisi ETag terakhir supaya perubahan orang lain tidak tertimpa.
 // @Tags Pekerjaan
 // @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param id path int true "ID Pekerjaan"
+// @Param If-Match header string false "ETag dari respon GET / PUT / PATCH sebelumnya"
 // @Param body body model.UpdatePekerjaanRequest true "Data pekerjaan yang diperbarui"
 // @Success 200 {object} map[string]interface{} "Pekerjaan berhasil diperbarui"
-// @Failure 400 {object} map[string]string "Body atau ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal memperbarui data pekerjaan"
+// @Header 200 {string} ETag "Versi data terbaru"
+// @Failure 400 {object} model.ErrorResponse "Body, ID, atau If-Match tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Data pekerjaan tidak ditemukan"
+// @Failure 412 {object} model.ErrorResponse "Data sudah diubah pengguna lain (If-Match tidak cocok)"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Gagal memperbarui data pekerjaan"
 // @Router /api/pekerjaan/{id} [put]
-func UpdatePekerjaanService(c *fiber.Ctx) error {
+func (s *PekerjaanService) UpdatePekerjaanService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "Invalid id"})
+		return apperror.BadRequest("common.invalid_id")
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
 	}
 
 	var req model.UpdatePekerjaanRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "Body tidak valid"})
+		return apperror.BadRequest("common.invalid_body")
 	}
+	return s.savePekerjaan(c, id, req, version)
+}
 
-	mulai, err := time.Parse("2006-01-02", req.TanggalMulaiKerja)
+
+// PatchPekerjaanService godoc
+// @Summary Update sebagian data pekerjaan
+// @Description Mengubah sebagian data pekerjaan dengan JSON Merge Patch (RFC 7386): field yang tidak dikirim tetap, null mengosongkan field (mis. tanggal_selesai_kerja). Hasil akhirnya divalidasi seperti PUT. Tanpa If-Match, patch diterapkan pada versi yang baru dibaca dan tetap ditolak (412) kalau data berubah di tengah jalan.
+// @Tags Pekerjaan
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID Pekerjaan"
+// @Param If-Match header string false "ETag dari respon GET / PUT / PATCH sebelumnya"
+// @Param body body model.UpdatePekerjaanRequest true "Field pekerjaan yang diubah (application/merge-patch+json atau application/json)"
+// @Success 200 {object} map[string]interface{} "Pekerjaan berhasil diperbarui"
+// @Header 200 {string} ETag "Versi data terbaru"
+// @Failure 400 {object} model.ErrorResponse "Body, ID, atau If-Match tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Data pekerjaan tidak ditemukan"
+// @Failure 412 {object} model.ErrorResponse "Data sudah diubah pengguna lain (If-Match tidak cocok)"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Gagal memperbarui data pekerjaan"
+// @Router /api/pekerjaan/{id} [patch]
+func (s *PekerjaanService) PatchPekerjaanService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "Format tanggal_mulai_kerja harus YYYY-MM-DD"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
-	var selesai *time.Time
-	if req.TanggalSelesaiKerja != nil && *req.TanggalSelesaiKerja != "" {
-		t, err := time.Parse("2006-01-02", *req.TanggalSelesaiKerja)
-		if err != nil {
-			return c.Status(400).JSON(fiber.Map{"error": "Format tanggal_selesai_kerja harus YYYY-MM-DD"})
-		}
-		selesai = &t
+	current, err := s.pekerjaan.GetByID(id)
+	if err != nil {
+		return apperror.NotFound("pekerjaan.not_found")
+	}
+	if err := checkIfMatch(c, current.Version); err != nil {
+		return err
+	}
+
+	base := model.UpdatePekerjaanRequest{
+		NamaPerusahaan:     current.NamaPerusahaan,
+		PosisiJabatan:      current.PosisiJabatan,
+		BidangIndustri:     current.BidangIndustri,
+		LokasiKerja:        current.LokasiKerja,
+		GajiRange:          current.GajiRange,
+		TanggalMulaiKerja:  current.TanggalMulaiKerja.Format("2006-01-02"),
+		StatusPekerjaan:    current.StatusPekerjaan,
+		DeskripsiPekerjaan: current.DeskripsiPekerjaan,
+	}
+	if current.TanggalSelesaiKerja != nil {
+		selesai := current.TanggalSelesaiKerja.Format("2006-01-02")
+		base.TanggalSelesaiKerja = &selesai
+	}
+
+	req, err := mergePatch(c, base)
+	if err != nil {
+		return err
+	}
+	return s.savePekerjaan(c, id, req, current.Version)
+}
+
+// savePekerjaan validasi lalu simpan data pekerjaan lengkap (PUT / hasil PATCH)
+func (s *PekerjaanService) savePekerjaan(c *fiber.Ctx, id int, req model.UpdatePekerjaanRequest, version int) error {
+	if err := middleware.Validate(&req); err != nil {
+		return err
+	}
+
+	mulai, selesai, err := parseTanggalKerja(req.TanggalMulaiKerja, req.TanggalSelesaiKerja)
+	if err != nil {
+		return err
 	}
 
 	data := model.PekerjaanAlumni{
@@ -192,10 +284,16 @@ func UpdatePekerjaanService(c *fiber.Ctx) error {
 		UpdatedAt:           time.Now(),
 	}
 
-	updated, err := repository.UpdatePekerjaan(data)
-	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": "Gagal update"})
+	updated, err := s.pekerjaan.Update(data, version)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return apperror.NotFound("pekerjaan.not_found")
+	case errors.Is(err, repository.ErrVersionConflict):
+		return err
+	case err != nil:
+		return apperror.Internal("pekerjaan.update_failed").Wrap(err)
 	}
+	setETag(c, updated.Version)
 	return c.JSON(fiber.Map{"success": true, "data": updated})
 }
 
@@ -208,19 +306,19 @@ func UpdatePekerjaanService(c *fiber.Ctx) error {
 // @Produce json
 // @Param id path int true "ID Pekerjaan"
 // @Success 200 {object} map[string]string "Pekerjaan berhasil dihapus"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal menghapus pekerjaan"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal menghapus pekerjaan"
 // @Router /api/pekerjaan/{id} [delete]
-func DeletePekerjaanService(c *fiber.Ctx) error {
+func (s *PekerjaanService) DeletePekerjaanService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "invalid id"})
+		return apperror.BadRequest("common.invalid_id")
 	}
-	if err := repository.DeletePekerjaan(id); err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+	if err := s.pekerjaan.Delete(id); err != nil {
+		return err
 	}
-	return c.JSON(fiber.Map{"success": true, "message": "Pekerjaan berhasil dihapus"})
+	return c.JSON(fiber.Map{"success": true, "message": i18n.T(c, "pekerjaan.deleted")})
 }
 
 
@@ -231,33 +329,59 @@ func DeletePekerjaanService(c *fiber.Ctx) error {
 // @Security BearerAuth
 // @Produce json
 // @Param page query int false "Nomor halaman (default 1)"
-// @Param limit query int false "Jumlah data per halaman (default 10)"
-// @Param sortBy query string false "Kolom pengurutan (default created_at)"
+// @Param limit query int false "Jumlah data per halaman (default 10, maksimal 100)"
+// @Param pagination query string false "cursor = pakai cursor pagination (meta berisi next_cursor / prev_cursor, tanpa total)"
+// @Param cursor query string false "next_cursor / prev_cursor dari respons sebelumnya"
+// @Param sort query string false "Multi kolom, mis. -tanggal_mulai_kerja,nama_perusahaan (prefix - = desc)"
+// @Param sortBy query string false "Kolom pengurutan tunggal (default created_at)"
 // @Param order query string false "Urutan (asc/desc)"
 // @Param search query string false "Kata kunci pencarian"
+// @Param alumni_id query int false "Filter ID alumni"
+// @Param bidang_industri query string false "Filter bidang industri"
+// @Param status_pekerjaan query string false "Filter status pekerjaan"
+// @Param lokasi_kerja query string false "Filter lokasi kerja"
+// @Param mulai_from query string false "Tanggal mulai kerja sejak (YYYY-MM-DD)"
+// @Param mulai_to query string false "Tanggal mulai kerja sampai (YYYY-MM-DD)"
+// @Param selesai_from query string false "Tanggal selesai kerja sejak (YYYY-MM-DD)"
+// @Param selesai_to query string false "Tanggal selesai kerja sampai (YYYY-MM-DD)"
+// @Param created_from query string false "Dibuat sejak (YYYY-MM-DD)"
+// @Param created_to query string false "Dibuat sampai (YYYY-MM-DD)"
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data pekerjaan"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Kesalahan server"
+// @Failure 400 {object} model.ErrorResponse "Parameter sort / filter tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
 // @Router /api/pekerjaan/list [get]
-func GetAllPekerjaanPaginationService(c *fiber.Ctx) error {
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	limit, _ := strconv.Atoi(c.Query("limit", "10"))
-	sortBy := c.Query("sortBy", "created_at")
-	order := c.Query("order", "desc")
-	search := c.Query("search", "")
+func (s *PekerjaanService) GetAllPekerjaanPaginationService(c *fiber.Ctx) error {
+	q, err := repository.ParseListQuery(repository.PekerjaanListSpec, c.Query)
+	if err != nil {
+		return err
+	}
 
-	if page < 1 {
-		page = 1
+	if isCursorMode(c) {
+		cur, limit, err := parseListCursor(c, q)
+		if err != nil {
+			return err
+		}
+		data, page, err := s.pekerjaan.ListCursor(q, cur, limit)
+		if err != nil {
+			return err
+		}
+		return c.JSON(fiber.Map{"success": true, "data": data, "meta": cursorMeta(q, limit, page)})
+	}
+
+	page, limit, err := parsePage(c)
+	if err != nil {
+		return err
 	}
 	offset := (page - 1) * limit
 
-	data, err := repository.GetAllPekerjaanWithPagination(search, sortBy, order, limit, offset)
+	data, err := s.pekerjaan.List(q, limit, offset)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
-	total, err := repository.CountPekerjaan(search)
+	total, err := s.pekerjaan.Count(q)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
 	return c.JSON(fiber.Map{
@@ -267,120 +391,130 @@ func GetAllPekerjaanPaginationService(c *fiber.Ctx) error {
 		"limit":      limit,
 		"total_data": total,
 		"total_page": (total + limit - 1) / limit,
+		"meta":       listMeta(q, page, limit, total),
 	})
 }
 
 
 // SoftDeletePekerjaanService godoc
 // @Summary Soft delete pekerjaan
-// @Description Menandai data pekerjaan sebagai dihapus (tidak benar-benar dihapus dari database, hanya untuk user login)
+// @Description Menandai data pekerjaan sebagai dihapus (tidak benar-benar dihapus dari database). Alumni hanya bisa menghapus pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:manage_all
 // @Tags Pekerjaan
 // @Security BearerAuth
 // @Produce json
 // @Param id path int true "ID Pekerjaan"
 // @Success 200 {object} map[string]string "Soft delete pekerjaan berhasil"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal melakukan soft delete"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 404 {object} model.ErrorResponse "Data tidak ditemukan atau bukan milik alumni ini"
+// @Failure 500 {object} model.ErrorResponse "Gagal melakukan soft delete"
 // @Router /api/pekerjaan/{id}/soft-delete [put]
-func SoftDeletePekerjaanService(c *fiber.Ctx) error {
+func (s *PekerjaanService) SoftDeletePekerjaanService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
-	role := c.Locals("role").(string)
-	userID := c.Locals("user_id").(int)
-
-	if role == "admin" {
-		err = repository.SoftDeletePekerjaanAdmin(id)
+	if middleware.HasPermission(c, model.PermPekerjaanManageAll) {
+		err = s.pekerjaan.SoftDelete(id)
 	} else {
-		err = repository.SoftDeletePekerjaanUser(id, userID)
+		alumniID, ok := middleware.AlumniID(c)
+		if !ok {
+			return middleware.ErrAlumniNotLinked
+		}
+		err = s.pekerjaan.SoftDeleteOwned(id, alumniID)
 	}
 
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err // ErrPekerjaanNotOwned → 404
 	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"message": fmt.Sprintf("Soft delete pekerjaan id %d sukses", id),
+		"message": i18n.T(c, "pekerjaan.soft_deleted_id", id),
 	})
 }
 
 
 // RestorePekerjaanService godoc
 // @Summary Restore pekerjaan
-// @Description Mengembalikan data pekerjaan yang sudah di-soft delete (hanya bisa diakses user yang login)
+// @Description Mengembalikan data pekerjaan yang sudah di-soft delete. Alumni hanya bisa me-restore pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:manage_all
 // @Tags Pekerjaan
 // @Security BearerAuth
 // @Produce json
 // @Param id path int true "ID Pekerjaan"
 // @Success 200 {object} map[string]string "Restore pekerjaan berhasil"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal melakukan restore"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 404 {object} model.ErrorResponse "Data tidak ditemukan atau bukan milik alumni ini"
+// @Failure 500 {object} model.ErrorResponse "Gagal melakukan restore"
 // @Router /api/pekerjaan/{id}/restore [put]
-func RestorePekerjaanService(c *fiber.Ctx) error {
+func (s *PekerjaanService) RestorePekerjaanService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
-	role := c.Locals("role").(string)
-	userID := c.Locals("user_id").(int)
-
-	if role == "admin" {
-		err = repository.RestorePekerjaanAdmin(id)
+	if middleware.HasPermission(c, model.PermPekerjaanManageAll) {
+		err = s.pekerjaan.Restore(id)
 	} else {
-		err = repository.RestorePekerjaanUser(id, userID)
+		alumniID, ok := middleware.AlumniID(c)
+		if !ok {
+			return middleware.ErrAlumniNotLinked
+		}
+		err = s.pekerjaan.RestoreOwned(id, alumniID)
 	}
 
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err // ErrPekerjaanNotOwned → 404
 	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"message": fmt.Sprintf("Restore pekerjaan id %d sukses", id),
+		"message": i18n.T(c, "pekerjaan.restored_id", id),
 	})
 }
 
 
 // HardDeletePekerjaanService godoc
 // @Summary Hard delete pekerjaan
-// @Description Menghapus data pekerjaan secara permanen dari database (hanya bisa diakses user yang login)
+// @Description Menghapus data pekerjaan yang sudah di-soft delete secara permanen dari database. Alumni hanya bisa menghapus pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:hard_delete
 // @Tags Pekerjaan
 // @Security BearerAuth
 // @Produce json
 // @Param id path int true "ID Pekerjaan"
 // @Success 200 {object} map[string]string "Hard delete pekerjaan berhasil"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal melakukan hard delete"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 404 {object} model.ErrorResponse "Data tidak ditemukan atau bukan milik alumni ini"
+// @Failure 500 {object} model.ErrorResponse "Gagal melakukan hard delete"
 // @Router /api/pekerjaan/{id}/hard-delete [delete]
-func HardDeletePekerjaanService(c *fiber.Ctx) error {
+func (s *PekerjaanService) HardDeletePekerjaanService(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
-	role := c.Locals("role").(string)
-	userID := c.Locals("user_id").(int)
-
-	if role == "admin" {
-		err = repository.HardDeletePekerjaanAdmin(id)
+	if middleware.HasPermission(c, model.PermPekerjaanHardDelete) {
+		err = s.pekerjaan.HardDelete(id)
 	} else {
-		err = repository.HardDeletePekerjaanUser(id, userID)
+		alumniID, ok := middleware.AlumniID(c)
+		if !ok {
+			return middleware.ErrAlumniNotLinked
+		}
+		err = s.pekerjaan.HardDeleteOwned(id, alumniID)
 	}
 
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err // ErrPekerjaanNotOwned → 404
 	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"message": fmt.Sprintf("Hard delete pekerjaan id %d sukses", id),
+		"message": i18n.T(c, "pekerjaan.hard_deleted_id", id),
 	})
 }
 
@@ -388,29 +522,31 @@ func HardDeletePekerjaanService(c *fiber.Ctx) error {
 
 // GetTrashedPekerjaanService godoc
 // @Summary Ambil data pekerjaan yang dihapus (trashed)
-// @Description Menampilkan daftar pekerjaan yang sudah di-soft delete (hanya bisa diakses user yang login)
+// @Description Menampilkan daftar pekerjaan yang sudah di-soft delete milik alumni yang login, atau milik semua alumni untuk permission pekerjaan:manage_all
 // @Tags Pekerjaan
 // @Security BearerAuth
 // @Produce json
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data pekerjaan terhapus"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal mengambil data pekerjaan terhapus"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 500 {object} model.ErrorResponse "Gagal mengambil data pekerjaan terhapus"
 // @Router /api/pekerjaan/trashed [get]
-func GetTrashedPekerjaanService(c *fiber.Ctx) error {
-	role := c.Locals("role").(string)
-	userID := c.Locals("user_id").(int)
-
+func (s *PekerjaanService) GetTrashedPekerjaanService(c *fiber.Ctx) error {
 	var data []model.PekerjaanAlumniTrashed
 	var err error
 
-	if role == "admin" {
-		data, err = repository.GetTrashedPekerjaanAdmin()
+	if middleware.HasPermission(c, model.PermPekerjaanManageAll) {
+		data, err = s.pekerjaan.GetTrashed()
 	} else {
-		data, err = repository.GetTrashedPekerjaanUser(userID)
+		alumniID, ok := middleware.AlumniID(c)
+		if !ok {
+			return middleware.ErrAlumniNotLinked
+		}
+		data, err = s.pekerjaan.GetTrashedByAlumniID(alumniID)
 	}
 
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"success": false, "error": err.Error()})
+		return err
 	}
 
 	return c.JSON(fiber.Map{"success": true, "data": data})
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/i18n"
+	"backendgo/middleware"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// RBACService handler pengelolaan role & permission
+type RBACService struct {
+	rbac  repository.RBACRepository
+	audit repository.AuditRepository
+}
+
+func NewRBACService(rbac repository.RBACRepository, audit repository.AuditRepository) *RBACService {
+	return &RBACService{rbac: rbac, audit: audit}
+}
+
+// validatePermissionCodes mengembalikan kode permission yang tidak dikenal
+func (s *RBACService) validatePermissionCodes(codes []string) ([]string, error) {
+	all, err := s.rbac.GetAllPermissions()
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(all))
+	for _, p := range all {
+		known[p.Code] = true
+	}
+	var unknown []string
+	for _, code := range codes {
+		if !known[code] {
+			unknown = append(unknown, code)
+		}
+	}
+	return unknown, nil
+}
+
+func writeAudit(audit repository.AuditRepository, c *fiber.Ctx, action, target string, detail map[string]interface{}) {
+	actorID := c.Locals("user_id").(int)
+	if err := audit.Create(model.AuditLog{
+		ActorUserID: &actorID,
+		Action:      action,
+		Target:      target,
+		IP:          c.IP(),
+		Detail:      detail,
+	}); err != nil {
+		log.Println("Gagal menyimpan audit log:", err)
+	}
+}
+
+// GetRolesService godoc
+// @Summary Ambil semua role
+// @Description Menampilkan semua role beserta daftar permission-nya.
+// @Tags Roles
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Berhasil mengambil daftar role"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/roles [get]
+func (s *RBACService) GetRolesService(c *fiber.Ctx) error {
+	roles, err := s.rbac.GetAllRoles()
+	if err != nil {
+		return apperror.Internal("rbac.role_fetch_failed").Wrap(err)
+	}
+	return c.JSON(fiber.Map{"success": true, "data": roles})
+}
+
+// GetPermissionsService godoc
+// @Summary Ambil semua permission
+// @Description Menampilkan daftar permission yang bisa diberikan ke role.
+// @Tags Roles
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Berhasil mengambil daftar permission"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/permissions [get]
+func (s *RBACService) GetPermissionsService(c *fiber.Ctx) error {
+	perms, err := s.rbac.GetAllPermissions()
+	if err != nil {
+		return apperror.Internal("rbac.permission_fetch_failed").Wrap(err)
+	}
+	return c.JSON(fiber.Map{"success": true, "data": perms})
+}
+
+// CreateRoleService godoc
+// @Summary Buat role baru
+// @Description Membuat role baru dengan kumpulan permission. Nama role huruf kecil, angka, dan underscore (mis. operator_prodi).
+// @Tags Roles
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param body body model.RoleRequest true "Data role"
+// @Success 201 {object} model.Role
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 409 {object} model.ErrorResponse "Role sudah ada"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/roles [post]
+func (s *RBACService) CreateRoleService(c *fiber.Ctx) error {
+	var req model.RoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if !roleNamePattern.MatchString(req.Name) {
+		return apperror.BadRequest("rbac.role_name_invalid")
+	}
+
+	unknown, err := s.validatePermissionCodes(req.Permissions)
+	if err != nil {
+		return apperror.Internal("rbac.permission_check_failed").Wrap(err)
+	}
+	if len(unknown) > 0 {
+		return apperror.BadRequest("rbac.permission_unknown", strings.Join(unknown, ", "))
+	}
+
+	if _, err := s.rbac.GetRoleByName(req.Name); err == nil {
+		return apperror.Conflict("rbac.role_exists")
+	} else if !errors.Is(err, repository.ErrRoleNotFound) {
+		return apperror.Internal("rbac.role_fetch_failed").Wrap(err)
+	}
+	if err := s.rbac.CreateRole(req); err != nil {
+		return apperror.Internal("rbac.role_create_failed").Wrap(err)
+	}
+	middleware.InvalidatePermissionCache()
+	writeAudit(s.audit, c, model.AuditRoleCreated, req.Name, map[string]interface{}{"permissions": req.Permissions})
+
+	role, err := s.rbac.GetRoleByName(req.Name)
+	if err != nil {
+		return apperror.Internal("rbac.role_fetch_failed").Wrap(err)
+	}
+	return c.Status(201).JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "rbac.role_created"),
+		"data":    role,
+	})
+}
+
+// UpdateRoleService godoc
+// @Summary Update role
+// @Description Mengubah deskripsi role dan mengganti seluruh daftar permission-nya. Perubahan langsung berlaku untuk semua user dengan role tersebut.
+// @Tags Roles
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param name path string true "Nama role"
+// @Param body body model.RoleRequest true "Deskripsi dan permission baru (field name diabaikan)"
+// @Success 200 {object} model.Role
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "Role tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/roles/{name} [put]
+func (s *RBACService) UpdateRoleService(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var req model.RoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+
+	unknown, err := s.validatePermissionCodes(req.Permissions)
+	if err != nil {
+		return apperror.Internal("rbac.permission_check_failed").Wrap(err)
+	}
+	if len(unknown) > 0 {
+		return apperror.BadRequest("rbac.permission_unknown", strings.Join(unknown, ", "))
+	}
+
+	// Jangan sampai tidak ada lagi yang bisa mengelola role
+	if name == model.RoleAdmin && !containsString(req.Permissions, model.PermRolesManage) {
+		return apperror.BadRequest("rbac.admin_permission_required", model.PermRolesManage)
+	}
+
+	if err := s.rbac.UpdateRole(name, req); err != nil {
+		if errors.Is(err, repository.ErrRoleNotFound) {
+			return err
+		}
+		return apperror.Internal("rbac.role_update_failed").Wrap(err)
+	}
+	middleware.InvalidatePermissionCache()
+	writeAudit(s.audit, c, model.AuditRoleUpdated, name, map[string]interface{}{"permissions": req.Permissions})
+
+	role, err := s.rbac.GetRoleByName(name)
+	if err != nil {
+		return apperror.Internal("rbac.role_fetch_failed").Wrap(err)
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "rbac.role_updated"),
+		"data":    role,
+	})
+}
+
+// DeleteRoleService godoc
+// @Summary Hapus role
+// @Description Menghapus role. Role bawaan (admin, user) dan role yang masih dipakai user tidak bisa dihapus.
+// @Tags Roles
+// @Security BearerAuth
+// @Produce json
+// @Param name path string true "Nama role"
+// @Success 200 {object} map[string]interface{} "Role berhasil dihapus"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "Role tidak ditemukan"
+// @Failure 409 {object} model.ErrorResponse "Role bawaan atau masih dipakai user"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/roles/{name} [delete]
+func (s *RBACService) DeleteRoleService(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	role, err := s.rbac.GetRoleByName(name)
+	if err != nil {
+		if errors.Is(err, repository.ErrRoleNotFound) {
+			return err
+		}
+		return apperror.Internal("rbac.role_fetch_failed").Wrap(err)
+	}
+	if role.IsSystem {
+		return apperror.Conflict("rbac.role_builtin")
+	}
+
+	total, err := s.rbac.CountUsersWithRole(name)
+	if err != nil {
+		return apperror.Internal("rbac.role_usage_failed").Wrap(err)
+	}
+	if total > 0 {
+		return apperror.Conflict("rbac.role_in_use", total)
+	}
+
+	if err := s.rbac.DeleteRole(name); err != nil {
+		if errors.Is(err, repository.ErrRoleNotFound) {
+			return err
+		}
+		return apperror.Internal("rbac.role_delete_failed").Wrap(err)
+	}
+	middleware.InvalidatePermissionCache()
+	writeAudit(s.audit, c, model.AuditRoleDeleted, name, nil)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "rbac.role_deleted"),
+	})
+}
+
+// UpdateUserRoleService godoc
+// @Summary Ganti role user
+// @Description Mengganti role user. Berlaku langsung di request berikutnya tanpa perlu login ulang. Admin tidak bisa mengganti role dirinya sendiri. Role lama atau baru yang punya permission di luar milik pemanggil butuh permission roles:manage.
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID User"
+// @Param body body model.UpdateUserRoleRequest true "Role baru"
+// @Success 200 {object} map[string]interface{} "Role user berhasil diganti"
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak atau butuh roles:manage"
+// @Failure 404 {object} model.ErrorResponse "User tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/users/{id}/role [put]
+func (s *UserService) UpdateUserRoleService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+	if id == c.Locals("user_id").(int) {
+		return apperror.BadRequest("rbac.cannot_change_own_role")
+	}
+
+	var req model.UpdateUserRoleRequest
+	if err := c.BodyParser(&req); err != nil || req.Role == "" {
+		return apperror.BadRequest("rbac.role_required")
+	}
+	role, err := s.rbac.GetRoleByName(req.Role)
+	if errors.Is(err, repository.ErrRoleNotFound) {
+		return apperror.BadRequest("rbac.role_unknown")
+	} else if err != nil {
+		return apperror.Internal("rbac.role_fetch_failed").Wrap(err)
+	}
+
+	user, err := s.users.GetByID(id)
+	if err != nil {
+		return apperror.NotFound("user.not_found")
+	}
+	if err := s.checkRoleEscalation(c, role.Name, user.Role); err != nil {
+		return err
+	}
+	if err := s.users.UpdateRole(id, req.Role); err != nil {
+		return apperror.Internal("rbac.user_role_failed").Wrap(err)
+	}
+	writeAudit(s.audit, c, model.AuditUserRoleChanged, fmt.Sprintf("user:%d", id), map[string]interface{}{
+		"username": user.Username,
+		"from":     user.Role,
+		"to":       req.Role,
+	})
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "rbac.user_role_changed"),
+		"data":    fiber.Map{"user_id": id, "role": req.Role},
+	})
+}
+
+// checkRoleEscalation users:manage saja tidak cukup untuk memberikan atau mencabut role yang
+// punya permission di luar milik pemanggil (mis. menjadikan user lain admin); itu butuh roles:manage
+func (s *UserService) checkRoleEscalation(c *fiber.Ctx, roles ...string) error {
+	if middleware.HasPermission(c, model.PermRolesManage) {
+		return nil
+	}
+	for _, name := range roles {
+		role, err := s.rbac.GetRoleByName(name)
+		if errors.Is(err, repository.ErrRoleNotFound) {
+			continue
+		} else if err != nil {
+			return apperror.Internal("rbac.role_fetch_failed").Wrap(err)
+		}
+		for _, perm := range role.Permissions {
+			if !middleware.HasPermission(c, perm) {
+				return apperror.Forbidden("rbac.role_escalation", model.PermRolesManage).
+					WithCode(apperror.CodePermissionDenied).
+					WithDetails(fiber.Map{"required_permission": []string{model.PermRolesManage}})
+			}
+		}
+	}
+	return nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
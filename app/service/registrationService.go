@@ -0,0 +1,420 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/config"
+	"backendgo/i18n"
+	"backendgo/mailer"
+	"backendgo/utils"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegistrationService handler pendaftaran mandiri alumni dan roster lulusan
+type RegistrationService struct {
+	registrations repository.RegistrationRepository
+	resets        repository.PasswordResetRepository
+	audit         repository.AuditRepository
+}
+
+func NewRegistrationService(
+	registrations repository.RegistrationRepository,
+	resets repository.PasswordResetRepository,
+	audit repository.AuditRepository,
+) *RegistrationService {
+	return &RegistrationService{registrations: registrations, resets: resets, audit: audit}
+}
+
+// RegisterService godoc
+// @Summary Pendaftaran mandiri alumni
+// @Description Lulusan mendaftar dengan NIM, nama, dan email. NIM dan nama dicocokkan dengan roster lulusan, lalu link verifikasi dikirim ke email. Setelah email diverifikasi, pendaftaran masuk antrian persetujuan admin.
+// @Tags Registration
+// @Accept json
+// @Produce json
+// @Param body body model.RegisterRequest true "Data pendaftaran"
+// @Success 202 {object} map[string]interface{} "Link verifikasi dikirim ke email"
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid / NIM tidak ada di roster lulusan"
+// @Failure 409 {object} model.ErrorResponse "NIM sudah terdaftar atau pendaftaran masih diproses"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/register [post]
+func (s *RegistrationService) RegisterService(c *fiber.Ctx) error {
+	var req model.RegisterRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	req.NIM = strings.TrimSpace(req.NIM)
+	req.Nama = strings.TrimSpace(req.Nama)
+	req.Email = strings.TrimSpace(req.Email)
+	if req.NIM == "" || req.Nama == "" || req.Email == "" {
+		return apperror.BadRequest("alumni.fields_required")
+	}
+	if !strings.Contains(req.Email, "@") {
+		return apperror.BadRequest("common.invalid_email")
+	}
+
+	graduate, err := s.registrations.GetGraduateByNIM(req.NIM)
+	if err != nil && !errors.Is(err, repository.ErrGraduateNotFound) {
+		return apperror.Internal("registration.check_failed").Wrap(err)
+	}
+	if err != nil || utils.NormalizeName(graduate.Nama) != utils.NormalizeName(req.Nama) {
+		// pesan sama untuk NIM tidak ada / nama beda supaya roster tidak bisa ditebak
+		return apperror.BadRequest("registration.roster_mismatch")
+	}
+
+	exists, err := s.registrations.AlumniNIMExists(req.NIM)
+	if err != nil {
+		return apperror.Internal("alumni.check_failed").Wrap(err)
+	}
+	if exists {
+		return apperror.Conflict("registration.nim_registered")
+	}
+	active, err := s.registrations.HasActive(req.NIM)
+	if err != nil {
+		return apperror.Internal("registration.check_failed").Wrap(err)
+	}
+	if active {
+		return apperror.Conflict("registration.pending_exists")
+	}
+
+	token, err := utils.GenerateOpaqueToken(32)
+	if err != nil {
+		return apperror.Internal("registration.token_failed").Wrap(err)
+	}
+	expiresAt := time.Now().Add(config.GetDuration("REGISTRATION_VERIFY_TTL", 48*time.Hour))
+	if _, err := s.registrations.Create(req, utils.HashToken(token), expiresAt); err != nil {
+		return apperror.Internal("registration.save_failed").Wrap(err)
+	}
+
+	link := strings.ReplaceAll(
+		config.GetEnv("REGISTRATION_VERIFY_URL", "http://localhost:3000/verify-registration?token={token}"),
+		"{token}", token,
+	)
+	err = mailer.Default().Send(mailer.Message{
+		To:      req.Email,
+		Subject: "Verifikasi pendaftaran alumni",
+		Body: fmt.Sprintf(
+			"Halo %s,\n\nTerima kasih telah mendaftar sebagai alumni (NIM %s).\n"+
+				"Buka link berikut untuk memverifikasi email Anda (berlaku sampai %s):\n\n%s\n\n"+
+				"Setelah terverifikasi, pendaftaran akan ditinjau oleh admin.\n",
+			req.Nama, req.NIM, expiresAt.Format("02-01-2006 15:04"), link,
+		),
+	})
+	if err != nil {
+		log.Println("Gagal mengirim email verifikasi pendaftaran:", err)
+		return apperror.Internal("registration.email_failed")
+	}
+
+	return c.Status(202).JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "registration.received"),
+	})
+}
+
+// VerifyRegistrationService godoc
+// @Summary Verifikasi email pendaftaran
+// @Description Memverifikasi email pendaftar memakai token dari email. Pendaftaran kemudian menunggu persetujuan admin.
+// @Tags Registration
+// @Accept json
+// @Produce json
+// @Param body body model.VerifyRegistrationRequest true "Token verifikasi"
+// @Success 200 {object} map[string]interface{} "Email terverifikasi"
+// @Failure 400 {object} model.ErrorResponse "Token tidak valid atau kadaluarsa"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/register/verify [post]
+func (s *RegistrationService) VerifyRegistrationService(c *fiber.Ctx) error {
+	var req model.VerifyRegistrationRequest
+	if err := c.BodyParser(&req); err != nil || req.Token == "" {
+		return apperror.BadRequest("registration.token_required")
+	}
+
+	reg, err := s.registrations.VerifyEmail(utils.HashToken(req.Token))
+	if err != nil {
+		if errors.Is(err, repository.ErrRegistrationTokenInvalid) {
+			return err
+		}
+		return apperror.Internal("registration.verify_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "registration.verified"),
+		"data":    fiber.Map{"id": reg.ID, "status": reg.Status},
+	})
+}
+
+// ImportGraduateRosterService godoc
+// @Summary Import roster lulusan
+// @Description Upload CSV roster lulusan (header wajib: nim, nama; opsional: jurusan, angkatan, tahun_lulus). Data dengan NIM yang sudah ada diperbarui.
+// @Tags Registration
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "File CSV roster lulusan"
+// @Success 200 {object} model.RosterImportResult
+// @Failure 400 {object} model.ErrorResponse "File tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/registrations/roster [post]
+func (s *RegistrationService) ImportGraduateRosterService(c *fiber.Ctx) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return apperror.BadRequest("common.file_required")
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return apperror.BadRequest("import.file_unreadable")
+	}
+	defer file.Close()
+
+	list, rowErrors, err := utils.ParseGraduateRosterCSV(file)
+	if err != nil {
+		return apperror.BadRequest(err.Error())
+	}
+
+	imported, err := s.registrations.UpsertGraduateRoster(list)
+	if err != nil {
+		return apperror.Internal("registration.roster_save_failed").Wrap(err)
+	}
+	result := model.RosterImportResult{Imported: imported, Skipped: len(rowErrors), Errors: rowErrors}
+	writeAudit(s.audit, c, model.AuditRosterImported, fileHeader.Filename, map[string]interface{}{
+		"imported": result.Imported,
+		"skipped":  result.Skipped,
+	})
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "registration.roster_imported"),
+		"data":    result,
+	})
+}
+
+// GetRegistrationsService godoc
+// @Summary Ambil antrian pendaftaran
+// @Description Menampilkan pendaftaran alumni. Default hanya yang menunggu persetujuan (pending_approval).
+// @Tags Registration
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "pending_verification / pending_approval / approved / rejected / all"
+// @Param page query int false "Halaman (default 1)"
+// @Param limit query int false "Jumlah per halaman (default 10)"
+// @Success 200 {object} map[string]interface{} "Berhasil mengambil data pendaftaran"
+// @Failure 400 {object} model.ErrorResponse "Status tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/registrations [get]
+func (s *RegistrationService) GetRegistrationsService(c *fiber.Ctx) error {
+	status := c.Query("status", model.RegistrationPendingApproval)
+	switch status {
+	case "all":
+		status = ""
+	case model.RegistrationPendingVerification, model.RegistrationPendingApproval,
+		model.RegistrationApproved, model.RegistrationRejected:
+	default:
+		return apperror.BadRequest("registration.status_invalid")
+	}
+
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	data, err := s.registrations.List(status, limit, (page-1)*limit)
+	if err != nil {
+		return apperror.Internal("registration.fetch_failed").Wrap(err)
+	}
+	total, err := s.registrations.Count(status)
+	if err != nil {
+		return apperror.Internal("registration.count_failed").Wrap(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data": data,
+		"meta": model.MetaInfo{
+			Page:   page,
+			Limit:  limit,
+			Total:  total,
+			Pages:  (total + limit - 1) / limit,
+			SortBy: "created_at",
+			Order:  "asc",
+		},
+	})
+}
+
+// ApproveRegistrationService godoc
+// @Summary Setujui pendaftaran
+// @Description Membuat data alumni + akun user dari pendaftaran yang emailnya sudah terverifikasi, lalu mengirim link untuk membuat password ke email pendaftar.
+// @Tags Registration
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "ID Pendaftaran"
+// @Success 200 {object} map[string]interface{} "Pendaftaran disetujui"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "Pendaftaran tidak ditemukan"
+// @Failure 409 {object} model.ErrorResponse "Pendaftaran tidak menunggu persetujuan / NIM sudah terdaftar"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/registrations/{id}/approve [post]
+func (s *RegistrationService) ApproveRegistrationService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+	adminID := c.Locals("user_id").(int)
+
+	reg, err := s.registrations.GetByID(id)
+	if err != nil {
+		if errors.Is(err, repository.ErrRegistrationNotFound) {
+			return err
+		}
+		return apperror.Internal("registration.fetch_failed").Wrap(err)
+	}
+	if reg.Status != model.RegistrationPendingApproval {
+		return apperror.Conflict("registration.not_pending")
+	}
+	if exists, err := s.registrations.AlumniNIMExists(reg.NIM); err != nil {
+		return apperror.Internal("alumni.check_failed").Wrap(err)
+	} else if exists {
+		return apperror.Conflict("registration.nim_registered")
+	}
+
+	req := model.CreateAlumniRequest{NIM: reg.NIM, Nama: reg.Nama, Email: reg.Email}
+	if graduate, err := s.registrations.GetGraduateByNIM(reg.NIM); err != nil && !errors.Is(err, repository.ErrGraduateNotFound) {
+		return apperror.Internal("registration.check_failed").Wrap(err)
+	} else if err == nil {
+		req.Nama = graduate.Nama
+		req.Jurusan = graduate.Jurusan
+		req.Angkatan = graduate.Angkatan
+		req.TahunLulus = graduate.TahunLulus
+	}
+
+	alumni, err := s.registrations.Approve(id, adminID, req)
+	if err != nil {
+		if errors.Is(err, repository.ErrRegistrationNotPending) || errors.Is(err, repository.ErrRegistrationNotFound) {
+			return err
+		}
+		log.Println("Gagal menyetujui pendaftaran:", err)
+		return apperror.Internal("registration.approve_failed")
+	}
+	writeAudit(s.audit, c, model.AuditRegistrationApproved, fmt.Sprintf("registration:%d", id), map[string]interface{}{
+		"nim":       reg.NIM,
+		"alumni_id": alumni.ID,
+		"user_id":   alumni.UserID,
+	})
+
+	// Akun baru memakai password default; pendaftar diminta membuat password sendiri lewat link
+	if err := s.sendAccountActivationEmail(alumni, adminID); err != nil {
+		log.Println("Gagal mengirim email aktivasi akun:", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "registration.approved"),
+		"data":    alumni,
+	})
+}
+
+func (s *RegistrationService) sendAccountActivationEmail(alumni model.Alumni, adminID int) error {
+	token, err := utils.GenerateOpaqueToken(32)
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(config.GetDuration("PASSWORD_RESET_TTL", 24*time.Hour))
+	if err := s.resets.Create(alumni.UserID, adminID, utils.HashToken(token), expiresAt); err != nil {
+		return err
+	}
+
+	link := strings.ReplaceAll(
+		config.GetEnv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password?token={token}"),
+		"{token}", token,
+	)
+	return mailer.Default().Send(mailer.Message{
+		To:      alumni.Email,
+		Subject: "Pendaftaran alumni disetujui",
+		Body: fmt.Sprintf(
+			"Halo %s,\n\nPendaftaran Anda sebagai alumni telah disetujui.\n"+
+				"Buka link berikut untuk membuat password akun Anda (berlaku sampai %s):\n\n%s\n",
+			alumni.Nama, expiresAt.Format("02-01-2006 15:04"), link,
+		),
+	})
+}
+
+// RejectRegistrationService godoc
+// @Summary Tolak pendaftaran
+// @Description Menolak pendaftaran alumni yang masih diproses. Alasan penolakan dikirim ke email pendaftar.
+// @Tags Registration
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID Pendaftaran"
+// @Param body body model.RejectRegistrationRequest true "Alasan penolakan"
+// @Success 200 {object} map[string]interface{} "Pendaftaran ditolak"
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "Pendaftaran tidak ditemukan"
+// @Failure 409 {object} model.ErrorResponse "Pendaftaran sudah diproses"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/registrations/{id}/reject [post]
+func (s *RegistrationService) RejectRegistrationService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+	adminID := c.Locals("user_id").(int)
+
+	var req model.RejectRegistrationRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Reason) == "" {
+		return apperror.BadRequest("registration.reason_required")
+	}
+
+	reg, err := s.registrations.GetByID(id)
+	if err != nil {
+		if errors.Is(err, repository.ErrRegistrationNotFound) {
+			return err
+		}
+		return apperror.Internal("registration.fetch_failed").Wrap(err)
+	}
+	if err := s.registrations.Reject(id, adminID, strings.TrimSpace(req.Reason)); err != nil {
+		if errors.Is(err, repository.ErrRegistrationNotPending) {
+			return apperror.Conflict("registration.processed")
+		}
+		return apperror.Internal("registration.reject_failed").Wrap(err)
+	}
+	writeAudit(s.audit, c, model.AuditRegistrationRejected, fmt.Sprintf("registration:%d", id), map[string]interface{}{
+		"nim":    reg.NIM,
+		"reason": req.Reason,
+	})
+
+	err = mailer.Default().Send(mailer.Message{
+		To:      reg.Email,
+		Subject: "Pendaftaran alumni ditolak",
+		Body: fmt.Sprintf(
+			"Halo %s,\n\nMohon maaf, pendaftaran alumni Anda (NIM %s) ditolak dengan alasan:\n\n%s\n\n"+
+				"Silakan hubungi admin jika ada pertanyaan.\n",
+			reg.Nama, reg.NIM, strings.TrimSpace(req.Reason),
+		),
+	})
+	if err != nil {
+		log.Println("Gagal mengirim email penolakan pendaftaran:", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "registration.rejected"),
+	})
+}
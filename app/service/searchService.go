@@ -0,0 +1,89 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/middleware"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SearchService handler pencarian gabungan alumni + pekerjaan
+type SearchService struct {
+	search repository.SearchRepository
+}
+
+func NewSearchService(search repository.SearchRepository) *SearchService {
+	return &SearchService{search: search}
+}
+
+// SearchService godoc
+// @Summary Pencarian alumni dan pekerjaan
+// @Description Pencarian full-text + fuzzy (toleran salah ketik) di alumni (nama, NIM, jurusan) dan pekerjaan (perusahaan, posisi, deskripsi). Hasil diurutkan berdasarkan relevansi; potongan teks yang cocok dibungkus <mark>. Tipe yang dicari dibatasi permission alumni:read / pekerjaan:read.
+// @Tags Search
+// @Security BearerAuth
+// @Produce json
+// @Param q query string true "Kata kunci (2 - 100 karakter)"
+// @Param type query string false "alumni / pekerjaan / all (default all)"
+// @Param page query int false "Nomor halaman (default 1)"
+// @Param limit query int false "Jumlah data per halaman (default 10, maksimal 100)"
+// @Success 200 {object} model.SearchResponse
+// @Failure 400 {object} model.ErrorResponse "Parameter tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/search [get]
+func (s *SearchService) SearchService(c *fiber.Ctx) error {
+	term := strings.TrimSpace(c.Query("q"))
+	if n := utf8.RuneCountInString(term); n < 2 || n > 100 {
+		return apperror.BadRequest("search.query_invalid")
+	}
+
+	var types []string
+	switch t := c.Query("type", "all"); t {
+	case "all":
+		types = []string{model.SearchTypeAlumni, model.SearchTypePekerjaan}
+	case model.SearchTypeAlumni, model.SearchTypePekerjaan:
+		types = []string{t}
+	default:
+		return apperror.BadRequest("search.type_invalid")
+	}
+
+	// Hanya cari tipe yang boleh dibaca user ini
+	allowed := types[:0]
+	for _, t := range types {
+		if (t == model.SearchTypeAlumni && middleware.HasPermission(c, model.PermAlumniRead)) ||
+			(t == model.SearchTypePekerjaan && middleware.HasPermission(c, model.PermPekerjaanRead)) {
+			allowed = append(allowed, t)
+		}
+	}
+	if len(allowed) == 0 {
+		return apperror.Forbidden("auth.permission_denied")
+	}
+
+	page, limit, err := parsePage(c)
+	if err != nil {
+		return err
+	}
+
+	data, total, err := s.search.SearchAll(term, allowed, limit, (page-1)*limit)
+	if err != nil {
+		return apperror.Internal("search.failed").Wrap(err)
+	}
+
+	return c.JSON(model.SearchResponse{
+		Data: data,
+		Meta: model.MetaInfo{
+			Page:   page,
+			Limit:  limit,
+			Total:  total,
+			Pages:  (total + limit - 1) / limit,
+			SortBy: "score",
+			Order:  "desc",
+			Search: term,
+		},
+	})
+}
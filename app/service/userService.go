@@ -0,0 +1,331 @@
+package service
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/apperror"
+	"backendgo/i18n"
+	"backendgo/utils"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// UserService handler manajemen user oleh admin (lihat juga role, unlock, dan reset password)
+type UserService struct {
+	users    repository.UserRepository
+	rbac     repository.RBACRepository
+	tokens   repository.RefreshTokenRepository
+	attempts repository.LoginAttemptRepository
+	resets   repository.PasswordResetRepository
+	audit    repository.AuditRepository
+}
+
+func NewUserService(
+	users repository.UserRepository,
+	rbac repository.RBACRepository,
+	tokens repository.RefreshTokenRepository,
+	attempts repository.LoginAttemptRepository,
+	resets repository.PasswordResetRepository,
+	audit repository.AuditRepository,
+) *UserService {
+	return &UserService{users: users, rbac: rbac, tokens: tokens, attempts: attempts, resets: resets, audit: audit}
+}
+
+// GetUsersService godoc
+// @Summary Ambil daftar user
+// @Description Menampilkan daftar user dengan pagination, pencarian username/email, serta filter role dan status aktif.
+// @Tags Users
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Halaman (default 1)"
+// @Param limit query int false "Jumlah per halaman (default 10)"
+// @Param search query string false "Cari username atau email"
+// @Param role query string false "Filter role"
+// @Param is_active query bool false "Filter status aktif"
+// @Success 200 {object} model.UserResponse
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/users [get]
+func (s *UserService) GetUsersService(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	filter := model.UserFilter{
+		Search: c.Query("search", ""),
+		Role:   c.Query("role", ""),
+	}
+	if v := c.Query("is_active"); v != "" {
+		active, err := strconv.ParseBool(v)
+		if err != nil {
+			return apperror.BadRequest("user.is_active_invalid")
+		}
+		filter.IsActive = &active
+	}
+
+	data, err := s.users.List(filter, limit, (page-1)*limit)
+	if err != nil {
+		return apperror.Internal("user.fetch_failed").Wrap(err)
+	}
+	total, err := s.users.Count(filter)
+	if err != nil {
+		return apperror.Internal("user.count_failed").Wrap(err)
+	}
+
+	return c.JSON(model.UserResponse{
+		Data: data,
+		Meta: model.MetaInfo{
+			Page:   page,
+			Limit:  limit,
+			Total:  total,
+			Pages:  (total + limit - 1) / limit,
+			SortBy: "id",
+			Order:  "asc",
+			Search: filter.Search,
+		},
+	})
+}
+
+// GetUserByIDService godoc
+// @Summary Ambil detail user
+// @Description Mengambil detail user beserta ID alumni yang terhubung.
+// @Tags Users
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "ID User"
+// @Success 200 {object} model.UserDetail
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "User tidak ditemukan"
+// @Router /api/users/{id} [get]
+func (s *UserService) GetUserByIDService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+	user, err := s.users.GetDetailByID(id)
+	if err != nil {
+		return apperror.NotFound("user.not_found")
+	}
+	return c.JSON(fiber.Map{"success": true, "data": user})
+}
+
+// CreateUserService godoc
+// @Summary Buat user baru
+// @Description Membuat akun user baru (mis. operator atau admin lain). Password awal wajib diganti saat login pertama. Role default "user".
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param body body model.CreateUserRequest true "Data user"
+// @Success 201 {object} model.UserDetail
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 409 {object} model.ErrorResponse "Username atau email sudah dipakai"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/users [post]
+func (s *UserService) CreateUserService(c *fiber.Ctx) error {
+	var req model.CreateUserRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	req.Username = strings.TrimSpace(req.Username)
+	req.Email = strings.TrimSpace(req.Email)
+	if req.Username == "" || req.Email == "" || req.Password == "" {
+		return apperror.BadRequest("user.fields_required")
+	}
+	if !strings.Contains(req.Email, "@") {
+		return apperror.BadRequest("common.invalid_email")
+	}
+	if len(req.Password) < MinPasswordLength {
+		return apperror.BadRequest("user.password_too_short", MinPasswordLength)
+	}
+	if req.Role == "" {
+		req.Role = model.RoleUser
+	}
+	if _, err := s.rbac.GetRoleByName(req.Role); errors.Is(err, repository.ErrRoleNotFound) {
+		return apperror.BadRequest("rbac.role_unknown")
+	} else if err != nil {
+		return apperror.Internal("rbac.role_fetch_failed").Wrap(err)
+	}
+
+	taken, err := s.users.IsUsernameOrEmailTaken(req.Username, req.Email)
+	if err != nil {
+		return apperror.Internal("user.check_failed").Wrap(err)
+	}
+	if taken {
+		return apperror.Conflict("user.taken")
+	}
+
+	hash, err := utils.HashPassword(req.Password)
+	if err != nil {
+		return apperror.Internal("password.hash_failed").Wrap(err)
+	}
+	id, err := s.users.Create(req.Username, req.Email, hash, req.Role)
+	if err != nil {
+		return apperror.Internal("user.create_failed").Wrap(err)
+	}
+	writeAudit(s.audit, c, model.AuditUserCreated, fmt.Sprintf("user:%d", id), map[string]interface{}{
+		"username": req.Username,
+		"role":     req.Role,
+	})
+
+	user, err := s.users.GetDetailByID(id)
+	if err != nil {
+		return apperror.Internal("user.fetch_failed").Wrap(err)
+	}
+	return c.Status(201).JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "user.created"),
+		"data":    user,
+	})
+}
+
+// UpdateUserStatusService godoc
+// @Summary Aktifkan / nonaktifkan user
+// @Description Menonaktifkan user langsung mencabut semua sesinya dan menolak login berikutnya. Admin tidak bisa menonaktifkan dirinya sendiri.
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID User"
+// @Param body body model.UpdateUserStatusRequest true "Status aktif"
+// @Success 200 {object} map[string]interface{} "Status user berhasil diubah"
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "User tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/users/{id}/status [put]
+func (s *UserService) UpdateUserStatusService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+
+	var req model.UpdateUserStatusRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if !req.IsActive && id == c.Locals("user_id").(int) {
+		return apperror.BadRequest("user.cannot_disable_self")
+	}
+
+	if err := s.users.SetActive(id, req.IsActive); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return err
+		}
+		return apperror.Internal("user.status_failed").Wrap(err)
+	}
+
+	action := model.AuditUserEnabled
+	message := i18n.T(c, "user.enabled")
+	if !req.IsActive {
+		action = model.AuditUserDisabled
+		message = i18n.T(c, "user.disabled")
+		if err := s.tokens.RevokeAllForUser(id); err != nil {
+			log.Println("Gagal mencabut sesi user yang dinonaktifkan:", err)
+		}
+	}
+	writeAudit(s.audit, c, action, fmt.Sprintf("user:%d", id), nil)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": message,
+		"data":    fiber.Map{"user_id": id, "is_active": req.IsActive},
+	})
+}
+
+// LinkUserAlumniService godoc
+// @Summary Hubungkan user dengan alumni
+// @Description Menghubungkan akun user dengan data alumni. Satu user hanya boleh terhubung ke satu alumni dan sebaliknya.
+// @Tags Users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "ID User"
+// @Param body body model.LinkAlumniRequest true "ID alumni"
+// @Success 200 {object} map[string]interface{} "User berhasil dihubungkan"
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "User atau alumni tidak ditemukan"
+// @Failure 409 {object} model.ErrorResponse "Alumni atau user sudah terhubung"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/users/{id}/alumni [put]
+func (s *UserService) LinkUserAlumniService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+
+	var req model.LinkAlumniRequest
+	if err := c.BodyParser(&req); err != nil || req.AlumniID <= 0 {
+		return apperror.BadRequest("user.alumni_id_required")
+	}
+	if _, err := s.users.GetByID(id); err != nil {
+		return apperror.NotFound("user.not_found")
+	}
+
+	if err := s.users.LinkAlumni(id, req.AlumniID); err != nil {
+		switch err {
+		case repository.ErrAlumniNotFound, repository.ErrAlumniAlreadyLinked, repository.ErrUserAlreadyLinked:
+			return err
+		}
+		return apperror.Internal("user.link_failed").Wrap(err)
+	}
+	writeAudit(s.audit, c, model.AuditUserAlumniLinked, fmt.Sprintf("user:%d", id), map[string]interface{}{"alumni_id": req.AlumniID})
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "user.linked"),
+		"data":    fiber.Map{"user_id": id, "alumni_id": req.AlumniID},
+	})
+}
+
+// UnlinkUserAlumniService godoc
+// @Summary Lepas hubungan user dengan alumni
+// @Description Melepas hubungan akun user dengan data alumni. Data alumni tetap ada.
+// @Tags Users
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "ID User"
+// @Success 200 {object} map[string]interface{} "Hubungan berhasil dilepas"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "User tidak terhubung dengan alumni"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/users/{id}/alumni [delete]
+func (s *UserService) UnlinkUserAlumniService(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return apperror.BadRequest("common.invalid_id")
+	}
+
+	if err := s.users.UnlinkAlumni(id); err != nil {
+		if errors.Is(err, repository.ErrAlumniNotLinked) {
+			return err
+		}
+		return apperror.Internal("user.unlink_failed").Wrap(err)
+	}
+	writeAudit(s.audit, c, model.AuditUserAlumniUnlinked, fmt.Sprintf("user:%d", id), nil)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "user.unlinked"),
+	})
+}
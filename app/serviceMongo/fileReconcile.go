@@ -0,0 +1,101 @@
+package serviceMongo
+
+import (
+	"backendgo/app/modelmongo"
+	"backendgo/app/repositoryMongo"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileReconcileReport selisih antara koleksi `files` dan isi folder upload
+type FileReconcileReport struct {
+	Checked       int               `json:"checked"`
+	MissingOnDisk []modelmongo.File `json:"missing_on_disk"` // record ada, file fisik hilang
+	OrphanOwner   []modelmongo.File `json:"orphan_owner"`    // user pemilik sudah tidak ada
+	OrphanOnDisk  []string          `json:"orphan_on_disk"`  // file fisik tanpa record
+	Removed       int               `json:"removed"`         // jumlah record + file yang dihapus (mode fix)
+}
+
+// ReconcileFiles bandingkan record file di MongoDB dengan file di baseDir.
+// userExists dipakai untuk mengecek pemilik file masih terdaftar.
+// Jika fix = true: record tanpa file fisik dihapus, file milik user yang sudah dihapus
+// ikut dihapus (record + fisik), dan file fisik tanpa record dihapus.
+func ReconcileFiles(repo repositoryMongo.FileRepository, baseDir string, userExists func(ids []int) (map[int]bool, error), fix bool) (FileReconcileReport, error) {
+	var report FileReconcileReport
+
+	files, err := repo.FindAll()
+	if err != nil {
+		return report, err
+	}
+	report.Checked = len(files)
+
+	ownerIDs := []int{}
+	seenOwner := map[int]bool{}
+	for _, f := range files {
+		if !seenOwner[f.UserID] {
+			seenOwner[f.UserID] = true
+			ownerIDs = append(ownerIDs, f.UserID)
+		}
+	}
+	owners, err := userExists(ownerIDs)
+	if err != nil {
+		return report, err
+	}
+
+	referenced := map[string]bool{}
+	for _, f := range files {
+		referenced[filepath.Clean(f.FilePath)] = true
+
+		_, statErr := os.Stat(f.FilePath)
+		missing := os.IsNotExist(statErr)
+		switch {
+		case !owners[f.UserID]:
+			report.OrphanOwner = append(report.OrphanOwner, f)
+		case missing:
+			report.MissingOnDisk = append(report.MissingOnDisk, f)
+		default:
+			continue
+		}
+
+		if fix {
+			if err := repo.Delete(f.ID.Hex()); err != nil {
+				return report, err
+			}
+			if !missing {
+				os.Remove(f.FilePath)
+			}
+			report.Removed++
+		}
+	}
+
+	err = filepath.WalkDir(baseDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == baseDir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		// file tersembunyi (.gitkeep dll) bukan hasil upload
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") || referenced[filepath.Clean(path)] {
+			return nil
+		}
+		report.OrphanOnDisk = append(report.OrphanOnDisk, path)
+		if fix {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			report.Removed++
+		}
+		return nil
+	})
+	sort.Strings(report.OrphanOnDisk)
+	return report, err
+}
+
+// UploadBasePath folder penyimpanan file upload
+func UploadBasePath() string {
+	return uploadBasePath
+}
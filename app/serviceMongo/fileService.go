@@ -1,9 +1,12 @@
 package serviceMongo
 
 import (
+	"backendgo/app/model"
 	"backendgo/app/modelmongo"
 	"backendgo/app/repositoryMongo"
-	"backendgo/database"
+	"backendgo/apperror"
+	"backendgo/i18n"
+	"backendgo/middleware"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -16,31 +19,49 @@ import (
 
 const uploadBasePath = "uploads"
 
+// FileService handler upload / daftar / hapus file. files nil kalau MongoDB tidak terhubung.
+type FileService struct {
+	files repositoryMongo.FileRepository
+}
+
+func NewFileService(files repositoryMongo.FileRepository) *FileService {
+	return &FileService{files: files}
+}
+
+// visibleFiles buang file yang ikut di-trash bersama alumni pemiliknya
+func visibleFiles(files []modelmongo.File) []modelmongo.File {
+	visible := files[:0]
+	for _, f := range files {
+		if !f.IsDeleted {
+			visible = append(visible, f)
+		}
+	}
+	return visible
+}
+
 // UploadFile godoc
 // @Summary Upload file (foto atau sertifikat)
-// @Description Mengunggah file (foto atau sertifikat) ke server dan menyimpannya ke MongoDB. Hanya bisa diakses user yang login. Admin dapat mengupload file untuk user lain dengan menambahkan form field `user_id`.
+// @Description Mengunggah file (foto atau sertifikat) ke server dan menyimpannya ke MongoDB. Hanya bisa diakses user yang login. User dengan permission `files:manage_all` dapat mengupload file untuk user lain dengan menambahkan form field `user_id`.
 // @Tags File
 // @Security BearerAuth
 // @Accept multipart/form-data
 // @Produce json
 // @Param file formData file true "File yang akan diupload (foto atau sertifikat)"
 // @Param category formData string false "Kategori file (foto / sertifikat)"
-// @Param user_id formData int false "Hanya admin: ID user lain yang ingin diuploadkan file"
+// @Param user_id formData int false "Hanya dengan permission files:manage_all: ID user lain yang ingin diuploadkan file"
 // @Success 200 {object} map[string]interface{} "File berhasil diupload"
-// @Failure 400 {object} map[string]string "Request tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Kesalahan server"
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
 // @Router /api/files/upload [post]
-func UploadFile(c *fiber.Ctx) error {
-	if database.MongoDB == nil {
-		return c.Status(500).JSON(fiber.Map{"error": "MongoDB belum terhubung"})
+func (s *FileService) UploadFile(c *fiber.Ctx) error {
+	if s.files == nil {
+		return apperror.Internal("common.mongo_unavailable")
 	}
-	fileRepo := repositoryMongo.NewFileRepository(database.MongoDB)
 
-	role := c.Locals("role").(string)
 	userID := c.Locals("user_id").(int)
 
-	if role == "admin" {
+	if middleware.HasPermission(c, model.PermFilesManageAll) {
 		if u := c.FormValue("user_id"); u != "" {
 			if parsed, err := strconv.Atoi(u); err == nil {
 				userID = parsed
@@ -50,7 +71,7 @@ func UploadFile(c *fiber.Ctx) error {
 
 	fileHeader, err := c.FormFile("file")
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "file wajib diupload"})
+		return apperror.BadRequest("common.file_required")
 	}
 
 	category := c.FormValue("category")
@@ -62,18 +83,18 @@ func UploadFile(c *fiber.Ctx) error {
 	switch category {
 	case "foto":
 		if fileHeader.Size > 1*1024*1024 {
-			return c.Status(400).JSON(fiber.Map{"error": "ukuran foto maksimal 1MB"})
+			return apperror.BadRequest("file.photo_too_large")
 		}
 		allowed := map[string]bool{"image/jpeg": true, "image/png": true, "image/jpg": true}
 		if !allowed[contentType] {
-			return c.Status(400).JSON(fiber.Map{"error": "format foto hanya jpeg/png/jpg"})
+			return apperror.BadRequest("file.photo_format")
 		}
 	case "sertifikat":
 		if fileHeader.Size > 2*1024*1024 {
-			return c.Status(400).JSON(fiber.Map{"error": "ukuran sertifikat maksimal 2MB"})
+			return apperror.BadRequest("file.certificate_too_large")
 		}
 		if contentType != "application/pdf" {
-			return c.Status(400).JSON(fiber.Map{"error": "format sertifikat hanya PDF"})
+			return apperror.BadRequest("file.certificate_format")
 		}
 	}
 
@@ -93,81 +114,76 @@ func UploadFile(c *fiber.Ctx) error {
 		UploadedAt:   time.Now(),
 	}
 
-	fileRepo.Create(&data)
+	s.files.Create(&data)
 	return c.JSON(fiber.Map{"success": true, "data": data})
 }
 
 // GetAllFiles godoc
 // @Summary Ambil semua file
-// @Description Menampilkan semua file milik user login. Jika user adalah admin, maka akan menampilkan semua file dari seluruh user.
+// @Description Menampilkan semua file milik user login. User dengan permission `files:read_all` melihat semua file dari seluruh user.
 // @Tags File
 // @Security BearerAuth
 // @Produce json
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil daftar file"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Kesalahan server"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
 // @Router /api/files [get]
-func GetAllFiles(c *fiber.Ctx) error {
-	if database.MongoDB == nil {
-		return c.Status(500).JSON(fiber.Map{"error": "MongoDB belum terhubung"})
+func (s *FileService) GetAllFiles(c *fiber.Ctx) error {
+	if s.files == nil {
+		return apperror.Internal("common.mongo_unavailable")
 	}
-	fileRepo := repositoryMongo.NewFileRepository(database.MongoDB)
 
-	role := c.Locals("role").(string)
 	userID := c.Locals("user_id").(int)
 
 	var files []modelmongo.File
 	var err error
 
-	if role == "admin" {
-		files, err = fileRepo.FindAll()
+	if middleware.HasPermission(c, model.PermFilesReadAll) {
+		files, err = s.files.FindAll()
 	} else {
-		files, err = fileRepo.FindByUser(userID)
+		files, err = s.files.FindByUser(userID)
 	}
 
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"data":    files,
+		"data":    visibleFiles(files),
 	})
 }
 
 // GetFileByID godoc
 // @Summary Ambil file berdasarkan ID
-// @Description Mengambil file berdasarkan ID. User hanya bisa mengakses file miliknya sendiri, kecuali admin yang bisa mengakses semua file.
+// @Description Mengambil file berdasarkan ID. User hanya bisa mengakses file miliknya sendiri, kecuali user dengan permission `files:read_all`.
 // @Tags File
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "ID File (ObjectID MongoDB)"
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data file"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 403 {object} map[string]string "Akses ditolak"
-// @Failure 404 {object} map[string]string "File tidak ditemukan"
-// @Failure 500 {object} map[string]string "Kesalahan server"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akses ditolak"
+// @Failure 404 {object} model.ErrorResponse "File tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
 // @Router /api/files/{id} [get]
-func GetFileByID(c *fiber.Ctx) error {
-	if database.MongoDB == nil {
-		return c.Status(500).JSON(fiber.Map{"error": "MongoDB belum terhubung"})
+func (s *FileService) GetFileByID(c *fiber.Ctx) error {
+	if s.files == nil {
+		return apperror.Internal("common.mongo_unavailable")
 	}
-	fileRepo := repositoryMongo.NewFileRepository(database.MongoDB)
 
 	id := c.Params("id")
-	role := c.Locals("role").(string)
-	userID := c.Locals("user_id").(int)
 
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
-	allFiles, _ := fileRepo.FindAll()
+	allFiles, _ := s.files.FindAll()
 	var found *modelmongo.File
 
-	for _, f := range allFiles {
+	for _, f := range visibleFiles(allFiles) {
 		if f.ID == objID {
 			found = &f
 			break
@@ -175,11 +191,11 @@ func GetFileByID(c *fiber.Ctx) error {
 	}
 
 	if found == nil {
-		return c.Status(404).JSON(fiber.Map{"error": "file tidak ditemukan"})
+		return apperror.NotFound("file.not_found")
 	}
 
-	if role != "admin" && found.UserID != userID {
-		return c.Status(403).JSON(fiber.Map{"error": "akses ditolak"})
+	if !middleware.CanAccessUser(c, found.UserID, model.PermFilesReadAll) {
+		return apperror.Forbidden("auth.access_denied")
 	}
 
 	return c.JSON(fiber.Map{"success": true, "data": found})
@@ -187,37 +203,34 @@ func GetFileByID(c *fiber.Ctx) error {
 
 // DeleteFile godoc
 // @Summary Hapus file
-// @Description Menghapus file berdasarkan ID. User hanya bisa menghapus file miliknya sendiri, admin dapat menghapus semua file.
+// @Description Menghapus file berdasarkan ID. User hanya bisa menghapus file miliknya sendiri, user dengan permission `files:manage_all` dapat menghapus semua file.
 // @Tags File
 // @Security BearerAuth
 // @Produce json
 // @Param id path string true "ID File (ObjectID MongoDB)"
 // @Success 200 {object} map[string]string "File berhasil dihapus"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 403 {object} map[string]string "Tidak boleh hapus file milik user lain"
-// @Failure 404 {object} map[string]string "File tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal menghapus file"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Tidak boleh hapus file milik user lain"
+// @Failure 404 {object} model.ErrorResponse "File tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal menghapus file"
 // @Router /api/files/{id} [delete]
-func DeleteFile(c *fiber.Ctx) error {
-	if database.MongoDB == nil {
-		return c.Status(500).JSON(fiber.Map{"error": "MongoDB belum terhubung"})
+func (s *FileService) DeleteFile(c *fiber.Ctx) error {
+	if s.files == nil {
+		return apperror.Internal("common.mongo_unavailable")
 	}
-	fileRepo := repositoryMongo.NewFileRepository(database.MongoDB)
 
 	id := c.Params("id")
-	role := c.Locals("role").(string)
-	userID := c.Locals("user_id").(int)
 
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return c.Status(400).JSON(fiber.Map{"error": "ID tidak valid"})
+		return apperror.BadRequest("common.invalid_id")
 	}
 
-	allFiles, _ := fileRepo.FindAll()
+	allFiles, _ := s.files.FindAll()
 	var target *modelmongo.File
 
-	for _, f := range allFiles {
+	for _, f := range visibleFiles(allFiles) {
 		if f.ID == objID {
 			target = &f
 			break
@@ -225,18 +238,18 @@ func DeleteFile(c *fiber.Ctx) error {
 	}
 
 	if target == nil {
-		return c.Status(404).JSON(fiber.Map{"error": "file tidak ditemukan"})
+		return apperror.NotFound("file.not_found")
 	}
 
-	if role != "admin" && target.UserID != userID {
-		return c.Status(403).JSON(fiber.Map{"error": "tidak boleh hapus file milik user lain"})
+	if !middleware.CanAccessUser(c, target.UserID, model.PermFilesManageAll) {
+		return apperror.Forbidden("file.delete_forbidden")
 	}
 
-	err = fileRepo.Delete(id)
+	err = s.files.Delete(id)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
 	os.Remove(target.FilePath)
-	return c.JSON(fiber.Map{"success": true, "message": "file berhasil dihapus"})
+	return c.JSON(fiber.Map{"success": true, "message": i18n.T(c, "file.deleted")})
 }
@@ -1,30 +1,56 @@
 package serviceMongo
 
 import (
+	"backendgo/app/model"
 	"backendgo/app/modelmongo"
 	"backendgo/app/repositoryMongo"
+	"backendgo/apperror"
+	"backendgo/config"
+	"backendgo/i18n"
+	"backendgo/middleware"
+	"backendgo/utils"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// PekerjaanMongoService handler pekerjaan di MongoDB. pekerjaan nil kalau MongoDB tidak terhubung.
+type PekerjaanMongoService struct {
+	pekerjaan repositoryMongo.PekerjaanMongoRepository
+}
+
+func NewPekerjaanMongoService(pekerjaan repositoryMongo.PekerjaanMongoRepository) *PekerjaanMongoService {
+	return &PekerjaanMongoService{pekerjaan: pekerjaan}
+}
+
 // GetAllPekerjaanMongoService godoc
 // @Summary Ambil semua data pekerjaan (MongoDB)
-// @Description Mengambil semua data pekerjaan dari MongoDB. Hanya bisa diakses oleh user yang login.
+// @Description Mengambil data pekerjaan dari MongoDB. Tanpa parameter, semua data dikembalikan; dengan limit / cursor / pagination=cursor, data dikembalikan per halaman (cursor pagination, urut created_at). Hanya bisa diakses oleh user yang login.
 // @Tags Pekerjaan Mongo
 // @Security BearerAuth
 // @Produce json
+// @Param pagination query string false "cursor = pakai cursor pagination"
+// @Param limit query int false "Jumlah data per halaman (default 10, maksimal 100)"
+// @Param cursor query string false "next_cursor / prev_cursor dari respons sebelumnya"
+// @Param order query string false "Urutan created_at (asc/desc, default desc)"
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil semua data pekerjaan"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal mengambil data"
+// @Failure 400 {object} model.ErrorResponse "Parameter pagination tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal mengambil data"
 // @Router /api/pekerjaan-mongo [get]
-func GetAllPekerjaanMongoService(c *fiber.Ctx) error {
-	data, err := repositoryMongo.GetAllPekerjaanMongo()
+func (s *PekerjaanMongoService) GetAllPekerjaanMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
+	if c.Query("pagination") == "cursor" || c.Query("cursor") != "" || c.Query("limit") != "" {
+		return s.getPekerjaanMongoPage(c)
+	}
+
+	data, err := s.pekerjaan.GetAll()
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{
-			"success": false,
-			"error":   err.Error(),
-		})
+		return err
 	}
 	return c.JSON(fiber.Map{
 		"success": true,
@@ -32,6 +58,44 @@ func GetAllPekerjaanMongoService(c *fiber.Ctx) error {
 	})
 }
 
+func (s *PekerjaanMongoService) getPekerjaanMongoPage(c *fiber.Ctx) error {
+	maxLimit := config.GetInt("LIST_MAX_LIMIT", 100)
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil || limit < 1 || limit > maxLimit {
+		return apperror.BadRequest("list.invalid_limit", maxLimit)
+	}
+	order := strings.ToLower(c.Query("order", "desc"))
+	if order != "asc" && order != "desc" {
+		return apperror.BadRequest("list.invalid_order")
+	}
+
+	var cur *utils.Cursor
+	if raw := c.Query("cursor"); raw != "" {
+		cur, err = utils.DecodeCursor(raw, repositoryMongo.PekerjaanMongoSortKey(order), repositoryMongo.PekerjaanMongoCursorKinds)
+		if err != nil {
+			return err
+		}
+	}
+
+	data, page, err := s.pekerjaan.Page(cur, order, limit)
+	if err != nil {
+		return err
+	}
+
+	meta := model.CursorMeta{Limit: limit, SortBy: "created_at", Order: order}
+	if page.Next != "" {
+		meta.NextCursor = &page.Next
+	}
+	if page.Prev != "" {
+		meta.PrevCursor = &page.Prev
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    data,
+		"meta":    meta,
+	})
+}
+
 // GetPekerjaanByIDMongoService godoc
 // @Summary Ambil pekerjaan berdasarkan ID (MongoDB)
 // @Description Mengambil satu data pekerjaan berdasarkan ID di MongoDB. Hanya bisa diakses user login.
@@ -40,20 +104,20 @@ func GetAllPekerjaanMongoService(c *fiber.Ctx) error {
 // @Produce json
 // @Param id path string true "ID Pekerjaan (ObjectID MongoDB)"
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data pekerjaan"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 404 {object} map[string]string "Data tidak ditemukan"
-// @Failure 500 {object} map[string]string "Kesalahan server"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 404 {object} model.ErrorResponse "Data tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
 // @Router /api/pekerjaan-mongo/{id} [get]
-func GetPekerjaanByIDMongoService(c *fiber.Ctx) error {
+func (s *PekerjaanMongoService) GetPekerjaanByIDMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
 	id := c.Params("id")
 
-	data, err := repositoryMongo.GetPekerjaanByIDMongo(id)
+	data, err := s.pekerjaan.GetByID(id)
 	if err != nil {
-		return c.Status(404).JSON(fiber.Map{
-			"success": false,
-			"error":   "Data tidak ditemukan",
-		})
+		return apperror.NotFound("common.not_found")
 	}
 	return c.JSON(fiber.Map{
 		"success": true,
@@ -69,18 +133,18 @@ func GetPekerjaanByIDMongoService(c *fiber.Ctx) error {
 // @Produce json
 // @Param alumni_id path string true "ID Alumni"
 // @Success 200 {object} map[string]interface{} "Berhasil mengambil data pekerjaan"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Kesalahan server"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
 // @Router /api/pekerjaan-mongo/alumni/{alumni_id} [get]
-func GetPekerjaanByAlumniMongoService(c *fiber.Ctx) error {
+func (s *PekerjaanMongoService) GetPekerjaanByAlumniMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
 	alumniID := c.Params("alumni_id")
 
-	data, err := repositoryMongo.GetPekerjaanByAlumniMongo(alumniID)
+	data, err := s.pekerjaan.GetByAlumni(alumniID)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{
-			"success": false,
-			"error":   err.Error(),
-		})
+		return err
 	}
 	return c.JSON(fiber.Map{
 		"success": true,
@@ -88,6 +152,24 @@ func GetPekerjaanByAlumniMongoService(c *fiber.Ctx) error {
 	})
 }
 
+// parseTanggal tanggal YYYY-MM-DD dari request (sudah divalidasi); kosong = nil
+func parseTanggal(value string) *time.Time {
+	t, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// formatTanggal kebalikan parseTanggal untuk tanggal yang tersimpan; nil tetap nil
+func formatTanggal(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	value := t.Format("2006-01-02")
+	return &value
+}
+
 // CreatePekerjaanMongoService godoc
 // @Summary Tambah data pekerjaan (MongoDB)
 // @Description Menambahkan data pekerjaan baru ke MongoDB. Hanya bisa diakses user yang login.
@@ -97,18 +179,22 @@ func GetPekerjaanByAlumniMongoService(c *fiber.Ctx) error {
 // @Produce json
 // @Param body body modelmongo.CreatePekerjaanRequest true "Data pekerjaan baru"
 // @Success 201 {object} map[string]interface{} "Data pekerjaan berhasil ditambahkan"
-// @Failure 400 {object} map[string]string "Body request tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal menyimpan data"
+// @Failure 400 {object} model.ErrorResponse "Body request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Gagal menyimpan data"
 // @Router /api/pekerjaan-mongo [post]
-func CreatePekerjaanMongoService(c *fiber.Ctx) error {
+func (s *PekerjaanMongoService) CreatePekerjaanMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
 	var req modelmongo.CreatePekerjaanRequest
 
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(fiber.Map{
-			"success": false,
-			"error":   "Request body tidak valid",
-		})
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if err := middleware.Validate(&req); err != nil {
+		return err
 	}
 
 	newData := modelmongo.PekerjaanAlumni{
@@ -124,15 +210,17 @@ func CreatePekerjaanMongoService(c *fiber.Ctx) error {
 		UpdatedAt:          time.Now(),
 		IsDeleted:          false,
 	}
+	newData.TanggalMulaiKerja = parseTanggal(req.TanggalMulaiKerja)
+	newData.TanggalSelesaiKerja = parseTanggal(req.TanggalSelesaiKerja)
 
-	data, err := repositoryMongo.CreatePekerjaanMongo(newData)
+	data, err := s.pekerjaan.Create(newData)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
 	return c.Status(201).JSON(fiber.Map{
 		"success": true,
-		"message": "Data pekerjaan berhasil ditambahkan",
+		"message": i18n.T(c, "pekerjaan.created"),
 		"data":    data,
 	})
 }
@@ -147,32 +235,51 @@ func CreatePekerjaanMongoService(c *fiber.Ctx) error {
 // @Param id path string true "ID Pekerjaan (ObjectID MongoDB)"
 // @Param body body modelmongo.UpdatePekerjaanRequest true "Data pekerjaan yang akan diperbarui"
 // @Success 200 {object} map[string]interface{} "Data pekerjaan berhasil diupdate"
-// @Failure 400 {object} map[string]string "Request tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal memperbarui data"
+// @Failure 400 {object} model.ErrorResponse "Request tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 422 {object} model.ErrorResponse "Data tidak valid"
+// @Failure 500 {object} model.ErrorResponse "Gagal memperbarui data"
 // @Router /api/pekerjaan-mongo/{id} [put]
-func UpdatePekerjaanMongoService(c *fiber.Ctx) error {
+func (s *PekerjaanMongoService) UpdatePekerjaanMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
 	id := c.Params("id")
 	var req modelmongo.UpdatePekerjaanRequest
 
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(fiber.Map{
-			"success": false,
-			"error":   "Request body tidak valid",
-		})
+		return apperror.BadRequest("common.invalid_body")
+	}
+	if err := middleware.Validate(&req); err != nil {
+		return err
+	}
+
+	// gtefield hanya membandingkan field di body yang sama; kalau hanya salah satu
+	// tanggal dikirim, pasangannya diambil dari data yang tersimpan
+	if (req.TanggalMulaiKerja == nil) != (req.TanggalSelesaiKerja == nil) {
+		current, err := s.pekerjaan.GetByID(id)
+		if err != nil {
+			return apperror.NotFound("common.not_found")
+		}
+		check := req
+		if check.TanggalMulaiKerja == nil {
+			check.TanggalMulaiKerja = formatTanggal(current.TanggalMulaiKerja)
+		} else {
+			check.TanggalSelesaiKerja = formatTanggal(current.TanggalSelesaiKerja)
+		}
+		if err := middleware.Validate(&check); err != nil {
+			return err
+		}
 	}
 
-	err := repositoryMongo.UpdatePekerjaanMongo(id, req)
+	err := s.pekerjaan.Update(id, req)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{
-			"success": false,
-			"error":   err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"message": "Data pekerjaan berhasil diupdate",
+		"message": i18n.T(c, "pekerjaan.updated"),
 	})
 }
 
@@ -184,23 +291,172 @@ func UpdatePekerjaanMongoService(c *fiber.Ctx) error {
 // @Produce json
 // @Param id path string true "ID Pekerjaan (ObjectID MongoDB)"
 // @Success 200 {object} map[string]string "Data pekerjaan berhasil dihapus"
-// @Failure 400 {object} map[string]string "ID tidak valid"
-// @Failure 401 {object} map[string]string "Token tidak valid atau tidak ditemukan"
-// @Failure 500 {object} map[string]string "Gagal menghapus data"
+// @Failure 400 {object} model.ErrorResponse "ID tidak valid"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Gagal menghapus data"
 // @Router /api/pekerjaan-mongo/{id} [delete]
-func DeletePekerjaanMongoService(c *fiber.Ctx) error {
+func (s *PekerjaanMongoService) DeletePekerjaanMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
 	id := c.Params("id")
 
-	err := repositoryMongo.HardDeletePekerjaanMongo(id)
+	err := s.pekerjaan.HardDelete(id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "pekerjaan.deleted"),
+	})
+}
+
+// findOwnedPekerjaanMongo ambil pekerjaan dan pastikan milik alumni yang login
+// atau user punya permission perm. Mengembalikan error domain kalau ditolak.
+func (s *PekerjaanMongoService) findOwnedPekerjaanMongo(c *fiber.Ctx, perm string) (*modelmongo.PekerjaanAlumni, error) {
+	data, err := s.pekerjaan.GetByID(c.Params("id"))
+	if err != nil {
+		return nil, apperror.NotFound("common.not_found")
+	}
+	if !middleware.CanAccessAlumni(c, data.AlumniID, perm) {
+		if _, ok := middleware.AlumniID(c); !ok {
+			return nil, middleware.ErrAlumniNotLinked
+		}
+		// sengaja 404 supaya keberadaan data milik alumni lain tidak bocor
+		return nil, apperror.NotFound("common.not_found")
+	}
+	return data, nil
+}
+
+// SoftDeletePekerjaanMongoService godoc
+// @Summary Soft delete pekerjaan (MongoDB)
+// @Description Menandai pekerjaan sebagai terhapus. Alumni hanya bisa menghapus pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:manage_all.
+// @Tags Pekerjaan Mongo
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "ID Pekerjaan (ObjectID MongoDB)"
+// @Success 200 {object} map[string]string "Soft delete pekerjaan berhasil"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 404 {object} model.ErrorResponse "Data tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/pekerjaan-mongo/{id}/soft-delete [put]
+func (s *PekerjaanMongoService) SoftDeletePekerjaanMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
+	if _, err := s.findOwnedPekerjaanMongo(c, model.PermPekerjaanManageAll); err != nil {
+		return err
+	}
+
+	if err := s.pekerjaan.SoftDelete(c.Params("id")); err != nil {
+		return err
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "pekerjaan.soft_deleted"),
+	})
+}
+
+// RestorePekerjaanMongoService godoc
+// @Summary Restore pekerjaan (MongoDB)
+// @Description Mengembalikan pekerjaan yang sudah di-soft delete. Alumni hanya bisa me-restore pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:manage_all.
+// @Tags Pekerjaan Mongo
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "ID Pekerjaan (ObjectID MongoDB)"
+// @Success 200 {object} map[string]string "Restore pekerjaan berhasil"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 404 {object} model.ErrorResponse "Data tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/pekerjaan-mongo/{id}/restore [put]
+func (s *PekerjaanMongoService) RestorePekerjaanMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
+	if _, err := s.findOwnedPekerjaanMongo(c, model.PermPekerjaanManageAll); err != nil {
+		return err
+	}
+
+	if err := s.pekerjaan.Restore(c.Params("id")); err != nil {
+		return err
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": i18n.T(c, "pekerjaan.restored"),
+	})
+}
+
+// HardDeleteTrashedPekerjaanMongoService godoc
+// @Summary Hard delete pekerjaan dari trash (MongoDB)
+// @Description Menghapus permanen pekerjaan yang sudah di-soft delete. Alumni hanya bisa menghapus pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:hard_delete.
+// @Tags Pekerjaan Mongo
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "ID Pekerjaan (ObjectID MongoDB)"
+// @Success 200 {object} map[string]string "Hard delete pekerjaan berhasil"
+// @Failure 400 {object} model.ErrorResponse "Pekerjaan belum di-soft delete"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 404 {object} model.ErrorResponse "Data tidak ditemukan"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/pekerjaan-mongo/{id}/hard-delete [delete]
+func (s *PekerjaanMongoService) HardDeleteTrashedPekerjaanMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
+	data, err := s.findOwnedPekerjaanMongo(c, model.PermPekerjaanHardDelete)
 	if err != nil {
-		return c.Status(500).JSON(fiber.Map{
-			"success": false,
-			"error":   err.Error(),
-		})
+		return err
+	}
+	if !data.IsDeleted {
+		return apperror.BadRequest("pekerjaan.not_soft_deleted")
 	}
 
+	if err := s.pekerjaan.HardDelete(c.Params("id")); err != nil {
+		return err
+	}
 	return c.JSON(fiber.Map{
 		"success": true,
-		"message": "Data pekerjaan berhasil dihapus",
+		"message": i18n.T(c, "pekerjaan.hard_deleted"),
+	})
+}
+
+// GetTrashedPekerjaanMongoService godoc
+// @Summary Ambil pekerjaan yang dihapus (MongoDB)
+// @Description Menampilkan pekerjaan yang sudah di-soft delete milik alumni yang login, atau milik semua alumni untuk permission pekerjaan:manage_all.
+// @Tags Pekerjaan Mongo
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Berhasil mengambil data pekerjaan terhapus"
+// @Failure 401 {object} model.ErrorResponse "Token tidak valid atau tidak ditemukan"
+// @Failure 403 {object} model.ErrorResponse "Akun belum terhubung dengan data alumni"
+// @Failure 500 {object} model.ErrorResponse "Kesalahan server"
+// @Router /api/pekerjaan-mongo/trashed [get]
+func (s *PekerjaanMongoService) GetTrashedPekerjaanMongoService(c *fiber.Ctx) error {
+	if s.pekerjaan == nil {
+		return apperror.Internal("common.mongo_unavailable")
+	}
+	var data []modelmongo.PekerjaanAlumni
+	var err error
+
+	if middleware.HasPermission(c, model.PermPekerjaanManageAll) {
+		data, err = s.pekerjaan.GetTrashed()
+	} else {
+		alumniID, ok := middleware.AlumniID(c)
+		if !ok {
+			return middleware.ErrAlumniNotLinked
+		}
+		data, err = s.pekerjaan.GetTrashedByAlumni(alumniID)
+	}
+
+	if err != nil {
+		return err
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    data,
 	})
 }
@@ -0,0 +1,176 @@
+package apperror
+
+import (
+	"backendgo/i18n"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ===================================================
+// 🔹 Error domain bertipe
+// Handler / repository cukup return *Error; middleware.ErrorHandler yang
+// menerjemahkannya ke status HTTP + envelope JSON yang seragam. Pesan ditulis
+// sebagai key katalog i18n (mis. "alumni.not_found") + argumen format:
+//
+//	{"success": false, "error": {"code", "message", "details", "request_id"}}
+// ===================================================
+
+// Kode error yang bisa dibaca mesin (stabil, dipakai client untuk percabangan)
+const (
+	CodeBadRequest         = "BAD_REQUEST"
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeNotFound           = "NOT_FOUND"
+	CodeConflict           = "CONFLICT"
+	CodeValidation         = "VALIDATION_FAILED"
+	CodePreconditionFailed = "PRECONDITION_FAILED"
+	CodeTooManyRequests    = "TOO_MANY_REQUESTS"
+	CodeInternal           = "INTERNAL_ERROR"
+)
+
+// Kode spesifik; status HTTP tetap dari constructor, kode ini hanya memperjelas penyebabnya
+const (
+	CodePermissionDenied       = "PERMISSION_DENIED"
+	CodeAlumniNotLinked        = "ALUMNI_NOT_LINKED"
+	CodePasswordChangeRequired = "PASSWORD_CHANGE_REQUIRED"
+	CodeMFAEnrollmentRequired  = "MFA_ENROLLMENT_REQUIRED"
+	CodeLoginLocked            = "LOGIN_LOCKED"
+)
+
+// Error satu kesalahan domain. Cause hanya untuk log, tidak pernah dikirim ke client.
+type Error struct {
+	Status  int
+	Code    string
+	Key     string
+	Args    []interface{}
+	Details interface{}
+	Cause   error
+}
+
+// Message pesan error dalam bahasa lang
+func (e *Error) Message(lang string) string {
+	return i18n.Translate(lang, e.Key, e.Args...)
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return e.Message(i18n.Default) + ": " + e.Cause.Error()
+	}
+	return e.Message(i18n.Default)
+}
+
+func (e *Error) Unwrap() error { return e.Cause }
+
+// Is dua *Error dianggap sama kalau status + kode + key-nya sama, supaya
+// errors.Is(err, repository.ErrUserNotFound) tetap benar setelah WithDetails / Wrap.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Status == e.Status && t.Code == e.Code && t.Key == e.Key
+}
+
+// New error dengan status HTTP; kodenya mengikuti status (lihat CodeForStatus)
+func New(status int, key string, args ...interface{}) *Error {
+	return &Error{Status: status, Code: CodeForStatus(status), Key: key, Args: args}
+}
+
+func BadRequest(key string, args ...interface{}) *Error {
+	return New(http.StatusBadRequest, key, args...)
+}
+
+func Unauthorized(key string, args ...interface{}) *Error {
+	return New(http.StatusUnauthorized, key, args...)
+}
+
+func Forbidden(key string, args ...interface{}) *Error {
+	return New(http.StatusForbidden, key, args...)
+}
+
+func NotFound(key string, args ...interface{}) *Error {
+	return New(http.StatusNotFound, key, args...)
+}
+
+func Conflict(key string, args ...interface{}) *Error {
+	return New(http.StatusConflict, key, args...)
+}
+
+// PreconditionFailed 412, dipakai saat If-Match tidak cocok dengan versi data terbaru
+func PreconditionFailed(key string, args ...interface{}) *Error {
+	return New(http.StatusPreconditionFailed, key, args...)
+}
+
+func TooManyRequests(key string, args ...interface{}) *Error {
+	return New(http.StatusTooManyRequests, key, args...)
+}
+
+func Internal(key string, args ...interface{}) *Error {
+	return New(http.StatusInternalServerError, key, args...)
+}
+
+// Validation 422 dengan daftar field yang tidak valid sebagai details
+func Validation(key string, details interface{}) *Error {
+	return New(http.StatusUnprocessableEntity, key).WithDetails(details)
+}
+
+// Method berikut mengembalikan salinan, jadi aman dipanggil pada error sentinel.
+
+// WithCode ganti kode umum dengan kode yang lebih spesifik (mis. ALUMNI_NOT_LINKED)
+func (e *Error) WithCode(code string) *Error {
+	cp := *e
+	cp.Code = code
+	return &cp
+}
+
+// WithDetails tambahkan data pendukung untuk client (field invalid, retry_after, ...)
+func (e *Error) WithDetails(details interface{}) *Error {
+	cp := *e
+	cp.Details = details
+	return &cp
+}
+
+// Wrap simpan error asli (driver, IO, ...) untuk log server
+func (e *Error) Wrap(cause error) *Error {
+	cp := *e
+	cp.Cause = cause
+	return &cp
+}
+
+// As ambil *Error dari rantai err (nil kalau bukan error domain)
+func As(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return nil
+}
+
+// CodeForStatus kode bawaan untuk status HTTP (status lain: teks status, mis. METHOD_NOT_ALLOWED)
+func CodeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeValidation
+	case http.StatusPreconditionFailed:
+		return CodePreconditionFailed
+	case http.StatusTooManyRequests:
+		return CodeTooManyRequests
+	case http.StatusInternalServerError:
+		return CodeInternal
+	}
+	if text := http.StatusText(status); text != "" {
+		return strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(text))
+	}
+	if status >= 500 {
+		return CodeInternal
+	}
+	return CodeBadRequest
+}
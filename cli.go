@@ -0,0 +1,106 @@
+package main
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/database"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{"serve", "jalankan HTTP server (default jika tanpa perintah)", runServe},
+	{"migrate", "migrasi skema PostgreSQL: up | down [n] | status", runMigrate},
+	{"seed", "isi data demo (admin, alumni, pekerjaan, file) dari seed tetap", runSeed},
+	{"user", "kelola user: create | reset-password | set-role", runUser},
+	{"import", "import data: alumni", runImport},
+	{"export", "export data: alumni | pekerjaan", runExport},
+	{"files", "pemeliharaan file upload: reconcile", runFiles},
+}
+
+// runCommand pilih subcommand dari argumen CLI. Semua perintah memakai .env yang sama
+// (sudah di-load di main) dan konek ke database sesuai kebutuhan masing-masing.
+func runCommand(args []string) error {
+	if len(args) == 0 {
+		return runServe(nil)
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		printUsage()
+		return nil
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			err := cmd.run(args[1:])
+			if errors.Is(err, flag.ErrHelp) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	printUsage()
+	return fmt.Errorf("perintah tidak dikenal: %s", args[0])
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "Pemakaian: backendgo <perintah> [opsi]")
+	fmt.Fprintln(os.Stderr, "\nPerintah:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-9s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(os.Stderr, "\nGunakan `backendgo <perintah> -h` untuk opsi tiap perintah.")
+}
+
+// newFlagSet flag set untuk satu subcommand, error parsing dikembalikan (bukan os.Exit)
+func newFlagSet(name, usage string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Pemakaian: backendgo %s\n", usage)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// connectDB konek ke PostgreSQL untuk perintah CLI; panggil fungsi yang dikembalikan saat selesai
+func connectDB() func() {
+	database.ConnectDB()
+	return func() { database.DB.Close() }
+}
+
+// userRepository repository user untuk perintah CLI (setelah connectDB)
+func userRepository() repository.UserRepository {
+	return repository.NewUserRepository(database.DB)
+}
+
+// rbacRepository repository role untuk perintah CLI (setelah connectDB)
+func rbacRepository() repository.RBACRepository {
+	return repository.NewRBACRepository(database.DB)
+}
+
+// refreshTokenRepository repository sesi login untuk perintah CLI (setelah connectDB)
+func refreshTokenRepository() repository.RefreshTokenRepository {
+	return repository.NewRefreshTokenRepository(database.DB)
+}
+
+// cliAudit catat audit log untuk aksi dari CLI (tanpa actor, IP = "cli")
+func cliAudit(action, target string, detail map[string]interface{}) {
+	if err := repository.NewAuditRepository(database.DB).Create(model.AuditLog{
+		Action: action,
+		Target: target,
+		IP:     "cli",
+		Detail: detail,
+	}); err != nil {
+		log.Println("Gagal menyimpan audit log:", err)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"backendgo/app/repository"
+	"backendgo/app/service"
+	"backendgo/database"
+	"bufio"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// runExport jalankan subcommand export. Filter, pencarian, dan sort memakai parameter
+// yang sama dengan endpoint list, mis. -query "angkatan=2020&sort=-nama".
+func runExport(args []string) error {
+	usage := "export alumni|pekerjaan [-format csv|xlsx|pdf] [-o file] [-query params]"
+	if len(args) == 0 {
+		return fmt.Errorf("pemakaian: %s", usage)
+	}
+
+	// tabel dirakit setelah konek database, di sini cukup validasi nama + spec filter
+	var newTable func(db *sql.DB) service.ExportTable
+	var spec *repository.ListSpec
+	switch args[0] {
+	case "alumni":
+		spec = repository.AlumniListSpec
+		newTable = func(db *sql.DB) service.ExportTable {
+			return service.AlumniExport(repository.NewAlumniRepository(db))
+		}
+	case "pekerjaan":
+		spec = repository.PekerjaanListSpec
+		newTable = func(db *sql.DB) service.ExportTable {
+			return service.PekerjaanExport(repository.NewPekerjaanRepository(db))
+		}
+	default:
+		return fmt.Errorf("data export tidak dikenal: %s (pakai alumni atau pekerjaan)", args[0])
+	}
+
+	flags := newFlagSet("export "+args[0], usage)
+	format := flags.String("format", "csv", "csv, xlsx, atau pdf")
+	output := flags.String("o", "", "file tujuan (kosong = stdout)")
+	rawQuery := flags.String("query", "", "filter / sort dalam format query string")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	*format = strings.ToLower(*format)
+	if !service.ValidExportFormat(*format) {
+		return fmt.Errorf("format harus csv, xlsx, atau pdf")
+	}
+
+	params, err := url.ParseQuery(*rawQuery)
+	if err != nil {
+		return fmt.Errorf("query tidak valid: %v", err)
+	}
+	q, err := repository.ParseListQuery(spec, func(key string, defaultValue ...string) string {
+		if v := params.Get(key); v != "" {
+			return v
+		}
+		if len(defaultValue) > 0 {
+			return defaultValue[0]
+		}
+		return ""
+	})
+	if err != nil {
+		return err
+	}
+	defer connectDB()()
+	table := newTable(database.DB)
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+	if err := service.WriteExport(w, table, *format, q); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if *output != "" {
+		fmt.Fprintf(os.Stderr, "✅ Export %s disimpan ke %s\n", table.Name, *output)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repositoryMongo"
+	"backendgo/app/serviceMongo"
+	"backendgo/database"
+	"fmt"
+)
+
+// runFiles jalankan subcommand files. `files reconcile` mencocokkan koleksi `files`
+// di MongoDB dengan folder upload; tanpa -fix hanya menampilkan laporan.
+func runFiles(args []string) error {
+	if len(args) == 0 || args[0] != "reconcile" {
+		return fmt.Errorf("pemakaian: files reconcile [-dir uploads] [-fix]")
+	}
+
+	flags := newFlagSet("files reconcile", "files reconcile [-dir uploads] [-fix]")
+	dir := flags.String("dir", serviceMongo.UploadBasePath(), "folder upload")
+	fix := flags.Bool("fix", false, "hapus record tanpa file, file milik user yang sudah dihapus, dan file tanpa record")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	defer connectDB()()
+	database.ConnectMongoDB()
+	if database.MongoDB == nil {
+		return fmt.Errorf("MongoDB belum dikonfigurasi (MONGO_URI / MONGO_DATABASE)")
+	}
+
+	report, err := serviceMongo.ReconcileFiles(
+		repositoryMongo.NewFileRepository(database.MongoDB), *dir, userRepository().ExistingIDs, *fix,
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d record file diperiksa\n", report.Checked)
+	for _, f := range report.MissingOnDisk {
+		fmt.Printf("  file hilang      %s (%s, user %d)\n", f.FilePath, f.ID.Hex(), f.UserID)
+	}
+	for _, f := range report.OrphanOwner {
+		fmt.Printf("  user tidak ada   %s (%s, user %d)\n", f.FilePath, f.ID.Hex(), f.UserID)
+	}
+	for _, path := range report.OrphanOnDisk {
+		fmt.Printf("  tanpa record     %s\n", path)
+	}
+
+	total := len(report.MissingOnDisk) + len(report.OrphanOwner) + len(report.OrphanOnDisk)
+	switch {
+	case total == 0:
+		fmt.Println("✅ Tidak ada selisih")
+	case *fix:
+		fmt.Printf("✅ %d item dibersihkan\n", report.Removed)
+		cliAudit(model.AuditFilesReconciled, *dir, map[string]interface{}{
+			"missing_on_disk": len(report.MissingOnDisk),
+			"orphan_owner":    len(report.OrphanOwner),
+			"orphan_on_disk":  len(report.OrphanOnDisk),
+			"removed":         report.Removed,
+		})
+	default:
+		fmt.Printf("%d selisih ditemukan, jalankan dengan -fix untuk membersihkan\n", total)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/app/service"
+	"backendgo/apperror"
+	"backendgo/database"
+	"backendgo/utils"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// runImport jalankan subcommand import. Saat ini hanya `import alumni`, aturannya
+// sama dengan POST /api/alumni/import (all-or-nothing, upsert berdasarkan NIM).
+func runImport(args []string) error {
+	if len(args) == 0 || args[0] != "alumni" {
+		return fmt.Errorf("pemakaian: import alumni -file data.csv [-dry-run] [-mapping JSON] [-report errors.csv]")
+	}
+
+	flags := newFlagSet("import alumni", "import alumni -file data.csv [-dry-run] [-mapping JSON] [-report errors.csv]")
+	file := flags.String("file", "", "file .csv atau .xlsx (wajib)")
+	dryRun := flags.Bool("dry-run", false, "hanya validasi, tidak menyimpan")
+	rawMapping := flags.String("mapping", "", `pemetaan kolom, mis. {"nim":"NIM Mahasiswa"}`)
+	reportPath := flags.String("report", "", "simpan laporan error (CSV) ke file ini")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *file == "" {
+		return fmt.Errorf("-file harus diisi")
+	}
+
+	mapping := map[string]string{}
+	if *rawMapping != "" {
+		if err := json.Unmarshal([]byte(*rawMapping), &mapping); err != nil {
+			return fmt.Errorf("mapping harus berupa JSON object")
+		}
+	}
+	content, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+	records, err := utils.ReadSpreadsheet(filepath.Base(*file), content)
+	if err != nil {
+		return err
+	}
+	defer connectDB()()
+
+	imports := service.NewAlumniImportService(
+		repository.NewAlumniImportRepository(database.DB),
+		repository.NewAuditRepository(database.DB),
+	)
+	result, importErr := imports.RunAlumniImport(nil, filepath.Base(*file), records, mapping, *dryRun)
+	if failure := apperror.As(importErr); importErr != nil && (failure == nil || failure.Status != 422) {
+		return importErr
+	}
+
+	printImportResult(result)
+	if *reportPath != "" && len(result.Errors) > 0 {
+		report, err := utils.BuildImportErrorReport(result.Errors)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*reportPath, report, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("Laporan error disimpan ke %s\n", *reportPath)
+	}
+	if importErr != nil {
+		return importErr
+	}
+
+	if !*dryRun {
+		cliAudit(model.AuditAlumniImported, filepath.Base(*file), map[string]interface{}{
+			"created": result.Created,
+			"updated": result.Updated,
+		})
+	}
+	return nil
+}
+
+func printImportResult(r model.AlumniImportResult) {
+	mode := "Import"
+	if r.DryRun {
+		mode = "Dry-run"
+	}
+	fmt.Printf("%s: %d baris, %d dibuat, %d diperbarui, %d gagal\n", mode, r.TotalRows, r.Created, r.Updated, r.Failed)
+	for _, e := range r.Errors {
+		column := e.Column
+		if column == "" {
+			column = "-"
+		}
+		fmt.Printf("  baris %d (NIM %s, kolom %s): %s\n", e.Row, e.NIM, column, e.Message)
+	}
+	if r.ReportID != "" {
+		fmt.Printf("ID laporan: %s\n", r.ReportID)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"backendgo/database"
+	"fmt"
+	"strconv"
+)
+
+// runMigrate jalankan subcommand migrate:
+//
+//	migrate up        → jalankan semua migrasi yang belum diterapkan
+//	migrate down [n]  → rollback n migrasi terakhir (default 1)
+//	migrate status    → tampilkan status setiap migrasi
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("pemakaian: migrate up | down [n] | status")
+	}
+	defer connectDB()()
+
+	switch args[0] {
+	case "up":
+		return migrateUp()
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("jumlah langkah rollback tidak valid: %s", args[1])
+			}
+			steps = n
+		}
+		reverted, err := database.MigrateDown(database.DB, steps)
+		for _, m := range reverted {
+			fmt.Printf("↩️  %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(reverted) == 0 {
+			fmt.Println("Tidak ada migrasi untuk di-rollback")
+		}
+		return nil
+
+	case "status":
+		statuses, err := database.MigrationStatuses(database.DB)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-24s %s\n", s.Version, s.Name, applied)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("perintah migrate tidak dikenal: %s (pakai up, down atau status)", args[0])
+	}
+}
+
+// migrateUp jalankan migrasi yang belum diterapkan (DB sudah terkoneksi)
+func migrateUp() error {
+	applied, err := database.MigrateUp(database.DB)
+	for _, m := range applied {
+		fmt.Printf("✅ %04d_%s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		fmt.Println("Skema sudah versi terbaru")
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/serviceMongo"
+	"backendgo/config"
+	"backendgo/database"
+	"backendgo/database/seeder"
+	"fmt"
+)
+
+// runSeed isi database untuk development / demo: akun admin awal lalu data alumni,
+// riwayat pekerjaan, roster lulusan, dan file upload contoh yang dibangkitkan dari seed
+// tetap (seed sama = data sama). -alumni 0 hanya membuat admin.
+func runSeed(args []string) error {
+	flags := newFlagSet("seed", "seed [-reset] [-seed 42] [-alumni 50] [-admin-username admin] [-admin-email e] [-admin-password p]")
+	reset := flags.Bool("reset", false, "kosongkan data user, alumni, pekerjaan, roster, dan file terlebih dahulu")
+	seed := flags.Int64("seed", int64(config.GetInt("SEED_RANDOM_SEED", 42)), "seed random generator")
+	alumniCount := flags.Int("alumni", config.GetInt("SEED_ALUMNI_COUNT", 50), "jumlah alumni demo (0 = hanya admin)")
+	username := flags.String("admin-username", config.GetEnv("SEED_ADMIN_USERNAME", "admin"), "username admin")
+	email := flags.String("admin-email", config.GetEnv("SEED_ADMIN_EMAIL", "admin@example.com"), "email admin")
+	password := flags.String("admin-password", config.GetEnv("SEED_ADMIN_PASSWORD", ""), "password admin (kosong = dibuat acak)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *alumniCount < 0 || *alumniCount > 5000 {
+		return fmt.Errorf("-alumni harus antara 0 dan 5000")
+	}
+	if *reset && config.GetEnv("APP_ENV", "development") == "production" {
+		return fmt.Errorf("seed -reset tidak boleh dijalankan saat APP_ENV=production")
+	}
+
+	defer connectDB()()
+	database.ConnectMongoDB()
+
+	if *reset {
+		if err := seeder.Reset(database.DB, database.MongoDB); err != nil {
+			return err
+		}
+		fmt.Println("🧹 Data lama dihapus")
+	}
+
+	if err := seedAdmin(*username, *email, *password); err != nil {
+		return err
+	}
+	if *alumniCount == 0 {
+		return nil
+	}
+
+	data := seeder.Generate(*seed, *alumniCount)
+	summary, err := seeder.Apply(database.DB, database.MongoDB, data, seeder.Options{
+		UserPassword: config.GetEnv("SEED_USER_PASSWORD", "alumni123"),
+		UploadDir:    serviceMongo.UploadBasePath(),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Seed %d: %d alumni (+ akun user), %d pekerjaan, %d roster lulusan\n",
+		*seed, summary.Alumni, summary.Pekerjaan, summary.Roster)
+	if summary.MongoSkipped {
+		fmt.Println("⚠️  MongoDB tidak terhubung, pekerjaan-mongo dan file contoh dilewati")
+	} else {
+		fmt.Printf("✅ MongoDB: %d pekerjaan, %d file\n", summary.MongoJobs, summary.MongoFiles)
+	}
+	fmt.Printf("Login alumni demo: username = email tanpa domain (mis. %s), password dari SEED_USER_PASSWORD\n",
+		data.Alumni[0].Username)
+	return nil
+}
+
+// seedAdmin buat akun admin awal. Aman dijalankan berulang: jika username / email
+// sudah ada, tidak ada yang diubah.
+func seedAdmin(username, email, password string) error {
+	taken, err := userRepository().IsUsernameOrEmailTaken(username, email)
+	if err != nil {
+		return err
+	}
+	if taken {
+		fmt.Printf("Admin %s sudah ada, dilewati\n", username)
+		return nil
+	}
+
+	id, generated, err := createUser(username, email, model.RoleAdmin, password)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ Admin %s dibuat (id %d)\n", username, id)
+	if generated != "" {
+		fmt.Printf("Password sementara: %s\n", generated)
+	}
+	return nil
+}
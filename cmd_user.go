@@ -0,0 +1,203 @@
+package main
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/service"
+	"backendgo/utils"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// runUser jalankan subcommand user:
+//
+//	user create -username u -email e [-role user] [-password p]
+//	user reset-password -user <id|username|email> [-password p]
+//	user set-role -user <id|username|email> -role r
+//
+// Jika -password kosong, password sementara dibuat acak dan ditampilkan sekali.
+// Password dari CLI selalu wajib diganti saat login pertama.
+func runUser(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("pemakaian: user create | reset-password | set-role")
+	}
+
+	switch args[0] {
+	case "create":
+		return runUserCreate(args[1:])
+	case "reset-password":
+		return runUserResetPassword(args[1:])
+	case "set-role":
+		return runUserSetRole(args[1:])
+	default:
+		return fmt.Errorf("perintah user tidak dikenal: %s (pakai create, reset-password atau set-role)", args[0])
+	}
+}
+
+func runUserCreate(args []string) error {
+	flags := newFlagSet("user create", "user create -username u -email e [-role user] [-password p]")
+	username := flags.String("username", "", "username (wajib)")
+	email := flags.String("email", "", "email (wajib)")
+	role := flags.String("role", model.RoleUser, "role user")
+	password := flags.String("password", "", "password awal (kosong = dibuat acak)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	defer connectDB()()
+
+	id, generated, err := createUser(*username, *email, *role, *password)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ User %s dibuat (id %d, role %s)\n", *username, id, *role)
+	if generated != "" {
+		fmt.Printf("Password sementara: %s\n", generated)
+	}
+	return nil
+}
+
+// createUser validasi + buat user, mengembalikan password acak jika password kosong
+func createUser(username, email, role, password string) (int, string, error) {
+	username, email = strings.TrimSpace(username), strings.TrimSpace(email)
+	if username == "" || email == "" {
+		return 0, "", fmt.Errorf("username dan email harus diisi")
+	}
+	if !strings.Contains(email, "@") {
+		return 0, "", fmt.Errorf("format email tidak valid")
+	}
+	if _, err := rbacRepository().GetRoleByName(role); err != nil {
+		return 0, "", fmt.Errorf("role tidak dikenal: %s", role)
+	}
+	taken, err := userRepository().IsUsernameOrEmailTaken(username, email)
+	if err != nil {
+		return 0, "", err
+	}
+	if taken {
+		return 0, "", fmt.Errorf("username atau email sudah dipakai")
+	}
+
+	password, generated, err := resolvePassword(password)
+	if err != nil {
+		return 0, "", err
+	}
+	hash, err := utils.HashPassword(password)
+	if err != nil {
+		return 0, "", err
+	}
+	id, err := userRepository().Create(username, email, hash, role)
+	if err != nil {
+		return 0, "", err
+	}
+	cliAudit(model.AuditUserCreated, fmt.Sprintf("user:%d", id), map[string]interface{}{
+		"username": username,
+		"role":     role,
+	})
+	return id, generated, nil
+}
+
+func runUserResetPassword(args []string) error {
+	flags := newFlagSet("user reset-password", "user reset-password -user <id|username|email> [-password p]")
+	identifier := flags.String("user", "", "id, username, atau email user (wajib)")
+	password := flags.String("password", "", "password baru (kosong = dibuat acak)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	defer connectDB()()
+
+	user, err := findUser(*identifier)
+	if err != nil {
+		return err
+	}
+	newPassword, generated, err := resolvePassword(*password)
+	if err != nil {
+		return err
+	}
+	hash, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	if err := userRepository().UpdatePassword(user.ID, hash, true); err != nil {
+		return err
+	}
+	// Sesi lama tidak boleh tetap hidup setelah password direset
+	if err := refreshTokenRepository().RevokeAllForUser(user.ID); err != nil {
+		return err
+	}
+	cliAudit(model.AuditUserPasswordReset, fmt.Sprintf("user:%d", user.ID), map[string]interface{}{
+		"username": user.Username,
+	})
+
+	fmt.Printf("✅ Password %s direset, semua sesi dicabut\n", user.Username)
+	if generated != "" {
+		fmt.Printf("Password sementara: %s\n", generated)
+	}
+	return nil
+}
+
+func runUserSetRole(args []string) error {
+	flags := newFlagSet("user set-role", "user set-role -user <id|username|email> -role r")
+	identifier := flags.String("user", "", "id, username, atau email user (wajib)")
+	role := flags.String("role", "", "role baru (wajib)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *role == "" {
+		return fmt.Errorf("-role harus diisi")
+	}
+	defer connectDB()()
+
+	user, err := findUser(*identifier)
+	if err != nil {
+		return err
+	}
+	if _, err := rbacRepository().GetRoleByName(*role); err != nil {
+		return fmt.Errorf("role tidak dikenal: %s", *role)
+	}
+	if err := userRepository().UpdateRole(user.ID, *role); err != nil {
+		return err
+	}
+	cliAudit(model.AuditUserRoleChanged, fmt.Sprintf("user:%d", user.ID), map[string]interface{}{
+		"username": user.Username,
+		"from":     user.Role,
+		"to":       *role,
+	})
+
+	fmt.Printf("✅ Role %s: %s → %s\n", user.Username, user.Role, *role)
+	return nil
+}
+
+// findUser cari user dari id, username, atau email
+func findUser(identifier string) (*model.User, error) {
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return nil, fmt.Errorf("-user harus diisi")
+	}
+
+	var user *model.User
+	var err error
+	if id, convErr := strconv.Atoi(identifier); convErr == nil {
+		user, err = userRepository().GetByID(id)
+	} else {
+		user, err = userRepository().GetByUsernameOrEmail(identifier)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("user tidak ditemukan: %s", identifier)
+	}
+	return user, nil
+}
+
+// resolvePassword pakai password yang diberikan (dicek panjangnya) atau buat password acak.
+// Nilai kedua berisi password acak supaya bisa ditampilkan ke operator.
+func resolvePassword(password string) (string, string, error) {
+	if password == "" {
+		generated, err := utils.GenerateOpaqueToken(12)
+		if err != nil {
+			return "", "", err
+		}
+		return generated, generated, nil
+	}
+	if len(password) < service.MinPasswordLength {
+		return "", "", fmt.Errorf("password minimal %d karakter", service.MinPasswordLength)
+	}
+	return password, "", nil
+}
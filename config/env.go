@@ -3,6 +3,8 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -19,3 +21,45 @@ func GetEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// GetDuration membaca env berformat durasi Go (mis. "15m", "720h")
+func GetDuration(key string, fallback time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Nilai %s tidak valid (%s), pakai default %s\n", key, value, fallback)
+		return fallback
+	}
+	return d
+}
+
+// GetInt membaca env berupa angka bulat
+func GetInt(key string, fallback int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Nilai %s tidak valid (%s), pakai default %d\n", key, value, fallback)
+		return fallback
+	}
+	return n
+}
+
+// GetBool membaca env berupa boolean ("true", "1", "false", "0", ...)
+func GetBool(key string, fallback bool) bool {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return fallback
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("Nilai %s tidak valid (%s), pakai default %t\n", key, value, fallback)
+		return fallback
+	}
+	return b
+}
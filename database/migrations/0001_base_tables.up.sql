@@ -0,0 +1,45 @@
+-- Tabel inti: users, alumni dan pekerjaan_alumni.
+CREATE TABLE IF NOT EXISTS users (
+    id             SERIAL PRIMARY KEY,
+    username       VARCHAR(100) NOT NULL UNIQUE,
+    email          VARCHAR(100) NOT NULL UNIQUE,
+    password_hash  VARCHAR(255) NOT NULL,
+    role           VARCHAR(50)  NOT NULL DEFAULT 'user',
+    created_at     TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS alumni (
+    id               SERIAL PRIMARY KEY,
+    user_id          INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    nim              VARCHAR(20)  NOT NULL UNIQUE,
+    nama             VARCHAR(100) NOT NULL,
+    jurusan          VARCHAR(100) NOT NULL DEFAULT '',
+    angkatan         INTEGER      NOT NULL DEFAULT 0,
+    tahun_lulus      INTEGER      NOT NULL DEFAULT 0,
+    email            VARCHAR(100) NOT NULL DEFAULT '',
+    no_telepon       VARCHAR(20)  NOT NULL DEFAULT '',
+    alamat           TEXT         NOT NULL DEFAULT '',
+    status_kematian  BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at       TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS pekerjaan_alumni (
+    id                     SERIAL PRIMARY KEY,
+    alumni_id              INTEGER NOT NULL REFERENCES alumni(id) ON DELETE CASCADE,
+    nama_perusahaan        VARCHAR(100) NOT NULL,
+    posisi_jabatan         VARCHAR(100) NOT NULL,
+    bidang_industri        VARCHAR(50)  NOT NULL DEFAULT '',
+    lokasi_kerja           VARCHAR(100) NOT NULL DEFAULT '',
+    gaji_range             VARCHAR(50)  NOT NULL DEFAULT '',
+    tanggal_mulai_kerja    DATE         NOT NULL,
+    tanggal_selesai_kerja  DATE         NULL,
+    status_pekerjaan       VARCHAR(20)  NOT NULL DEFAULT 'aktif',
+    deskripsi_pekerjaan    TEXT         NOT NULL DEFAULT '',
+    is_deleted             BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at             TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at             TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pekerjaan_alumni_alumni ON pekerjaan_alumni (alumni_id) WHERE is_deleted = FALSE;
@@ -0,0 +1,21 @@
+-- Proteksi brute-force login dan audit log.
+CREATE TABLE IF NOT EXISTS login_attempts (
+    scope            VARCHAR(16)  NOT NULL,
+    key              VARCHAR(255) NOT NULL,
+    failures         INTEGER      NOT NULL DEFAULT 0,
+    last_failure_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    locked_until     TIMESTAMP    NULL,
+    PRIMARY KEY (scope, key)
+);
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id             SERIAL PRIMARY KEY,
+    actor_user_id  INTEGER NULL,
+    action         VARCHAR(64)  NOT NULL,
+    target         VARCHAR(255) NOT NULL DEFAULT '',
+    ip             VARCHAR(64)  NOT NULL DEFAULT '',
+    detail         JSONB        NOT NULL DEFAULT '{}'::jsonb,
+    created_at     TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs (action, created_at DESC);
@@ -0,0 +1,58 @@
+-- RBAC: role dan permission disimpan di database, users.role merujuk ke roles.name.
+CREATE TABLE IF NOT EXISTS roles (
+    name        VARCHAR(50) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    is_system   BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    code        VARCHAR(100) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_name       VARCHAR(50) NOT NULL REFERENCES roles(name) ON DELETE CASCADE ON UPDATE CASCADE,
+    permission_code VARCHAR(100) NOT NULL REFERENCES permissions(code) ON DELETE CASCADE,
+    PRIMARY KEY (role_name, permission_code)
+);
+
+INSERT INTO roles (name, description, is_system) VALUES
+    ('admin', 'Administrator, akses penuh', TRUE),
+    ('user',  'Alumni, akses data miliknya sendiri', TRUE)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (code, description) VALUES
+    ('alumni:read',           'Melihat data alumni'),
+    ('alumni:write',          'Menambah dan mengubah data alumni'),
+    ('alumni:delete',         'Menghapus data alumni'),
+    ('pekerjaan:read',        'Melihat data pekerjaan alumni'),
+    ('pekerjaan:write',       'Menambah dan mengubah pekerjaan semua alumni'),
+    ('pekerjaan:delete',      'Menghapus pekerjaan semua alumni'),
+    ('pekerjaan:manage_all',  'Soft delete, restore dan melihat trash pekerjaan semua alumni'),
+    ('pekerjaan:hard_delete', 'Menghapus permanen pekerjaan semua alumni'),
+    ('files:read_all',        'Melihat file milik semua user'),
+    ('files:manage_all',      'Mengupload dan menghapus file milik user lain'),
+    ('users:manage',          'Mengelola akun user (reset password, unlock, ganti role)'),
+    ('roles:manage',          'Mengelola role dan permission')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role_name, permission_code)
+SELECT 'admin', code FROM permissions
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role_name, permission_code) VALUES
+    ('user', 'alumni:read'),
+    ('user', 'pekerjaan:read')
+ON CONFLICT DO NOTHING;
+
+-- Role yang sudah dipakai user tapi belum terdaftar ikut dibuat supaya FK valid
+INSERT INTO roles (name) SELECT DISTINCT role FROM users ON CONFLICT (name) DO NOTHING;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'users_role_fkey') THEN
+        ALTER TABLE users ADD CONSTRAINT users_role_fkey
+            FOREIGN KEY (role) REFERENCES roles(name) ON UPDATE CASCADE;
+    END IF;
+END $$;
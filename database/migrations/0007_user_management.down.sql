@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS alumni_user_id_unique;
+
+-- Alumni yang sudah dilepas dari user tidak bisa dikembalikan ke NOT NULL.
+-- Rollback dibatalkan (bukan menghapus data alumni); hubungkan dulu alumni tersebut ke user.
+DO $$
+DECLARE
+    unlinked INTEGER;
+BEGIN
+    SELECT COUNT(*) INTO unlinked FROM alumni WHERE user_id IS NULL;
+    IF unlinked > 0 THEN
+        RAISE EXCEPTION 'Tidak bisa rollback 0007: % alumni belum terhubung ke user', unlinked;
+    END IF;
+END $$;
+ALTER TABLE alumni ALTER COLUMN user_id SET NOT NULL;
+
+ALTER TABLE users DROP COLUMN IF EXISTS is_active;
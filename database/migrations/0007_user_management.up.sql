@@ -0,0 +1,7 @@
+-- Manajemen user: status aktif + relasi user ↔ alumni yang bisa dilepas.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_active BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE alumni ALTER COLUMN user_id DROP NOT NULL;
+
+-- Satu user paling banyak terhubung ke satu alumni
+CREATE UNIQUE INDEX IF NOT EXISTS alumni_user_id_unique ON alumni (user_id) WHERE user_id IS NOT NULL;
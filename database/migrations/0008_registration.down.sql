@@ -0,0 +1,5 @@
+DELETE FROM role_permissions WHERE permission_code = 'registrations:manage';
+DELETE FROM permissions WHERE code = 'registrations:manage';
+
+DROP TABLE IF EXISTS alumni_registrations;
+DROP TABLE IF EXISTS graduate_roster;
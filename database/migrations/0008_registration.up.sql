@@ -0,0 +1,40 @@
+-- Pendaftaran mandiri alumni: roster lulusan + antrian pendaftaran.
+CREATE TABLE IF NOT EXISTS graduate_roster (
+    nim          VARCHAR(20) PRIMARY KEY,
+    nama         VARCHAR(100) NOT NULL,
+    jurusan      VARCHAR(100) NOT NULL DEFAULT '',
+    angkatan     INTEGER NOT NULL DEFAULT 0,
+    tahun_lulus  INTEGER NOT NULL DEFAULT 0,
+    imported_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS alumni_registrations (
+    id                       SERIAL PRIMARY KEY,
+    nim                      VARCHAR(20) NOT NULL,
+    nama                     VARCHAR(100) NOT NULL,
+    email                    VARCHAR(100) NOT NULL,
+    status                   VARCHAR(30) NOT NULL DEFAULT 'pending_verification',
+    verification_token_hash  VARCHAR(64) NULL UNIQUE,
+    verification_expires_at  TIMESTAMP NULL,
+    email_verified_at        TIMESTAMP NULL,
+    reviewed_by              INTEGER NULL REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at              TIMESTAMP NULL,
+    reject_reason            TEXT NOT NULL DEFAULT '',
+    alumni_id                INTEGER NULL REFERENCES alumni(id) ON DELETE SET NULL,
+    created_at               TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at               TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Satu NIM hanya boleh punya satu pendaftaran yang masih berjalan
+CREATE UNIQUE INDEX IF NOT EXISTS alumni_registrations_active_nim
+    ON alumni_registrations (nim)
+    WHERE status IN ('pending_verification', 'pending_approval');
+
+CREATE INDEX IF NOT EXISTS idx_alumni_registrations_status ON alumni_registrations (status);
+
+INSERT INTO permissions (code, description) VALUES
+    ('registrations:manage', 'Mengelola roster lulusan dan menyetujui / menolak pendaftaran alumni')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role_name, permission_code) VALUES ('admin', 'registrations:manage')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,10 @@
+-- Laporan error import alumni (CSV) yang bisa diunduh ulang.
+CREATE TABLE IF NOT EXISTS alumni_import_reports (
+    id          UUID PRIMARY KEY,
+    created_by  INTEGER NULL REFERENCES users(id) ON DELETE SET NULL,
+    filename    VARCHAR(255) NOT NULL DEFAULT '',
+    content     BYTEA NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_alumni_import_reports_created_at ON alumni_import_reports (created_at);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_pekerjaan_posisi_trgm;
+DROP INDEX IF EXISTS idx_pekerjaan_perusahaan_trgm;
+DROP INDEX IF EXISTS idx_alumni_nim_trgm;
+DROP INDEX IF EXISTS idx_alumni_nama_trgm;
+
+ALTER TABLE pekerjaan_alumni DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE alumni DROP COLUMN IF EXISTS search_vector;
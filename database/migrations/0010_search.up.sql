@@ -0,0 +1,28 @@
+-- Pencarian full-text (tsvector) + fuzzy (pg_trgm) untuk alumni dan pekerjaan.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Kolom tsvector di-generate Postgres, jadi selalu ikut ter-update saat data berubah.
+-- Nama / NIM / perusahaan memakai konfigurasi 'simple' (tanpa stemming), deskripsi
+-- pekerjaan memakai 'indonesian'.
+ALTER TABLE alumni ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(nama, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(nim, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(jurusan, '')), 'B')
+    ) STORED;
+
+ALTER TABLE pekerjaan_alumni ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(nama_perusahaan, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(posisi_jabatan, '')), 'B') ||
+        setweight(to_tsvector('indonesian', coalesce(deskripsi_pekerjaan, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_alumni_search_vector ON alumni USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_pekerjaan_search_vector ON pekerjaan_alumni USING GIN (search_vector);
+
+-- Trigram untuk toleransi salah ketik (operator <% / word_similarity)
+CREATE INDEX IF NOT EXISTS idx_alumni_nama_trgm ON alumni USING GIN (nama gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_alumni_nim_trgm ON alumni USING GIN (nim gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_pekerjaan_perusahaan_trgm ON pekerjaan_alumni USING GIN (nama_perusahaan gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_pekerjaan_posisi_trgm ON pekerjaan_alumni USING GIN (posisi_jabatan gin_trgm_ops);
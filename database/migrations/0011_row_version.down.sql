@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS trg_pekerjaan_version ON pekerjaan_alumni;
+DROP TRIGGER IF EXISTS trg_alumni_version ON alumni;
+DROP FUNCTION IF EXISTS bump_row_version();
+
+ALTER TABLE pekerjaan_alumni DROP COLUMN IF EXISTS version;
+ALTER TABLE alumni DROP COLUMN IF EXISTS version;
@@ -0,0 +1,20 @@
+-- Versi baris untuk optimistic concurrency (ETag / If-Match) alumni dan pekerjaan.
+ALTER TABLE alumni ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE pekerjaan_alumni ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+-- Versi dinaikkan trigger, jadi semua UPDATE (termasuk import, link user,
+-- soft delete) ikut mengubah ETag tanpa harus diingat di setiap query.
+CREATE OR REPLACE FUNCTION bump_row_version() RETURNS trigger AS $$
+BEGIN
+    NEW.version := OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_alumni_version ON alumni;
+CREATE TRIGGER trg_alumni_version BEFORE UPDATE ON alumni
+    FOR EACH ROW EXECUTE FUNCTION bump_row_version();
+
+DROP TRIGGER IF EXISTS trg_pekerjaan_version ON pekerjaan_alumni;
+CREATE TRIGGER trg_pekerjaan_version BEFORE UPDATE ON pekerjaan_alumni
+    FOR EACH ROW EXECUTE FUNCTION bump_row_version();
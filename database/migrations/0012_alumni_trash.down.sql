@@ -0,0 +1,8 @@
+DELETE FROM role_permissions WHERE permission_code = 'alumni:hard_delete';
+DELETE FROM permissions WHERE code = 'alumni:hard_delete';
+
+DROP INDEX IF EXISTS idx_alumni_trashed;
+
+ALTER TABLE pekerjaan_alumni DROP COLUMN IF EXISTS deleted_with_alumni;
+ALTER TABLE alumni DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE alumni DROP COLUMN IF EXISTS is_deleted;
@@ -0,0 +1,17 @@
+-- Trash alumni: soft delete, restore, dan hard delete (ikut menghapus akun user).
+ALTER TABLE alumni ADD COLUMN IF NOT EXISTS is_deleted BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE alumni ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL;
+
+-- Pekerjaan yang ikut di-trash bersama alumninya. Restore alumni hanya memulihkan
+-- pekerjaan ini, pekerjaan yang sudah di-trash sendiri sebelumnya tetap di trash.
+ALTER TABLE pekerjaan_alumni ADD COLUMN IF NOT EXISTS deleted_with_alumni BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_alumni_trashed ON alumni (deleted_at DESC) WHERE is_deleted = TRUE;
+
+INSERT INTO permissions (code, description) VALUES
+    ('alumni:hard_delete', 'Menghapus permanen alumni beserta akun user dan datanya')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role_name, permission_code) VALUES
+    ('admin', 'alumni:hard_delete')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,10 @@
+-- Token challenge MFA yang sudah ditukar di /api/login/mfa (jti), supaya satu
+-- token tidak bisa dipakai dua kali. Baris boleh dibuang setelah expires_at lewat.
+CREATE TABLE IF NOT EXISTS mfa_challenges_used (
+    jti         VARCHAR(64) PRIMARY KEY,
+    user_id     INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at  TIMESTAMP NOT NULL,
+    used_at     TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_mfa_challenges_used_expires ON mfa_challenges_used (expires_at);
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"backendgo/config"
 	"context"
 	"fmt"
 	"log"
@@ -39,4 +40,11 @@ func ConnectMongoDB() {
 
 	MongoDB = client.Database(dbName)
 	fmt.Println("🎉 Berhasil terhubung ke MongoDB!")
+
+	// Validator + index koleksi; gagal (mis. user tanpa hak collMod) tidak menghentikan server
+	if config.GetBool("MONGO_BOOTSTRAP", true) {
+		if err := EnsureMongoSchema(ctx, MongoDB); err != nil {
+			log.Printf("⚠️  Bootstrap skema MongoDB gagal: %v", err)
+		}
+	}
 }
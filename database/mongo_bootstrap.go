@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoCollectionSpec validator ($jsonSchema) + index untuk satu koleksi
+type MongoCollectionSpec struct {
+	Name      string
+	Validator bson.M
+	Indexes   []mongo.IndexModel
+}
+
+// Integer dari Go bisa tersimpan sebagai int32 atau int64 tergantung nilainya
+var mongoIntTypes = bson.A{"int", "long"}
+
+// MongoCollectionSpecs skema koleksi yang dipakai aplikasi
+func MongoCollectionSpecs() []MongoCollectionSpec {
+	return []MongoCollectionSpec{
+		{
+			Name: "pekerjaan_alumni",
+			Validator: bson.M{"$jsonSchema": bson.M{
+				"bsonType": "object",
+				"required": bson.A{"alumni_id", "nama_perusahaan", "posisi_jabatan", "is_deleted", "created_at", "updated_at"},
+				"properties": bson.M{
+					"alumni_id":             bson.M{"bsonType": mongoIntTypes},
+					"nama_perusahaan":       bson.M{"bsonType": "string"},
+					"posisi_jabatan":        bson.M{"bsonType": "string"},
+					"bidang_industri":       bson.M{"bsonType": "string"},
+					"lokasi_kerja":          bson.M{"bsonType": "string"},
+					"gaji_range":            bson.M{"bsonType": "string"},
+					"tanggal_mulai_kerja":   bson.M{"bsonType": "date"},
+					"tanggal_selesai_kerja": bson.M{"bsonType": bson.A{"null", "date"}},
+					"status_pekerjaan":      bson.M{"bsonType": "string"},
+					"deskripsi_pekerjaan":   bson.M{"bsonType": "string"},
+					"is_deleted":            bson.M{"bsonType": "bool"},
+					"deleted_with_alumni":   bson.M{"bsonType": "bool"},
+					"created_at":            bson.M{"bsonType": "date"},
+					"updated_at":            bson.M{"bsonType": "date"},
+				},
+			}},
+			Indexes: []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "alumni_id", Value: 1}, {Key: "is_deleted", Value: 1}},
+					Options: options.Index().SetName("alumni_id_is_deleted"),
+				},
+				{
+					// Dipakai cursor pagination (filter is_deleted, urut created_at + _id)
+					Keys:    bson.D{{Key: "is_deleted", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+					Options: options.Index().SetName("is_deleted_created_at_id"),
+				},
+			},
+		},
+		{
+			Name: "files",
+			Validator: bson.M{"$jsonSchema": bson.M{
+				"bsonType": "object",
+				"required": bson.A{"user_id", "file_name", "file_path", "file_size", "file_type", "uploaded_at"},
+				"properties": bson.M{
+					"user_id":       bson.M{"bsonType": mongoIntTypes},
+					"file_name":     bson.M{"bsonType": "string"},
+					"original_name": bson.M{"bsonType": "string"},
+					"file_path":     bson.M{"bsonType": "string"},
+					"file_size":     bson.M{"bsonType": mongoIntTypes, "minimum": 0},
+					"file_type":     bson.M{"bsonType": "string"},
+					"file_category": bson.M{"bsonType": "string"},
+					"uploaded_at":   bson.M{"bsonType": "date"},
+				},
+			}},
+			Indexes: []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "uploaded_at", Value: -1}},
+					Options: options.Index().SetName("user_id_uploaded_at"),
+				},
+			},
+		},
+	}
+}
+
+// EnsureMongoSchema buat koleksi (atau perbarui validator via collMod) dan index.
+// Aman dijalankan berulang kali; index yang sudah ada tidak dibuat ulang.
+// Validator memakai level "moderate" supaya dokumen lama yang belum valid
+// tetap bisa dibaca/di-update.
+func EnsureMongoSchema(ctx context.Context, db *mongo.Database) error {
+	existing, err := db.ListCollectionNames(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+	exists := map[string]bool{}
+	for _, name := range existing {
+		exists[name] = true
+	}
+
+	var errs []error
+	for _, spec := range MongoCollectionSpecs() {
+		if exists[spec.Name] {
+			err = db.RunCommand(ctx, bson.D{
+				{Key: "collMod", Value: spec.Name},
+				{Key: "validator", Value: spec.Validator},
+				{Key: "validationLevel", Value: "moderate"},
+			}).Err()
+		} else {
+			err = db.CreateCollection(ctx, spec.Name, options.CreateCollection().
+				SetValidator(spec.Validator).
+				SetValidationLevel("moderate"))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("validator %s: %w", spec.Name, err))
+		}
+
+		if len(spec.Indexes) > 0 {
+			if _, err := db.Collection(spec.Name).Indexes().CreateMany(ctx, spec.Indexes); err != nil {
+				errs = append(errs, fmt.Errorf("index %s: %w", spec.Name, err))
+			}
+		}
+	}
+	if exists["pekerjaan_alumni"] {
+		if err := repairPekerjaanDates(ctx, db); err != nil {
+			errs = append(errs, fmt.Errorf("tanggal pekerjaan_alumni: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// repairPekerjaanDates update pekerjaan versi lama menyimpan tanggal kerja sebagai string
+// "YYYY-MM-DD" sehingga dokumennya gagal di-decode. Ubah ke date; string kosong atau
+// tidak valid dihapus, sama seperti tanggal yang dikosongkan lewat update.
+func repairPekerjaanDates(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection("pekerjaan_alumni")
+	for _, field := range []string{"tanggal_mulai_kerja", "tanggal_selesai_kerja"} {
+		_, err := collection.UpdateMany(ctx,
+			bson.M{field: bson.M{"$type": "string"}},
+			mongo.Pipeline{{{Key: "$set", Value: bson.M{field: bson.M{"$dateFromString": bson.M{
+				"dateString": "$" + field,
+				"format":     "%Y-%m-%d",
+				"onError":    "$$REMOVE",
+			}}}}}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package seeder
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"backendgo/app/modelmongo"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrNotEmpty tabel alumni sudah berisi data; seeder hanya mengisi database kosong
+// supaya hasilnya selalu sama (pakai Reset terlebih dahulu)
+var ErrNotEmpty = errors.New("database sudah berisi data alumni, jalankan dengan reset")
+
+type Options struct {
+	// Password semua akun alumni demo (tidak wajib diganti saat login)
+	UserPassword string
+	// Folder upload tempat file demo ditulis, sama dengan folder upload API
+	UploadDir string
+}
+
+type Summary struct {
+	Users        int  `json:"users"`
+	Alumni       int  `json:"alumni"`
+	Pekerjaan    int  `json:"pekerjaan"`
+	Roster       int  `json:"roster"`
+	MongoJobs    int  `json:"mongo_pekerjaan"`
+	MongoFiles   int  `json:"files"`
+	MongoSkipped bool `json:"mongo_skipped"`
+}
+
+// Reset kosongkan data aplikasi: semua user (beserta alumni, pekerjaan, token, dll lewat
+// CASCADE), roster, audit log, percobaan login, dan koleksi MongoDB `pekerjaan_alumni` /
+// `files` beserta file fisiknya. Role, permission, dan schema_migrations tidak disentuh.
+func Reset(db *sql.DB, mdb *mongo.Database) error {
+	_, err := db.Exec(`
+		TRUNCATE users, alumni, pekerjaan_alumni, graduate_roster, audit_logs, login_attempts
+		RESTART IDENTITY CASCADE
+	`)
+	if err != nil {
+		return err
+	}
+	if mdb == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Hapus file fisik yang tercatat saja, file lain di folder upload tidak disentuh
+	cursor, err := mdb.Collection("files").Find(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+	var files []modelmongo.File
+	if err := cursor.All(ctx, &files); err != nil {
+		return err
+	}
+	for _, f := range files {
+		os.Remove(f.FilePath)
+	}
+
+	if _, err := mdb.Collection("files").DeleteMany(ctx, bson.M{}); err != nil {
+		return err
+	}
+	_, err = mdb.Collection("pekerjaan_alumni").DeleteMany(ctx, bson.M{})
+	return err
+}
+
+// Apply simpan dataset ke PostgreSQL (satu transaksi) lalu ke MongoDB jika terhubung.
+// Pekerjaan ditulis ke kedua database supaya endpoint /pekerjaan dan /pekerjaan-mongo
+// sama-sama berisi data.
+func Apply(db *sql.DB, mdb *mongo.Database, data Dataset, opts Options) (Summary, error) {
+	var summary Summary
+
+	var existing int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM alumni`).Scan(&existing); err != nil {
+		return summary, err
+	}
+	if existing > 0 {
+		return summary, ErrNotEmpty
+	}
+
+	// bcrypt sengaja hanya sekali, semua akun demo memakai password yang sama
+	hash, err := bcrypt.GenerateFromPassword([]byte(opts.UserPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return summary, err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return summary, err
+	}
+	defer tx.Rollback()
+
+	alumniIDs := make([]int, len(data.Alumni))
+	userIDs := make([]int, len(data.Alumni))
+	for i, a := range data.Alumni {
+		err := tx.QueryRow(`
+			INSERT INTO users (username, email, password_hash, role, must_change_password, is_active, created_at, updated_at)
+			VALUES ($1, $2, $3, 'user', FALSE, TRUE, NOW(), NOW())
+			RETURNING id
+		`, a.Username, a.Email, string(hash)).Scan(&userIDs[i])
+		if err != nil {
+			return summary, err
+		}
+
+		err = tx.QueryRow(`
+			INSERT INTO alumni (
+				user_id, nim, nama, jurusan, angkatan, tahun_lulus, email,
+				no_telepon, alamat, status_kematian, created_at, updated_at
+			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NOW(),NOW())
+			RETURNING id
+		`, userIDs[i], a.NIM, a.Nama, a.Jurusan, a.Angkatan, a.TahunLulus, a.Email,
+			a.NoTelepon, a.Alamat, a.StatusKematian).Scan(&alumniIDs[i])
+		if err != nil {
+			return summary, err
+		}
+
+		for _, p := range a.Pekerjaan {
+			_, err := tx.Exec(`
+				INSERT INTO pekerjaan_alumni (
+					alumni_id, nama_perusahaan, posisi_jabatan, bidang_industri, lokasi_kerja, gaji_range,
+					tanggal_mulai_kerja, tanggal_selesai_kerja, status_pekerjaan, deskripsi_pekerjaan,
+					created_at, updated_at
+				) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NOW(),NOW())
+			`, alumniIDs[i], p.NamaPerusahaan, p.PosisiJabatan, p.BidangIndustri, p.LokasiKerja,
+				p.GajiRange, p.TanggalMulaiKerja, p.TanggalSelesaiKerja, p.StatusPekerjaan, p.DeskripsiPekerjaan)
+			if err != nil {
+				return summary, err
+			}
+			summary.Pekerjaan++
+		}
+	}
+
+	for _, r := range data.Roster {
+		_, err := tx.Exec(`
+			INSERT INTO graduate_roster (nim, nama, jurusan, angkatan, tahun_lulus, imported_at)
+			VALUES ($1, $2, $3, $4, $5, NOW())
+			ON CONFLICT (nim) DO NOTHING
+		`, r.NIM, r.Nama, r.Jurusan, r.Angkatan, r.TahunLulus)
+		if err != nil {
+			return summary, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return summary, err
+	}
+	summary.Users = len(data.Alumni)
+	summary.Alumni = len(data.Alumni)
+	summary.Roster = len(data.Roster)
+
+	if mdb == nil {
+		summary.MongoSkipped = true
+		return summary, nil
+	}
+	if err := applyMongo(mdb, data, alumniIDs, userIDs, opts.UploadDir, &summary); err != nil {
+		return summary, err
+	}
+	return summary, nil
+}
+
+func applyMongo(mdb *mongo.Database, data Dataset, alumniIDs, userIDs []int, uploadDir string, summary *Summary) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	// created_at dibuat berurutan supaya cursor pagination punya urutan yang stabil
+	createdAt := time.Now().UTC().Truncate(time.Second)
+
+	var jobs, files []interface{}
+	for i, a := range data.Alumni {
+		for _, p := range a.Pekerjaan {
+			mulai := p.TanggalMulaiKerja
+			createdAt = createdAt.Add(time.Millisecond)
+			jobs = append(jobs, modelmongo.PekerjaanAlumni{
+				ID:                  primitive.NewObjectID(),
+				AlumniID:            alumniIDs[i],
+				NamaPerusahaan:      p.NamaPerusahaan,
+				PosisiJabatan:       p.PosisiJabatan,
+				BidangIndustri:      p.BidangIndustri,
+				LokasiKerja:         p.LokasiKerja,
+				GajiRange:           p.GajiRange,
+				TanggalMulaiKerja:   &mulai,
+				TanggalSelesaiKerja: p.TanggalSelesaiKerja,
+				StatusPekerjaan:     p.StatusPekerjaan,
+				DeskripsiPekerjaan:  p.DeskripsiPekerjaan,
+				CreatedAt:           createdAt,
+				UpdatedAt:           createdAt,
+			})
+		}
+
+		for _, f := range a.Files {
+			path := filepath.Join(uploadDir, f.Category, f.FileName)
+			if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+				return err
+			}
+			if err := os.WriteFile(path, f.Content, 0o644); err != nil {
+				return err
+			}
+			files = append(files, modelmongo.File{
+				UserID:       userIDs[i],
+				FileName:     f.FileName,
+				OriginalName: f.OriginalName,
+				FilePath:     path,
+				FileSize:     int64(len(f.Content)),
+				FileType:     f.ContentType,
+				FileCategory: f.Category,
+				UploadedAt:   createdAt,
+			})
+		}
+	}
+
+	if len(jobs) > 0 {
+		if _, err := mdb.Collection("pekerjaan_alumni").InsertMany(ctx, jobs); err != nil {
+			return err
+		}
+	}
+	if len(files) > 0 {
+		if _, err := mdb.Collection("files").InsertMany(ctx, files); err != nil {
+			return err
+		}
+	}
+	summary.MongoJobs = len(jobs)
+	summary.MongoFiles = len(files)
+	return nil
+}
@@ -0,0 +1,317 @@
+package seeder
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Dataset data demo hasil Generate. Seed yang sama selalu menghasilkan data yang sama.
+type Dataset struct {
+	Alumni []AlumniSeed
+	// Roster lulusan: semua alumni di atas + lulusan yang belum mendaftar (untuk demo registrasi)
+	Roster []RosterSeed
+}
+
+type AlumniSeed struct {
+	Username       string
+	NIM            string
+	Nama           string
+	Jurusan        string
+	Angkatan       int
+	TahunLulus     int
+	Email          string
+	NoTelepon      string
+	Alamat         string
+	StatusKematian bool
+	Pekerjaan      []PekerjaanSeed
+	Files          []FileSeed
+}
+
+type PekerjaanSeed struct {
+	NamaPerusahaan      string
+	PosisiJabatan       string
+	BidangIndustri      string
+	LokasiKerja         string
+	GajiRange           string
+	TanggalMulaiKerja   time.Time
+	TanggalSelesaiKerja *time.Time
+	StatusPekerjaan     string
+	DeskripsiPekerjaan  string
+}
+
+type FileSeed struct {
+	FileName     string
+	OriginalName string
+	Category     string // foto / sertifikat
+	ContentType  string
+	Content      []byte
+}
+
+type RosterSeed struct {
+	NIM        string
+	Nama       string
+	Jurusan    string
+	Angkatan   int
+	TahunLulus int
+}
+
+// referenceDate "hari ini" versi seeder, supaya tanggal pekerjaan tidak bergantung jam sistem
+var referenceDate = time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+var (
+	firstNames = []string{
+		"Budi", "Siti", "Agus", "Dewi", "Rizky", "Putri", "Andi", "Ayu", "Fajar", "Intan",
+		"Hendra", "Rina", "Dimas", "Nur", "Yoga", "Wulan", "Arief", "Sri", "Bayu", "Maya",
+		"Eko", "Lestari", "Gilang", "Ratna", "Teguh", "Anisa", "Reza", "Fitri", "Wahyu", "Indah",
+		"Galih", "Citra", "Ilham", "Dian", "Rudi", "Kartika", "Yusuf", "Melati", "Bagus", "Nadia",
+	}
+	lastNames = []string{
+		"Santoso", "Wijaya", "Saputra", "Lestari", "Pratama", "Hidayat", "Kusuma", "Nugroho", "Siregar", "Wibowo",
+		"Rahmawati", "Setiawan", "Halim", "Simanjuntak", "Gunawan", "Purnomo", "Maharani", "Sihombing", "Utami", "Firmansyah",
+		"Hasibuan", "Permana", "Suryadi", "Anggraini", "Harahap", "Susanto", "Ramadhan", "Nasution", "Wulandari", "Hakim",
+	}
+	majors = []struct {
+		Code int
+		Name string
+	}{
+		{11, "Teknik Informatika"}, {12, "Sistem Informasi"}, {13, "Teknik Elektro"}, {21, "Manajemen"},
+		{22, "Akuntansi"}, {31, "Ilmu Komunikasi"}, {14, "Teknik Sipil"}, {32, "Desain Komunikasi Visual"},
+	}
+	cities = []string{
+		"Jakarta", "Bandung", "Surabaya", "Yogyakarta", "Semarang", "Malang",
+		"Medan", "Makassar", "Denpasar", "Balikpapan", "Palembang", "Tangerang",
+	}
+	streets = []string{
+		"Jl. Merdeka", "Jl. Sudirman", "Jl. Diponegoro", "Jl. Gajah Mada", "Jl. Pahlawan",
+		"Jl. Ahmad Yani", "Jl. Kartini", "Jl. Cendana", "Jl. Melati", "Jl. Veteran",
+	}
+	industries = []struct {
+		Name      string
+		Companies []string
+		Positions []string
+	}{
+		{"Teknologi", []string{"PT Nusantara Digital Solusi", "PT Cakra Teknologi Indonesia", "PT Sinar Data Integra"},
+			[]string{"Backend Engineer", "Frontend Developer", "Data Analyst", "DevOps Engineer", "QA Engineer"}},
+		{"Perbankan", []string{"PT Bank Mitra Sejahtera", "PT Bank Arta Nusantara", "PT Bank Daerah Sentosa"},
+			[]string{"Analis Kredit", "Relationship Manager", "Auditor Internal", "Staf Operasional"}},
+		{"Manufaktur", []string{"PT Baja Perkasa Utama", "PT Sumber Makmur Plastindo", "PT Garuda Otomotif Parts"},
+			[]string{"Engineer Produksi", "Supervisor Quality Control", "Staf PPIC", "Maintenance Engineer"}},
+		{"Pendidikan", []string{"Yayasan Pendidikan Cendekia", "SMK Negeri 2", "Universitas Harapan Bangsa"},
+			[]string{"Guru", "Dosen", "Staf Akademik", "Laboran"}},
+		{"Kesehatan", []string{"RS Medika Utama", "PT Farmasi Sehat Sentosa", "Klinik Sehat Bersama"},
+			[]string{"Staf Administrasi", "Analis Sistem Informasi RS", "Staf Keuangan"}},
+		{"Pemerintahan", []string{"Dinas Komunikasi dan Informatika", "Badan Pusat Statistik", "Dinas Pekerjaan Umum"},
+			[]string{"Pranata Komputer", "Statistisi", "Analis Kebijakan", "Staf Perencanaan"}},
+		{"Retail", []string{"PT Serba Ada Nusantara", "PT Toko Makmur Jaya", "PT Pasar Digital Niaga"},
+			[]string{"Store Manager", "Merchandiser", "Staf Pemasaran Digital", "Analis Supply Chain"}},
+		{"Konstruksi", []string{"PT Wijaya Bangun Persada", "PT Karya Beton Mandiri"},
+			[]string{"Site Engineer", "Drafter", "Quantity Surveyor", "Project Manager"}},
+		{"Media", []string{"PT Kabar Nusantara Media", "PT Kreasi Visual Indonesia"},
+			[]string{"Content Writer", "Desainer Grafis", "Social Media Specialist", "Jurnalis"}},
+	}
+	salaryRanges = []string{"3-5 juta", "5-8 juta", "8-12 juta", "12-20 juta", "20-30 juta"}
+)
+
+// Generate buat data demo dari seed tetap. alumniCount = jumlah alumni terdaftar.
+func Generate(seed int64, alumniCount int) Dataset {
+	rng := rand.New(rand.NewSource(seed))
+	var data Dataset
+
+	usedNames := map[string]int{}
+	nextName := func() (string, string) {
+		for {
+			nama := firstNames[rng.Intn(len(firstNames))] + " " + lastNames[rng.Intn(len(lastNames))]
+			usedNames[nama]++
+			if usedNames[nama] == 1 {
+				return nama, slug(nama)
+			}
+			// Nama kembar tetap realistis, tapi username / email harus unik
+			if usedNames[nama] <= 3 {
+				return nama, fmt.Sprintf("%s%d", slug(nama), usedNames[nama])
+			}
+		}
+	}
+
+	seq := map[int]int{}
+	for i := 0; i < alumniCount+alumniCount/5; i++ {
+		major := majors[rng.Intn(len(majors))]
+		angkatan := 2012 + rng.Intn(9)
+		tahunLulus := angkatan + 4
+		if rng.Intn(10) < 3 {
+			tahunLulus++
+		}
+		seq[angkatan*100+major.Code]++
+		nim := fmt.Sprintf("%02d%02d%04d", angkatan%100, major.Code, seq[angkatan*100+major.Code])
+		nama, handle := nextName()
+
+		data.Roster = append(data.Roster, RosterSeed{
+			NIM: nim, Nama: nama, Jurusan: major.Name, Angkatan: angkatan, TahunLulus: tahunLulus,
+		})
+		// Sisanya (± 1/6 roster) sengaja belum terdaftar sebagai alumni
+		if i >= alumniCount {
+			continue
+		}
+
+		city := cities[rng.Intn(len(cities))]
+		a := AlumniSeed{
+			Username:       handle,
+			NIM:            nim,
+			Nama:           nama,
+			Jurusan:        major.Name,
+			Angkatan:       angkatan,
+			TahunLulus:     tahunLulus,
+			Email:          handle + "@alumni.example.ac.id",
+			NoTelepon:      fmt.Sprintf("08%d%09d", 11+rng.Intn(9), rng.Intn(1000000000)),
+			Alamat:         fmt.Sprintf("%s No. %d, %s", streets[rng.Intn(len(streets))], 1+rng.Intn(150), city),
+			StatusKematian: rng.Intn(50) == 0,
+		}
+		a.Pekerjaan = generateJobs(rng, tahunLulus, city)
+		a.Files = generateFiles(rng, a)
+		data.Alumni = append(data.Alumni, a)
+	}
+	return data
+}
+
+func generateJobs(rng *rand.Rand, tahunLulus int, homeCity string) []PekerjaanSeed {
+	count := rng.Intn(4) // 0-3 riwayat pekerjaan
+	start := time.Date(tahunLulus, time.Month(7+rng.Intn(6)), 1, 0, 0, 0, 0, time.UTC)
+
+	var jobs []PekerjaanSeed
+	for j := 0; j < count && start.Before(referenceDate); j++ {
+		industry := industries[rng.Intn(len(industries))]
+		position := industry.Positions[rng.Intn(len(industry.Positions))]
+		company := industry.Companies[rng.Intn(len(industry.Companies))]
+		city := homeCity
+		if rng.Intn(3) == 0 {
+			city = cities[rng.Intn(len(cities))]
+		}
+
+		salary := j + rng.Intn(2)
+		if salary >= len(salaryRanges) {
+			salary = len(salaryRanges) - 1
+		}
+
+		job := PekerjaanSeed{
+			NamaPerusahaan:    company,
+			PosisiJabatan:     position,
+			BidangIndustri:    industry.Name,
+			LokasiKerja:       city,
+			GajiRange:         salaryRanges[salary],
+			TanggalMulaiKerja: start,
+			StatusPekerjaan:   "aktif",
+			DeskripsiPekerjaan: fmt.Sprintf("Bekerja sebagai %s di %s, bidang %s, berkantor di %s.",
+				strings.ToLower(position), company, strings.ToLower(industry.Name), city),
+		}
+
+		end := start.AddDate(0, 12+rng.Intn(37), 0)
+		last := j == count-1
+		if !end.Before(referenceDate) || (last && rng.Intn(2) == 0) {
+			// Pekerjaan saat ini
+			jobs = append(jobs, job)
+			break
+		}
+		job.TanggalSelesaiKerja = &end
+		job.StatusPekerjaan = "selesai"
+		if rng.Intn(4) == 0 {
+			job.StatusPekerjaan = "resign"
+		}
+		jobs = append(jobs, job)
+		start = end.AddDate(0, rng.Intn(4), 0)
+	}
+	return jobs
+}
+
+func generateFiles(rng *rand.Rand, a AlumniSeed) []FileSeed {
+	var files []FileSeed
+	if rng.Intn(10) < 6 {
+		files = append(files, FileSeed{
+			FileName:     newUUID(rng) + ".png",
+			OriginalName: "foto-" + a.NIM + ".png",
+			Category:     "foto",
+			ContentType:  "image/png",
+			Content:      avatarPNG(rng),
+		})
+	}
+	if rng.Intn(10) < 4 {
+		files = append(files, FileSeed{
+			FileName:     newUUID(rng) + ".pdf",
+			OriginalName: "sertifikat-" + a.NIM + ".pdf",
+			Category:     "sertifikat",
+			ContentType:  "application/pdf",
+			Content:      certificatePDF(a),
+		})
+	}
+	return files
+}
+
+// newUUID UUID v4 dari rng seeder (bukan crypto/rand) supaya nama file ikut deterministik
+func newUUID(rng *rand.Rand) string {
+	id, err := uuid.NewRandomFromReader(rng)
+	if err != nil {
+		panic(err)
+	}
+	return id.String()
+}
+
+// avatarPNG foto profil 64x64 berwarna polos dengan inisial berupa kotak
+func avatarPNG(rng *rand.Rand) []byte {
+	bg := color.RGBA{uint8(80 + rng.Intn(150)), uint8(80 + rng.Intn(150)), uint8(80 + rng.Intn(150)), 255}
+	img := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 64; x++ {
+			c := bg
+			if x >= 22 && x < 42 && y >= 14 && y < 34 || x >= 12 && x < 52 && y >= 40 {
+				c = color.RGBA{245, 245, 245, 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	png.Encode(&buf, img)
+	return buf.Bytes()
+}
+
+// certificatePDF sertifikat satu halaman (teks ASCII, tanpa timestamp supaya deterministik)
+func certificatePDF(a AlumniSeed) []byte {
+	lines := []string{
+		"BT /F1 28 Tf 170 470 Td (SERTIFIKAT KELULUSAN) Tj ET",
+		fmt.Sprintf("BT /F1 20 Tf 170 400 Td (%s) Tj ET", a.Nama),
+		fmt.Sprintf("BT /F1 14 Tf 170 360 Td (NIM %s - %s) Tj ET", a.NIM, a.Jurusan),
+		fmt.Sprintf("BT /F1 14 Tf 170 335 Td (Lulus tahun %d) Tj ET", a.TahunLulus),
+	}
+	stream := strings.Join(lines, "\n")
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 842 595] /Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(stream), stream),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func slug(nama string) string {
+	return strings.ReplaceAll(strings.ToLower(nama), " ", ".")
+}
@@ -19,6 +19,26 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Daftar kunci publik untuk memverifikasi access token (RS256/EdDSA). Aplikasi lain cukup mengambil kunci dari sini berdasarkan header ` + "`" + `kid` + "`" + `, tanpa perlu secret.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "JSON Web Key Set",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.JWKSet"
+                        }
+                    }
+                }
+            }
+        },
         "/api/alumni": {
             "get": {
                 "security": [
@@ -45,19 +65,13 @@ const docTemplate = `{
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Terjadi kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
@@ -101,59 +115,58 @@ const docTemplate = `{
                     "400": {
                         "description": "Body request tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Gagal menyimpan ke database",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/alumni/pagination": {
+        "/api/alumni/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengambil daftar alumni dengan fitur pencarian, sorting, dan pagination (hanya bisa diakses user yang login)",
+                "description": "Mengunduh data alumni dalam format CSV, XLSX, atau PDF. Filter, pencarian, dan sort sama dengan /api/alumni/list (lihat parameter di sana); data dikirim secara streaming.",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "application/pdf"
                 ],
                 "tags": [
                     "Alumni"
                 ],
-                "summary": "Ambil data alumni dengan pagination dan pencarian",
+                "summary": "Export data alumni",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Nomor halaman (default 1)",
-                        "name": "page",
+                        "type": "string",
+                        "description": "csv (default) / xlsx / pdf",
+                        "name": "format",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Jumlah data per halaman (default 10)",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "Multi kolom, mis. -angkatan,nama",
+                        "name": "sort",
                         "in": "query"
                     },
                     {
@@ -177,444 +190,511 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Data alumni dengan pagination",
+                        "description": "File export",
                         "schema": {
-                            "$ref": "#/definitions/model.AlumniResponse"
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Gagal mengambil data",
+                    "403": {
+                        "description": "Akses ditolak",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/alumni/{id}": {
-            "get": {
+        "/api/alumni/import": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengambil data alumni tertentu berdasarkan ID-nya (hanya bisa diakses user yang login)",
+                "description": "Import massal alumni. Alumni dengan NIM yang sudah ada diperbarui, NIM baru dibuat beserta akun user-nya. Import bersifat all-or-nothing: jika ada baris tidak valid, tidak ada data yang disimpan. Gunakan dry_run=true untuk memvalidasi tanpa menyimpan. Laporan error bisa diunduh lewat report_id.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Alumni"
                 ],
-                "summary": "Ambil data alumni berdasarkan ID",
+                "summary": "Import alumni dari CSV / XLSX",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID Alumni",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "File .csv atau .xlsx (baris pertama = header)",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pemetaan kolom dalam JSON, mis. {\\",
+                        "name": "mapping",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Hanya validasi, tidak menyimpan",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil data alumni",
+                        "description": "Hasil import / dry-run",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/model.AlumniImportResult"
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "File atau mapping tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Alumni tidak ditemukan",
+                    "403": {
+                        "description": "Akses ditolak",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Ada baris tidak valid, tidak ada data yang disimpan (details = model.AlumniImportResult)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/alumni/import/reports/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Memperbarui data alumni berdasarkan ID (hanya bisa diakses user yang login)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Mengunduh laporan error import alumni dalam format CSV.",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
                     "Alumni"
                 ],
-                "summary": "Update data alumni",
+                "summary": "Unduh laporan error import alumni",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID Alumni",
+                        "type": "string",
+                        "description": "ID laporan (report_id dari hasil import)",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Data alumni yang diperbarui",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateAlumniRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Data alumni diperbarui",
+                        "description": "Laporan error (CSV)",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "file"
                         }
                     },
-                    "400": {
-                        "description": "Body atau ID tidak valid",
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Token tidak valid atau tidak ditemukan",
+                    "403": {
+                        "description": "Akses ditolak",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Gagal memperbarui data",
+                    "404": {
+                        "description": "Laporan tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/alumni/pagination": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menghapus data alumni berdasarkan ID (hanya bisa diakses user yang login)",
+                "description": "Mengambil daftar alumni dengan fitur pencarian, sorting, dan pagination (hanya bisa diakses user yang login). Mendukung pagination offset (page) atau cursor (pagination=cursor / cursor).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Alumni"
                 ],
-                "summary": "Hapus data alumni",
+                "summary": "Ambil data alumni dengan pagination dan pencarian",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID Alumni",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Nomor halaman (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 10, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cursor = pakai cursor pagination (meta berisi next_cursor / prev_cursor, tanpa total)",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor / prev_cursor dari respons sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Multi kolom, mis. -angkatan,nama (prefix - = desc). Kolom: id, nim, nama, jurusan, angkatan, tahun_lulus, email, status_kematian, created_at, updated_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Kolom pengurutan tunggal (default id)",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan (asc/desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Kata kunci pencarian",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter jurusan",
+                        "name": "jurusan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Angkatan minimal",
+                        "name": "angkatan_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Angkatan maksimal",
+                        "name": "angkatan_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tahun lulus minimal",
+                        "name": "tahun_lulus_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tahun lulus maksimal",
+                        "name": "tahun_lulus_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter status kematian",
+                        "name": "status_kematian",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Dibuat sejak (YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Dibuat sampai (YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Alumni berhasil dihapus",
+                        "description": "Data alumni dengan pagination",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.AlumniResponse"
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Parameter sort / filter tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal menghapus data alumni",
+                        "description": "Gagal mengambil data",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/alumni/{id}/kematian": {
-            "put": {
+        "/api/alumni/trashed": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengubah status kematian alumni (hidup/wafat) (hanya bisa diakses user yang login)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Menampilkan alumni yang sudah di-soft delete, terakhir dihapus dulu",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Alumni"
                 ],
-                "summary": "Update status kematian alumni",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID Alumni",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Status kematian alumni",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateStatusKematianRequest"
-                        }
-                    }
-                ],
+                "summary": "Ambil alumni di trash",
                 "responses": {
                     "200": {
-                        "description": "Status kematian diperbarui",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Body atau ID tidak valid",
+                        "description": "Berhasil mengambil data trash alumni",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "additionalProperties": true
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal memperbarui status kematian",
+                        "description": "Gagal mengambil data",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/files": {
+        "/api/alumni/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menampilkan semua file milik user login. Jika user adalah admin, maka akan menampilkan semua file dari seluruh user.",
+                "description": "Mengambil data alumni tertentu berdasarkan ID-nya (hanya bisa diakses user yang login)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "File"
+                    "Alumni"
+                ],
+                "summary": "Ambil data alumni berdasarkan ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Alumni",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Ambil semua file",
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil daftar file",
+                        "description": "Berhasil mengambil data alumni",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versi data, kirim balik lewat If-Match saat update"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Kesalahan server",
+                    "404": {
+                        "description": "Alumni tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/files/upload": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengunggah file (foto atau sertifikat) ke server dan menyimpannya ke MongoDB. Hanya bisa diakses user yang login. Admin dapat mengupload file untuk user lain dengan menambahkan form field ` + "`" + `user_id` + "`" + `.",
+                "description": "Memperbarui seluruh data alumni berdasarkan ID (hanya bisa diakses user yang login). Kirim If-Match berisi ETag terakhir supaya perubahan orang lain tidak tertimpa.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "File"
+                    "Alumni"
                 ],
-                "summary": "Upload file (foto atau sertifikat)",
+                "summary": "Update data alumni",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "File yang akan diupload (foto atau sertifikat)",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "ID Alumni",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Kategori file (foto / sertifikat)",
-                        "name": "category",
-                        "in": "formData"
+                        "description": "ETag dari respon GET / PUT / PATCH sebelumnya",
+                        "name": "If-Match",
+                        "in": "header"
                     },
                     {
-                        "type": "integer",
-                        "description": "Hanya admin: ID user lain yang ingin diuploadkan file",
-                        "name": "user_id",
-                        "in": "formData"
+                        "description": "Data alumni yang diperbarui",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateAlumniRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "File berhasil diupload",
+                        "description": "Data alumni diperbarui",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versi data terbaru"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Request tidak valid",
+                        "description": "Body, ID, atau If-Match tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Alumni tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Data sudah diubah pengguna lain (If-Match tidak cocok)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Kesalahan server",
+                        "description": "Gagal memperbarui data",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/files/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengambil file berdasarkan ID. User hanya bisa mengakses file miliknya sendiri, kecuali admin yang bisa mengakses semua file.",
+                "description": "Sama dengan PUT /api/alumni/{id}/soft-delete: alumni dipindah ke trash dan bisa di-restore. Hapus permanen lewat DELETE /api/alumni/{id}/hard-delete.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "File"
+                    "Alumni"
                 ],
-                "summary": "Ambil file berdasarkan ID",
+                "summary": "Hapus data alumni (pindah ke trash)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID File (ObjectID MongoDB)",
+                        "type": "integer",
+                        "description": "ID Alumni",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -622,173 +702,161 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil data file",
+                        "description": "Alumni dipindahkan ke trash",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
                         "description": "ID tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "403": {
-                        "description": "Akses ditolak",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "File tidak ditemukan",
+                        "description": "Alumni tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Kesalahan server",
+                        "description": "Gagal menghapus data alumni",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menghapus file berdasarkan ID. User hanya bisa menghapus file miliknya sendiri, admin dapat menghapus semua file.",
+                "description": "Mengubah sebagian data alumni dengan JSON Merge Patch (RFC 7386): field yang tidak dikirim tetap, null mengosongkan field. Hasil akhirnya divalidasi seperti PUT. Tanpa If-Match, patch diterapkan pada versi yang baru dibaca dan tetap ditolak (412) kalau data berubah di tengah jalan.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "File"
+                    "Alumni"
                 ],
-                "summary": "Hapus file",
+                "summary": "Update sebagian data alumni",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID File (ObjectID MongoDB)",
+                        "type": "integer",
+                        "description": "ID Alumni",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag dari respon GET / PUT / PATCH sebelumnya",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Field alumni yang diubah (application/merge-patch+json atau application/json)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateAlumniRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "File berhasil dihapus",
+                        "description": "Data alumni diperbarui",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "additionalProperties": true
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versi data terbaru"
                             }
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Body, ID, atau If-Match tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Tidak boleh hapus file milik user lain",
+                    "404": {
+                        "description": "Alumni tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "File tidak ditemukan",
+                    "412": {
+                        "description": "Data sudah diubah pengguna lain (If-Match tidak cocok)",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Gagal menghapus file",
+                    "422": {
+                        "description": "Data tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal memperbarui data",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/login": {
-            "post": {
-                "description": "Autentikasi user menggunakan username/email dan password, kemudian menghasilkan JWT token",
-                "consumes": [
-                    "application/json"
+        "/api/alumni/{id}/hard-delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Menghapus permanen alumni yang ada di trash beserta akun user, pekerjaan, dan file miliknya. Akun dengan role selain user (mis. admin atau staf) yang ditautkan ke alumni beserta file-nya tidak ikut dihapus.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Alumni"
                 ],
-                "summary": "Login user",
+                "summary": "Hard delete alumni",
                 "parameters": [
                     {
-                        "description": "Data login (username dan password)",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.LoginRequest"
-                        }
+                        "type": "integer",
+                        "description": "ID Alumni",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.LoginResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Request tidak valid",
+                        "description": "Alumni dihapus permanen",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -796,77 +864,41 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "401": {
-                        "description": "Username atau password salah",
+                    "400": {
+                        "description": "ID tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Kesalahan server",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/pekerjaan": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Mengambil semua data pekerjaan dari database (hanya bisa diakses user yang login)",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Pekerjaan"
-                ],
-                "summary": "Ambil semua data pekerjaan",
-                "responses": {
-                    "200": {
-                        "description": "Berhasil mengambil data pekerjaan",
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Token tidak valid atau tidak ditemukan",
+                    "404": {
+                        "description": "Alumni tidak ada di trash",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Terjadi kesalahan server",
+                        "description": "Gagal melakukan hard delete",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/alumni/{id}/kematian": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menambahkan data pekerjaan baru untuk alumni (hanya bisa diakses user yang login)",
+                "description": "Mengubah status kematian alumni (hidup/wafat) (hanya bisa diakses user yang login)",
                 "consumes": [
                     "application/json"
                 ],
@@ -874,194 +906,204 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
+                    "Alumni"
                 ],
-                "summary": "Tambah pekerjaan baru",
+                "summary": "Update status kematian alumni",
                 "parameters": [
                     {
-                        "description": "Data pekerjaan baru",
+                        "type": "integer",
+                        "description": "ID Alumni",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status kematian alumni",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreatePekerjaanRequest"
+                            "$ref": "#/definitions/model.UpdateStatusKematianRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Pekerjaan berhasil dibuat",
+                    "200": {
+                        "description": "Status kematian diperbarui",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Body request tidak valid",
+                        "description": "Body atau ID tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Alumni tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal menyimpan data pekerjaan",
+                        "description": "Gagal memperbarui status kematian",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan-mongo": {
-            "get": {
+        "/api/alumni/{id}/restore": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengambil semua data pekerjaan dari MongoDB. Hanya bisa diakses oleh user yang login.",
+                "description": "Mengembalikan alumni dari trash beserta pekerjaan dan file yang ikut di-trash bersamanya. Pekerjaan yang sudah di-trash sendiri sebelumnya tetap di trash.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan Mongo"
+                    "Alumni"
+                ],
+                "summary": "Restore alumni",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Alumni",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Ambil semua data pekerjaan (MongoDB)",
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil semua data pekerjaan",
+                        "description": "Alumni berhasil di-restore",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Alumni tidak ada di trash",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal mengambil data",
+                        "description": "Gagal melakukan restore",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/alumni/{id}/soft-delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menambahkan data pekerjaan baru ke MongoDB. Hanya bisa diakses user yang login.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Memindahkan alumni ke trash. Alumni di trash tidak muncul di daftar, pencarian, dan export; pekerjaan (PostgreSQL dan MongoDB) dan file miliknya ikut di-trash. File milik akun dengan role selain user (mis. admin atau staf) yang ditautkan tidak ikut di-trash.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan Mongo"
+                    "Alumni"
                 ],
-                "summary": "Tambah data pekerjaan (MongoDB)",
+                "summary": "Soft delete alumni",
                 "parameters": [
                     {
-                        "description": "Data pekerjaan baru",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/modelmongo.CreatePekerjaanRequest"
-                        }
+                        "type": "integer",
+                        "description": "ID Alumni",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Data pekerjaan berhasil ditambahkan",
+                    "200": {
+                        "description": "Alumni dipindahkan ke trash",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Body request tidak valid",
+                        "description": "ID tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Alumni tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal menyimpan data",
+                        "description": "Gagal melakukan soft delete",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan-mongo/alumni/{alumni_id}": {
+        "/api/files": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengambil semua pekerjaan milik alumni tertentu dari MongoDB. Hanya bisa diakses user yang login.",
+                "description": "Menampilkan semua file milik user login. User dengan permission ` + "`" + `files:read_all` + "`" + ` melihat semua file dari seluruh user.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan Mongo"
-                ],
-                "summary": "Ambil pekerjaan berdasarkan ID alumni (MongoDB)",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "ID Alumni",
-                        "name": "alumni_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "File"
                 ],
+                "summary": "Ambil semua file",
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil data pekerjaan",
+                        "description": "Berhasil mengambil daftar file",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1070,162 +1112,146 @@ const docTemplate = `{
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan-mongo/{id}": {
-            "get": {
+        "/api/files/upload": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengambil satu data pekerjaan berdasarkan ID di MongoDB. Hanya bisa diakses user login.",
+                "description": "Mengunggah file (foto atau sertifikat) ke server dan menyimpannya ke MongoDB. Hanya bisa diakses user yang login. User dengan permission ` + "`" + `files:manage_all` + "`" + ` dapat mengupload file untuk user lain dengan menambahkan form field ` + "`" + `user_id` + "`" + `.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan Mongo"
+                    "File"
                 ],
-                "summary": "Ambil pekerjaan berdasarkan ID (MongoDB)",
+                "summary": "Upload file (foto atau sertifikat)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID Pekerjaan (ObjectID MongoDB)",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "File yang akan diupload (foto atau sertifikat)",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Kategori file (foto / sertifikat)",
+                        "name": "category",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Hanya dengan permission files:manage_all: ID user lain yang ingin diuploadkan file",
+                        "name": "user_id",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil data pekerjaan",
+                        "description": "File berhasil diupload",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Request tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Data tidak ditemukan",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/files/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Memperbarui data pekerjaan berdasarkan ID di MongoDB. Hanya bisa diakses user login.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Mengambil file berdasarkan ID. User hanya bisa mengakses file miliknya sendiri, kecuali user dengan permission ` + "`" + `files:read_all` + "`" + `.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan Mongo"
+                    "File"
                 ],
-                "summary": "Update data pekerjaan (MongoDB)",
+                "summary": "Ambil file berdasarkan ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID Pekerjaan (ObjectID MongoDB)",
+                        "description": "ID File (ObjectID MongoDB)",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Data pekerjaan yang akan diperbarui",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/modelmongo.UpdatePekerjaanRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Data pekerjaan berhasil diupdate",
+                        "description": "Berhasil mengambil data file",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Request tidak valid",
+                        "description": "ID tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal memperbarui data",
+                        "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
@@ -1236,18 +1262,18 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menghapus data pekerjaan secara permanen dari MongoDB. Hanya bisa diakses user login.",
+                "description": "Menghapus file berdasarkan ID. User hanya bisa menghapus file miliknya sendiri, user dengan permission ` + "`" + `files:manage_all` + "`" + ` dapat menghapus semua file.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan Mongo"
+                    "File"
                 ],
-                "summary": "Hapus data pekerjaan (MongoDB)",
+                "summary": "Hapus file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID Pekerjaan (ObjectID MongoDB)",
+                        "description": "ID File (ObjectID MongoDB)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1255,7 +1281,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Data pekerjaan berhasil dihapus",
+                        "description": "File berhasil dihapus",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1266,189 +1292,176 @@ const docTemplate = `{
                     "400": {
                         "description": "ID tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tidak boleh hapus file milik user lain",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal menghapus data",
+                        "description": "Gagal menghapus file",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan/alumni/{alumni_id}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/api/login": {
+            "post": {
+                "description": "Autentikasi user menggunakan username/email dan password, kemudian menghasilkan access token (JWT berumur pendek) dan refresh token. Jika 2FA aktif, yang dikembalikan adalah mfa_token untuk dilanjutkan ke /api/login/mfa.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Mengambil semua data pekerjaan milik alumni tertentu (hanya bisa diakses user yang login)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
+                    "Auth"
                 ],
-                "summary": "Ambil pekerjaan berdasarkan ID Alumni",
+                "summary": "Login user",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID Alumni",
-                        "name": "alumni_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Data login (username dan password)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil data pekerjaan alumni",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/model.LoginResponse"
                         }
                     },
                     "400": {
-                        "description": "ID alumni tidak valid",
+                        "description": "Request tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Token tidak valid atau tidak ditemukan",
+                        "description": "Username atau password salah",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun dinonaktifkan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Terlalu banyak percobaan login gagal (lihat header Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan/list": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/api/login/mfa": {
+            "post": {
+                "description": "Menukar mfa_token dari /api/login dengan access token dan refresh token, memakai kode TOTP atau salah satu recovery code. mfa_token hanya bisa dipakai sekali, termasuk kalau kodenya salah.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Mengambil semua data pekerjaan dengan fitur pencarian, sorting, dan pagination (hanya bisa diakses user yang login)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
+                    "Auth"
                 ],
-                "summary": "Ambil semua pekerjaan dengan pagination",
+                "summary": "Login langkah kedua (2FA)",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Nomor halaman (default 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Jumlah data per halaman (default 10)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Kolom pengurutan (default created_at)",
-                        "name": "sortBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Urutan (asc/desc)",
-                        "name": "order",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Kata kunci pencarian",
-                        "name": "search",
-                        "in": "query"
+                        "description": "mfa_token dan kode TOTP / recovery code",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MFALoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil data pekerjaan",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/model.LoginResponse"
                         }
                     },
-                    "401": {
-                        "description": "Token tidak valid atau tidak ditemukan",
+                    "400": {
+                        "description": "Request tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token MFA tidak valid, sudah dipakai, atau kode salah",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Terlalu banyak percobaan gagal",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan/trashed": {
-            "get": {
+        "/api/logout": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menampilkan daftar pekerjaan yang sudah di-soft delete (hanya bisa diakses user yang login)",
+                "description": "Mencabut sesi yang sedang dipakai. Access token dan refresh token dari sesi ini tidak bisa dipakai lagi.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
+                    "Auth"
                 ],
-                "summary": "Ambil data pekerjaan yang dihapus (trashed)",
+                "summary": "Logout",
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil data pekerjaan terhapus",
+                        "description": "Logout berhasil",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1457,92 +1470,111 @@ const docTemplate = `{
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal mengambil data pekerjaan terhapus",
+                        "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan/{id}": {
-            "get": {
+        "/api/logout-all": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengambil detail pekerjaan berdasarkan ID (hanya bisa diakses user yang login)",
+                "description": "Mencabut semua sesi milik user yang sedang login, termasuk sesi saat ini.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
-                ],
-                "summary": "Ambil pekerjaan berdasarkan ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID Pekerjaan",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Auth"
                 ],
+                "summary": "Logout dari semua perangkat",
                 "responses": {
                     "200": {
-                        "description": "Data pekerjaan ditemukan",
+                        "description": "Semua sesi berhasil dicabut",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "ID tidak valid",
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/me/alumni": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil data alumni yang terhubung dengan akun yang sedang login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Me"
+                ],
+                "summary": "Ambil data alumni sendiri",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Alumni"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Data pekerjaan tidak ditemukan",
+                        "description": "Alumni tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             },
-            "put": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Memperbarui data pekerjaan berdasarkan ID (hanya bisa diakses user yang login)",
+                "description": "Alumni mengubah email, no_telepon, dan alamat miliknya sendiri. Field lain (nim, nama, angkatan, status_kematian, dll) hanya bisa diubah admin dan akan ditolak.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1550,206 +1582,189 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
+                    "Me"
                 ],
-                "summary": "Update data pekerjaan",
+                "summary": "Ubah kontak alumni sendiri",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID Pekerjaan",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Data pekerjaan yang diperbarui",
+                        "description": "Data kontak yang diubah",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdatePekerjaanRequest"
+                            "$ref": "#/definitions/model.UpdateMyAlumniRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Pekerjaan berhasil diperbarui",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/model.Alumni"
                         }
                     },
                     "400": {
-                        "description": "Body atau ID tidak valid",
+                        "description": "Request tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Field hanya bisa diubah admin / akun belum terhubung",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal memperbarui data pekerjaan",
+                        "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/me/pekerjaan": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menghapus data pekerjaan secara permanen (hanya bisa diakses user yang login)",
+                "description": "Menampilkan semua pekerjaan milik alumni yang sedang login.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
-                ],
-                "summary": "Hapus pekerjaan",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID Pekerjaan",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Me"
                 ],
+                "summary": "Ambil riwayat pekerjaan sendiri",
                 "responses": {
                     "200": {
-                        "description": "Pekerjaan berhasil dihapus",
+                        "description": "Berhasil mengambil data pekerjaan",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "ID tidak valid",
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Token tidak valid atau tidak ditemukan",
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal menghapus pekerjaan",
+                        "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/pekerjaan/{id}/hard-delete": {
-            "delete": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menghapus data pekerjaan secara permanen dari database (hanya bisa diakses user yang login)",
+                "description": "Alumni menambahkan riwayat pekerjaan miliknya sendiri. alumni_id diambil dari akun yang login.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
+                    "Me"
                 ],
-                "summary": "Hard delete pekerjaan",
+                "summary": "Tambah pekerjaan sendiri",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID Pekerjaan",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Data pekerjaan",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MyPekerjaanRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Hard delete pekerjaan berhasil",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.PekerjaanAlumni"
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Request tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal melakukan hard delete",
+                        "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan/{id}/restore": {
+        "/api/me/pekerjaan/{id}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengembalikan data pekerjaan yang sudah di-soft delete (hanya bisa diakses user yang login)",
+                "description": "Alumni mengubah riwayat pekerjaan miliknya sendiri. Pekerjaan milik alumni lain atau yang sudah di-soft delete tidak bisa diubah.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
+                    "Me"
                 ],
-                "summary": "Restore pekerjaan",
+                "summary": "Ubah pekerjaan sendiri",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1757,130 +1772,138 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Data pekerjaan",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MyPekerjaanRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Restore pekerjaan berhasil",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.PekerjaanAlumni"
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Request tidak valid",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data tidak ditemukan atau bukan milik alumni ini",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal melakukan restore",
+                        "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/pekerjaan/{id}/soft-delete": {
-            "put": {
+        "/api/mfa/recovery-codes": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Menandai data pekerjaan sebagai dihapus (tidak benar-benar dihapus dari database, hanya untuk user login)",
+                "description": "Mengganti semua recovery code dengan yang baru (yang lama tidak berlaku). Butuh konfirmasi kode TOTP.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pekerjaan"
+                    "MFA"
                 ],
-                "summary": "Soft delete pekerjaan",
+                "summary": "Buat ulang recovery code",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID Pekerjaan",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Kode TOTP 6 digit",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.TOTPCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Soft delete pekerjaan berhasil",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.TOTPVerifyResponse"
                         }
                     },
                     "400": {
-                        "description": "ID tidak valid",
+                        "description": "Kode salah atau 2FA belum aktif",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Gagal melakukan soft delete",
+                        "description": "Kesalahan server",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/profile": {
+        "/api/mfa/status": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mengambil profil user yang sedang login berdasarkan token JWT",
+                "description": "Menampilkan apakah 2FA (TOTP) aktif untuk user yang sedang login, sisa recovery code, dan apakah 2FA diwajibkan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "MFA"
                 ],
-                "summary": "Ambil profil user",
+                "summary": "Status 2FA",
                 "responses": {
                     "200": {
-                        "description": "Berhasil mengambil profil",
+                        "description": "Status 2FA",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1888,69 +1911,3889 @@ const docTemplate = `{
                     },
                     "401": {
                         "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mfa/totp/disable": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menonaktifkan 2FA dengan konfirmasi kode TOTP. Tidak diizinkan untuk admin jika 2FA diwajibkan oleh kebijakan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MFA"
+                ],
+                "summary": "Nonaktifkan 2FA",
+                "parameters": [
+                    {
+                        "description": "Kode TOTP 6 digit",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.TOTPCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "2FA dinonaktifkan",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Kode salah atau 2FA belum aktif",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "2FA wajib untuk admin",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "model.Alumni": {
-            "type": "object",
-            "properties": {
-                "alamat": {
-                    "type": "string"
-                },
-                "angkatan": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "jurusan": {
-                    "type": "string"
-                },
-                "nama": {
-                    "type": "string"
-                },
-                "nim": {
-                    "type": "string"
-                },
-                "no_telepon": {
-                    "type": "string"
-                },
-                "status_kematian": {
-                    "type": "boolean"
+        },
+        "/api/mfa/totp/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Membuat secret TOTP baru dan provisioning URI (otpauth://) untuk ditampilkan sebagai QR code di aplikasi authenticator. 2FA baru aktif setelah kode diverifikasi lewat /api/mfa/totp/verify.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MFA"
+                ],
+                "summary": "Mulai enrollment 2FA (TOTP)",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.TOTPEnrollResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "2FA sudah aktif",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mfa/totp/verify": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengaktifkan 2FA setelah kode TOTP pertama benar. Recovery code dikembalikan sekali ini saja, beserta token baru untuk sesi ini.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MFA"
+                ],
+                "summary": "Verifikasi \u0026 aktifkan 2FA",
+                "parameters": [
+                    {
+                        "description": "Kode TOTP 6 digit",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.TOTPCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.TOTPVerifyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Kode salah atau enrollment belum dimulai",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "2FA sudah aktif",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/password/reset": {
+            "post": {
+                "description": "Membuat password baru memakai token sekali pakai dari email reset password. Semua sesi user dicabut setelah berhasil.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Reset password dengan token",
+                "parameters": [
+                    {
+                        "description": "Token reset dan password baru",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password berhasil direset",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Token tidak valid, kadaluarsa, atau password tidak memenuhi syarat",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun dinonaktifkan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil semua data pekerjaan dari database (hanya bisa diakses user yang login)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Ambil semua data pekerjaan",
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil data pekerjaan",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Terjadi kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menambahkan data pekerjaan baru untuk alumni (hanya bisa diakses user yang login)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Tambah pekerjaan baru",
+                "parameters": [
+                    {
+                        "description": "Data pekerjaan baru",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatePekerjaanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Pekerjaan berhasil dibuat",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Body request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal menyimpan data pekerjaan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan-mongo": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil data pekerjaan dari MongoDB. Tanpa parameter, semua data dikembalikan; dengan limit / cursor / pagination=cursor, data dikembalikan per halaman (cursor pagination, urut created_at). Hanya bisa diakses oleh user yang login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Ambil semua data pekerjaan (MongoDB)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "cursor = pakai cursor pagination",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 10, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor / prev_cursor dari respons sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan created_at (asc/desc, default desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil semua data pekerjaan",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter pagination tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal mengambil data",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menambahkan data pekerjaan baru ke MongoDB. Hanya bisa diakses user yang login.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Tambah data pekerjaan (MongoDB)",
+                "parameters": [
+                    {
+                        "description": "Data pekerjaan baru",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelmongo.CreatePekerjaanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Data pekerjaan berhasil ditambahkan",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Body request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal menyimpan data",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan-mongo/alumni/{alumni_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil semua pekerjaan milik alumni tertentu dari MongoDB. Hanya bisa diakses user yang login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Ambil pekerjaan berdasarkan ID alumni (MongoDB)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Alumni",
+                        "name": "alumni_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil data pekerjaan",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan-mongo/trashed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menampilkan pekerjaan yang sudah di-soft delete milik alumni yang login, atau milik semua alumni untuk permission pekerjaan:manage_all.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Ambil pekerjaan yang dihapus (MongoDB)",
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil data pekerjaan terhapus",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan-mongo/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil satu data pekerjaan berdasarkan ID di MongoDB. Hanya bisa diakses user login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Ambil pekerjaan berdasarkan ID (MongoDB)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Pekerjaan (ObjectID MongoDB)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil data pekerjaan",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Memperbarui data pekerjaan berdasarkan ID di MongoDB. Hanya bisa diakses user login.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Update data pekerjaan (MongoDB)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Pekerjaan (ObjectID MongoDB)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data pekerjaan yang akan diperbarui",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelmongo.UpdatePekerjaanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data pekerjaan berhasil diupdate",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal memperbarui data",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menghapus data pekerjaan secara permanen dari MongoDB. Hanya bisa diakses user login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Hapus data pekerjaan (MongoDB)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Pekerjaan (ObjectID MongoDB)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data pekerjaan berhasil dihapus",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal menghapus data",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan-mongo/{id}/hard-delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menghapus permanen pekerjaan yang sudah di-soft delete. Alumni hanya bisa menghapus pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:hard_delete.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Hard delete pekerjaan dari trash (MongoDB)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Pekerjaan (ObjectID MongoDB)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hard delete pekerjaan berhasil",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Pekerjaan belum di-soft delete",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan-mongo/{id}/restore": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengembalikan pekerjaan yang sudah di-soft delete. Alumni hanya bisa me-restore pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:manage_all.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Restore pekerjaan (MongoDB)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Pekerjaan (ObjectID MongoDB)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restore pekerjaan berhasil",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan-mongo/{id}/soft-delete": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menandai pekerjaan sebagai terhapus. Alumni hanya bisa menghapus pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:manage_all.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan Mongo"
+                ],
+                "summary": "Soft delete pekerjaan (MongoDB)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Pekerjaan (ObjectID MongoDB)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Soft delete pekerjaan berhasil",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan/alumni/{alumni_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil semua data pekerjaan milik alumni tertentu (hanya bisa diakses user yang login)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Ambil pekerjaan berdasarkan ID Alumni",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Alumni",
+                        "name": "alumni_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil data pekerjaan alumni",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID alumni tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengunduh data pekerjaan alumni dalam format CSV, XLSX, atau PDF. Filter, pencarian, dan sort sama dengan /api/pekerjaan/list (lihat parameter di sana); data dikirim secara streaming.",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Export data pekerjaan alumni",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv (default) / xlsx / pdf",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Multi kolom, mis. -tanggal_mulai_kerja,nama_perusahaan",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Kolom pengurutan (default created_at)",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan (asc/desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Kata kunci pencarian",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File export",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil semua data pekerjaan dengan fitur pencarian, sorting, dan pagination (hanya bisa diakses user yang login)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Ambil semua pekerjaan dengan pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 10, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cursor = pakai cursor pagination (meta berisi next_cursor / prev_cursor, tanpa total)",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor / prev_cursor dari respons sebelumnya",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Multi kolom, mis. -tanggal_mulai_kerja,nama_perusahaan (prefix - = desc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Kolom pengurutan tunggal (default created_at)",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan (asc/desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Kata kunci pencarian",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter ID alumni",
+                        "name": "alumni_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter bidang industri",
+                        "name": "bidang_industri",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter status pekerjaan",
+                        "name": "status_pekerjaan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter lokasi kerja",
+                        "name": "lokasi_kerja",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tanggal mulai kerja sejak (YYYY-MM-DD)",
+                        "name": "mulai_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tanggal mulai kerja sampai (YYYY-MM-DD)",
+                        "name": "mulai_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tanggal selesai kerja sejak (YYYY-MM-DD)",
+                        "name": "selesai_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tanggal selesai kerja sampai (YYYY-MM-DD)",
+                        "name": "selesai_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Dibuat sejak (YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Dibuat sampai (YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil data pekerjaan",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter sort / filter tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan/trashed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menampilkan daftar pekerjaan yang sudah di-soft delete milik alumni yang login, atau milik semua alumni untuk permission pekerjaan:manage_all",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Ambil data pekerjaan yang dihapus (trashed)",
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil data pekerjaan terhapus",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal mengambil data pekerjaan terhapus",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil detail pekerjaan berdasarkan ID (hanya bisa diakses user yang login)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Ambil pekerjaan berdasarkan ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pekerjaan",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data pekerjaan ditemukan",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versi data, kirim balik lewat If-Match saat update"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data pekerjaan tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Memperbarui seluruh data pekerjaan berdasarkan ID (hanya bisa diakses user yang login). Kirim If-Match berisi ETag terakhir supaya perubahan orang lain tidak tertimpa.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Update data pekerjaan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pekerjaan",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag dari respon GET / PUT / PATCH sebelumnya",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Data pekerjaan yang diperbarui",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdatePekerjaanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pekerjaan berhasil diperbarui",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versi data terbaru"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Body, ID, atau If-Match tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data pekerjaan tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Data sudah diubah pengguna lain (If-Match tidak cocok)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal memperbarui data pekerjaan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menghapus data pekerjaan secara permanen (hanya bisa diakses user yang login)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Hapus pekerjaan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pekerjaan",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pekerjaan berhasil dihapus",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal menghapus pekerjaan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengubah sebagian data pekerjaan dengan JSON Merge Patch (RFC 7386): field yang tidak dikirim tetap, null mengosongkan field (mis. tanggal_selesai_kerja). Hasil akhirnya divalidasi seperti PUT. Tanpa If-Match, patch diterapkan pada versi yang baru dibaca dan tetap ditolak (412) kalau data berubah di tengah jalan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Update sebagian data pekerjaan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pekerjaan",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag dari respon GET / PUT / PATCH sebelumnya",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Field pekerjaan yang diubah (application/merge-patch+json atau application/json)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdatePekerjaanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pekerjaan berhasil diperbarui",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versi data terbaru"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Body, ID, atau If-Match tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data pekerjaan tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Data sudah diubah pengguna lain (If-Match tidak cocok)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal memperbarui data pekerjaan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan/{id}/hard-delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menghapus data pekerjaan yang sudah di-soft delete secara permanen dari database. Alumni hanya bisa menghapus pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:hard_delete",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Hard delete pekerjaan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pekerjaan",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hard delete pekerjaan berhasil",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data tidak ditemukan atau bukan milik alumni ini",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal melakukan hard delete",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan/{id}/restore": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengembalikan data pekerjaan yang sudah di-soft delete. Alumni hanya bisa me-restore pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:manage_all",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Restore pekerjaan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pekerjaan",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restore pekerjaan berhasil",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data tidak ditemukan atau bukan milik alumni ini",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal melakukan restore",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pekerjaan/{id}/soft-delete": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menandai data pekerjaan sebagai dihapus (tidak benar-benar dihapus dari database). Alumni hanya bisa menghapus pekerjaan miliknya sendiri, kecuali punya permission pekerjaan:manage_all",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pekerjaan"
+                ],
+                "summary": "Soft delete pekerjaan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pekerjaan",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Soft delete pekerjaan berhasil",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akun belum terhubung dengan data alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data tidak ditemukan atau bukan milik alumni ini",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Gagal melakukan soft delete",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menampilkan daftar permission yang bisa diberikan ke role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Ambil semua permission",
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil daftar permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil profil user yang sedang login berdasarkan token JWT",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Ambil profil user",
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil profil",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/profile/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengganti password user yang sedang login. Password lama wajib benar. Setelah berhasil, semua sesi lain dicabut dan token baru dikembalikan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Ganti password sendiri",
+                "parameters": [
+                    {
+                        "description": "Password lama dan baru",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Password lama salah",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/register": {
+            "post": {
+                "description": "Lulusan mendaftar dengan NIM, nama, dan email. NIM dan nama dicocokkan dengan roster lulusan, lalu link verifikasi dikirim ke email. Setelah email diverifikasi, pendaftaran masuk antrian persetujuan admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Pendaftaran mandiri alumni",
+                "parameters": [
+                    {
+                        "description": "Data pendaftaran",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Link verifikasi dikirim ke email",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid / NIM tidak ada di roster lulusan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "NIM sudah terdaftar atau pendaftaran masih diproses",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/register/verify": {
+            "post": {
+                "description": "Memverifikasi email pendaftar memakai token dari email. Pendaftaran kemudian menunggu persetujuan admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Verifikasi email pendaftaran",
+                "parameters": [
+                    {
+                        "description": "Token verifikasi",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.VerifyRegistrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Email terverifikasi",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Token tidak valid atau kadaluarsa",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/registrations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menampilkan pendaftaran alumni. Default hanya yang menunggu persetujuan (pending_approval).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Ambil antrian pendaftaran",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pending_verification / pending_approval / approved / rejected / all",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Halaman (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah per halaman (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil data pendaftaran",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Status tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/registrations/roster": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload CSV roster lulusan (header wajib: nim, nama; opsional: jurusan, angkatan, tahun_lulus). Data dengan NIM yang sudah ada diperbarui.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Import roster lulusan",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File CSV roster lulusan",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.RosterImportResult"
+                        }
+                    },
+                    "400": {
+                        "description": "File tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/registrations/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Membuat data alumni + akun user dari pendaftaran yang emailnya sudah terverifikasi, lalu mengirim link untuk membuat password ke email pendaftar.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Setujui pendaftaran",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pendaftaran",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pendaftaran disetujui",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pendaftaran tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pendaftaran tidak menunggu persetujuan / NIM sudah terdaftar",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/registrations/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menolak pendaftaran alumni yang masih diproses. Alasan penolakan dikirim ke email pendaftar.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Tolak pendaftaran",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID Pendaftaran",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alasan penolakan",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RejectRegistrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pendaftaran ditolak",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pendaftaran tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pendaftaran sudah diproses",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menampilkan semua role beserta daftar permission-nya.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Ambil semua role",
+                "responses": {
+                    "200": {
+                        "description": "Berhasil mengambil daftar role",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Membuat role baru dengan kumpulan permission. Nama role huruf kecil, angka, dan underscore (mis. operator_prodi).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Buat role baru",
+                "parameters": [
+                    {
+                        "description": "Data role",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.Role"
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Role sudah ada",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/roles/{name}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengubah deskripsi role dan mengganti seluruh daftar permission-nya. Perubahan langsung berlaku untuk semua user dengan role tersebut.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Update role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nama role",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Deskripsi dan permission baru (field name diabaikan)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Role"
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menghapus role. Role bawaan (admin, user) dan role yang masih dipakai user tidak bisa dihapus.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Hapus role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nama role",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role berhasil dihapus",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Role bawaan atau masih dipakai user",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pencarian full-text + fuzzy (toleran salah ketik) di alumni (nama, NIM, jurusan) dan pekerjaan (perusahaan, posisi, deskripsi). Hasil diurutkan berdasarkan relevansi; potongan teks yang cocok dibungkus \u003cmark\u003e. Tipe yang dicari dibatasi permission alumni:read / pekerjaan:read.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Pencarian alumni dan pekerjaan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kata kunci (2 - 100 karakter)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "alumni / pekerjaan / all (default all)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Nomor halaman (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah data per halaman (default 10, maksimal 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parameter tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/token/refresh": {
+            "post": {
+                "description": "Menukar refresh token dengan access token dan refresh token baru (rotasi). Refresh token lama tidak bisa dipakai lagi; jika dipakai ulang, seluruh sesi terkait akan dicabut.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Refresh token tidak valid, kadaluarsa, atau sudah dipakai",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menampilkan daftar user dengan pagination, pencarian username/email, serta filter role dan status aktif.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Ambil daftar user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Halaman (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Jumlah per halaman (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cari username atau email",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter role",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter status aktif",
+                        "name": "is_active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Membuat akun user baru (mis. operator atau admin lain). Password awal wajib diganti saat login pertama. Role default \"user\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Buat user baru",
+                "parameters": [
+                    {
+                        "description": "Data user",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Username atau email sudah dipakai",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengambil detail user beserta ID alumni yang terhubung.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Ambil detail user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID User",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UserDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}/alumni": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menghubungkan akun user dengan data alumni. Satu user hanya boleh terhubung ke satu alumni dan sebaliknya.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Hubungkan user dengan alumni",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID User",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ID alumni",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.LinkAlumniRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User berhasil dihubungkan",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User atau alumni tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Alumni atau user sudah terhubung",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Melepas hubungan akun user dengan data alumni. Data alumni tetap ada.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Lepas hubungan user dengan alumni",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID User",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Hubungan berhasil dilepas",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak terhubung dengan alumni",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}/password-reset": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Membuat token reset password sekali pakai dan mengirim link reset ke email user. Token sebelumnya yang belum dipakai otomatis dibatalkan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Reset password user (admin)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID User",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Link reset password dikirim",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Akun user dinonaktifkan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mengganti role user. Berlaku langsung di request berikutnya tanpa perlu login ulang. Admin tidak bisa mengganti role dirinya sendiri. Role lama atau baru yang punya permission di luar milik pemanggil butuh permission roles:manage.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Ganti role user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID User",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role baru",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateUserRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role user berhasil diganti",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak atau butuh roles:manage",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menonaktifkan user langsung mencabut semua sesinya dan menolak login berikutnya. Admin tidak bisa menonaktifkan dirinya sendiri.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Aktifkan / nonaktifkan user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID User",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status aktif",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateUserStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status user berhasil diubah",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Request tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Menghapus catatan login gagal dan lockout untuk akun user sehingga user bisa langsung login lagi. Aksi ini dicatat di audit log.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Buka kunci login user (admin)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID User",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Akun berhasil dibuka",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID tidak valid",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Token tidak valid atau tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Akses ditolak",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User tidak ditemukan",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Kesalahan server",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "model.Alumni": {
+            "type": "object",
+            "properties": {
+                "alamat": {
+                    "type": "string"
+                },
+                "angkatan": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "jurusan": {
+                    "type": "string"
+                },
+                "nama": {
+                    "type": "string"
+                },
+                "nim": {
+                    "type": "string"
+                },
+                "no_telepon": {
+                    "type": "string"
+                },
+                "status_kematian": {
+                    "type": "boolean"
+                },
+                "tahun_lulus": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "naik setiap update, dikirim juga sebagai ETag",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AlumniImportResult": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ImportRowError"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "report_id": {
+                    "description": "download di /api/alumni/import/reports/{id}",
+                    "type": "string"
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AlumniResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Alumni"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/model.MetaInfo"
+                }
+            }
+        },
+        "model.ChangePasswordRequest": {
+            "type": "object",
+            "properties": {
+                "new_password": {
+                    "type": "string",
+                    "example": "passwordBaru123"
+                },
+                "old_password": {
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "model.CreateAlumniRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "nama",
+                "nim"
+            ],
+            "properties": {
+                "alamat": {
+                    "type": "string",
+                    "example": "Jl. Merdeka No. 1"
+                },
+                "angkatan": {
+                    "type": "integer",
+                    "maximum": 2100,
+                    "minimum": 1900,
+                    "example": 2019
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "john@example.com"
+                },
+                "jurusan": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Teknik Informatika"
+                },
+                "nama": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "John Doe"
+                },
+                "nim": {
+                    "type": "string",
+                    "example": "12345678"
+                },
+                "no_telepon": {
+                    "type": "string",
+                    "example": "08123456789"
+                },
+                "status_kematian": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "tahun_lulus": {
+                    "type": "integer",
+                    "maximum": 2100,
+                    "minimum": 1900,
+                    "example": 2023
+                }
+            }
+        },
+        "model.CreatePekerjaanRequest": {
+            "type": "object",
+            "required": [
+                "alumni_id",
+                "nama_perusahaan",
+                "posisi_jabatan",
+                "tanggal_mulai_kerja"
+            ],
+            "properties": {
+                "alumni_id": {
+                    "type": "integer"
+                },
+                "bidang_industri": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "deskripsi_pekerjaan": {
+                    "type": "string"
+                },
+                "gaji_range": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "lokasi_kerja": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "nama_perusahaan": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "posisi_jabatan": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "status_pekerjaan": {
+                    "type": "string",
+                    "enum": [
+                        "aktif",
+                        "selesai",
+                        "resign"
+                    ]
+                },
+                "tanggal_mulai_kerja": {
+                    "description": "string → biar gampang diparse",
+                    "type": "string"
+                },
+                "tanggal_selesai_kerja": {
+                    "description": "nullable",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateUserRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "operator1@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "passwordAwal123"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "user"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "operator1"
+                }
+            }
+        },
+        "model.ErrorBody": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "NOT_FOUND"
+                },
+                "details": {},
+                "message": {
+                    "type": "string",
+                    "example": "Alumni tidak ditemukan"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "3f0c8a52-6f1e-4d7b-9a57-0d3c5b1e2a44"
+                }
+            }
+        },
+        "model.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/model.ErrorBody"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "model.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "nim": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.LinkAlumniRequest": {
+            "type": "object",
+            "properties": {
+                "alumni_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "model.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/model.User"
+                }
+            }
+        },
+        "model.MFALoginRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "123456"
+                },
+                "mfa_token": {
+                    "type": "string"
+                },
+                "recovery_code": {
+                    "type": "string",
+                    "example": "abcde-fghij"
+                }
+            }
+        },
+        "model.MetaInfo": {
+            "type": "object",
+            "properties": {
+                "filters": {
+                    "description": "filter yang dipakai",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "order": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pages": {
+                    "type": "integer"
+                },
+                "search": {
+                    "type": "string"
+                },
+                "sort": {
+                    "description": "urutan lengkap (multi kolom)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SortField"
+                    }
+                },
+                "sortBy": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.MyPekerjaanRequest": {
+            "type": "object",
+            "required": [
+                "nama_perusahaan",
+                "posisi_jabatan",
+                "tanggal_mulai_kerja"
+            ],
+            "properties": {
+                "bidang_industri": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Teknologi"
+                },
+                "deskripsi_pekerjaan": {
+                    "type": "string",
+                    "example": "Mengembangkan API"
+                },
+                "gaji_range": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "10-15 juta"
+                },
+                "lokasi_kerja": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Jakarta"
+                },
+                "nama_perusahaan": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "PT Maju Jaya"
+                },
+                "posisi_jabatan": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Backend Engineer"
+                },
+                "status_pekerjaan": {
+                    "type": "string",
+                    "enum": [
+                        "aktif",
+                        "selesai",
+                        "resign"
+                    ],
+                    "example": "aktif"
+                },
+                "tanggal_mulai_kerja": {
+                    "type": "string",
+                    "example": "2023-08-01"
+                },
+                "tanggal_selesai_kerja": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.PekerjaanAlumni": {
+            "type": "object",
+            "properties": {
+                "alumni_id": {
+                    "type": "integer"
+                },
+                "bidang_industri": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deskripsi_pekerjaan": {
+                    "type": "string"
+                },
+                "gaji_range": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lokasi_kerja": {
+                    "type": "string"
+                },
+                "nama_perusahaan": {
+                    "type": "string"
+                },
+                "posisi_jabatan": {
+                    "type": "string"
+                },
+                "status_pekerjaan": {
+                    "type": "string"
+                },
+                "tanggal_mulai_kerja": {
+                    "type": "string"
+                },
+                "tanggal_selesai_kerja": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "naik setiap update, dikirim juga sebagai ETag",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RefreshTokenRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "3q2-7w..."
+                }
+            }
+        },
+        "model.RegisterRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "nama": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "nim": {
+                    "type": "string",
+                    "example": "12345678"
+                }
+            }
+        },
+        "model.RejectRegistrationRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "NIM tidak sesuai dengan data kelulusan"
+                }
+            }
+        },
+        "model.ResetPasswordRequest": {
+            "type": "object",
+            "properties": {
+                "new_password": {
+                    "type": "string",
+                    "example": "passwordBaru123"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "Zm9vYmFy..."
+                }
+            }
+        },
+        "model.Role": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "is_system": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.RoleRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Operator program studi"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "operator_prodi"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "alumni:read",
+                        "alumni:write"
+                    ]
+                }
+            }
+        },
+        "model.RosterImportResult": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "tahun_lulus": {
+                "imported": {
                     "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
-                },
-                "user_id": {
+                "skipped": {
                     "type": "integer"
                 }
             }
         },
-        "model.AlumniResponse": {
+        "model.SearchResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Alumni"
+                        "$ref": "#/definitions/model.SearchResult"
                     }
                 },
                 "meta": {
@@ -1958,134 +5801,97 @@ const docTemplate = `{
                 }
             }
         },
-        "model.CreateAlumniRequest": {
+        "model.SearchResult": {
             "type": "object",
             "properties": {
-                "alamat": {
-                    "type": "string",
-                    "example": "Jl. Merdeka No. 1"
-                },
-                "angkatan": {
+                "alumni_id": {
                     "type": "integer",
-                    "example": 2019
+                    "example": 12
                 },
-                "email": {
+                "highlight": {
+                    "description": "HTML aman, potongan cocok dibungkus \u003cmark\u003e",
                     "type": "string",
-                    "example": "john@example.com"
+                    "example": "\u003cmark\u003eBudi\u003c/mark\u003e Santoso 2101 Teknik Informatika"
                 },
-                "jurusan": {
-                    "type": "string",
-                    "example": "Teknik Informatika"
+                "id": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "nama": {
-                    "type": "string",
-                    "example": "John Doe"
+                "score": {
+                    "type": "number",
+                    "example": 0.87
                 },
-                "nim": {
+                "subtitle": {
                     "type": "string",
-                    "example": "12345678"
+                    "example": "2101 · Teknik Informatika"
                 },
-                "no_telepon": {
+                "title": {
                     "type": "string",
-                    "example": "08123456789"
-                },
-                "status_kematian": {
-                    "type": "boolean",
-                    "example": false
+                    "example": "Budi Santoso"
                 },
-                "tahun_lulus": {
-                    "type": "integer",
-                    "example": 2023
+                "type": {
+                    "description": "alumni / pekerjaan",
+                    "type": "string",
+                    "example": "alumni"
                 }
             }
         },
-        "model.CreatePekerjaanRequest": {
+        "model.SortField": {
             "type": "object",
             "properties": {
-                "alumni_id": {
-                    "type": "integer"
-                },
-                "bidang_industri": {
-                    "type": "string"
-                },
-                "deskripsi_pekerjaan": {
-                    "type": "string"
-                },
-                "gaji_range": {
-                    "type": "string"
-                },
-                "lokasi_kerja": {
-                    "type": "string"
-                },
-                "nama_perusahaan": {
-                    "type": "string"
-                },
-                "posisi_jabatan": {
-                    "type": "string"
-                },
-                "status_pekerjaan": {
-                    "type": "string"
-                },
-                "tanggal_mulai_kerja": {
-                    "description": "string → biar gampang diparse",
-                    "type": "string"
+                "column": {
+                    "type": "string",
+                    "example": "angkatan"
                 },
-                "tanggal_selesai_kerja": {
-                    "description": "nullable",
-                    "type": "string"
+                "order": {
+                    "type": "string",
+                    "example": "desc"
                 }
             }
         },
-        "model.LoginRequest": {
+        "model.TOTPCodeRequest": {
             "type": "object",
             "properties": {
-                "password": {
-                    "type": "string"
-                },
-                "username": {
-                    "type": "string"
+                "code": {
+                    "type": "string",
+                    "example": "123456"
                 }
             }
         },
-        "model.LoginResponse": {
+        "model.TOTPEnrollResponse": {
             "type": "object",
             "properties": {
-                "token": {
-                    "type": "string"
+                "provisioning_uri": {
+                    "type": "string",
+                    "example": "otpauth://totp/Alumni%20Portal:admin?secret=JBSWY3DPEHPK3PXP\u0026issuer=Alumni+Portal"
                 },
-                "user": {
-                    "$ref": "#/definitions/model.User"
+                "secret": {
+                    "type": "string",
+                    "example": "JBSWY3DPEHPK3PXP"
                 }
             }
         },
-        "model.MetaInfo": {
+        "model.TOTPVerifyResponse": {
             "type": "object",
             "properties": {
-                "limit": {
-                    "type": "integer"
-                },
-                "order": {
-                    "type": "string"
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pages": {
-                    "type": "integer"
-                },
-                "search": {
-                    "type": "string"
-                },
-                "sortBy": {
-                    "type": "string"
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "total": {
-                    "type": "integer"
+                "tokens": {
+                    "$ref": "#/definitions/model.LoginResponse"
                 }
             }
         },
         "model.UpdateAlumniRequest": {
             "type": "object",
+            "required": [
+                "email",
+                "nama",
+                "nim"
+            ],
             "properties": {
                 "alamat": {
                     "type": "string",
@@ -2093,10 +5899,13 @@ const docTemplate = `{
                 },
                 "angkatan": {
                     "type": "integer",
+                    "maximum": 2100,
+                    "minimum": 1900,
                     "example": 2019
                 },
                 "email": {
                     "type": "string",
+                    "maxLength": 100,
                     "example": "john@example.com"
                 },
                 "id": {
@@ -2105,10 +5914,12 @@ const docTemplate = `{
                 },
                 "jurusan": {
                     "type": "string",
+                    "maxLength": 100,
                     "example": "Teknik Informatika"
                 },
                 "nama": {
                     "type": "string",
+                    "maxLength": 100,
                     "example": "John Doe"
                 },
                 "nim": {
@@ -2125,6 +5936,8 @@ const docTemplate = `{
                 },
                 "tahun_lulus": {
                     "type": "integer",
+                    "maximum": 2100,
+                    "minimum": 1900,
                     "example": 2023
                 },
                 "user_id": {
@@ -2132,29 +5945,62 @@ const docTemplate = `{
                 }
             }
         },
+        "model.UpdateMyAlumniRequest": {
+            "type": "object",
+            "properties": {
+                "alamat": {
+                    "type": "string",
+                    "example": "Jl. Sudirman No. 2"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "john.baru@example.com"
+                },
+                "no_telepon": {
+                    "type": "string",
+                    "example": "08123456789"
+                }
+            }
+        },
         "model.UpdatePekerjaanRequest": {
             "type": "object",
+            "required": [
+                "nama_perusahaan",
+                "posisi_jabatan",
+                "tanggal_mulai_kerja"
+            ],
             "properties": {
                 "bidang_industri": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 50
                 },
                 "deskripsi_pekerjaan": {
                     "type": "string"
                 },
                 "gaji_range": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 50
                 },
                 "lokasi_kerja": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "nama_perusahaan": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "posisi_jabatan": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "status_pekerjaan": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "aktif",
+                        "selesai",
+                        "resign"
+                    ]
                 },
                 "tanggal_mulai_kerja": {
                     "type": "string"
@@ -2173,6 +6019,24 @@ const docTemplate = `{
                 }
             }
         },
+        "model.UpdateUserRoleRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "operator_prodi"
+                }
+            }
+        },
+        "model.UpdateUserStatusRequest": {
+            "type": "object",
+            "properties": {
+                "is_active": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
         "model.User": {
             "type": "object",
             "properties": {
@@ -2185,47 +6049,129 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "must_change_password": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "totp_enabled": {
+                    "type": "boolean"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UserDetail": {
+            "type": "object",
+            "properties": {
+                "alumni_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "must_change_password": {
+                    "type": "boolean"
+                },
                 "role": {
                     "type": "string"
                 },
+                "totp_enabled": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
                 "username": {
                     "type": "string"
                 }
             }
         },
+        "model.UserResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UserDetail"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/model.MetaInfo"
+                }
+            }
+        },
+        "model.VerifyRegistrationRequest": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "Zm9vYmFy..."
+                }
+            }
+        },
         "modelmongo.CreatePekerjaanRequest": {
             "type": "object",
+            "required": [
+                "alumni_id",
+                "nama_perusahaan",
+                "posisi_jabatan"
+            ],
             "properties": {
                 "alumni_id": {
                     "type": "integer"
                 },
                 "bidang_industri": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 50
                 },
                 "deskripsi_pekerjaan": {
                     "type": "string"
                 },
                 "gaji_range": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 50
                 },
                 "lokasi_kerja": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "nama_perusahaan": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "posisi_jabatan": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "status_pekerjaan": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "aktif",
+                        "selesai",
+                        "resign"
+                    ]
                 },
                 "tanggal_mulai_kerja": {
-                    "description": "ISO8601 expected, optional",
+                    "description": "YYYY-MM-DD, optional",
                     "type": "string"
                 },
                 "tanggal_selesai_kerja": {
-                    "description": "ISO8601 expected, optional",
+                    "description": "YYYY-MM-DD, optional",
                     "type": "string"
                 }
             }
@@ -2234,33 +6180,83 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "bidang_industri": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 50
                 },
                 "deskripsi_pekerjaan": {
                     "type": "string"
                 },
                 "gaji_range": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 50
                 },
                 "lokasi_kerja": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "nama_perusahaan": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "posisi_jabatan": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "status_pekerjaan": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "aktif",
+                        "selesai",
+                        "resign"
+                    ]
                 },
                 "tanggal_mulai_kerja": {
-                    "description": "optional ISO8601",
+                    "description": "optional YYYY-MM-DD",
                     "type": "string"
                 },
                 "tanggal_selesai_kerja": {
-                    "description": "optional ISO8601",
+                    "description": "optional YYYY-MM-DD",
+                    "type": "string"
+                }
+            }
+        },
+        "utils.JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string"
+                },
+                "crv": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
                     "type": "string"
+                },
+                "kty": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                },
+                "x": {
+                    "type": "string"
+                }
+            }
+        },
+        "utils.JWKSet": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.JWK"
+                    }
                 }
             }
         }
@@ -2282,7 +6278,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{"http"},
 	Title:            "BackendGo API",
-	Description:      "API untuk mengelola data backend menggunakan Fiber dan MongoDB.",
+	Description:      "API untuk mengelola data backend menggunakan Fiber dan MongoDB.\nPesan respon mengikuti header Accept-Language: id (default) atau en.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
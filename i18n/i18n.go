@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ===================================================
+// 🔹 Pesan API dua bahasa (Indonesia / Inggris)
+// Pesan disimpan di katalog per bahasa dengan key seperti "alumni.not_found".
+// Bahasa dipilih dari header Accept-Language (lihat middleware.Language);
+// Indonesia tetap default dan jadi fallback kalau key belum diterjemahkan.
+// ===================================================
+
+const (
+	Indonesian = "id"
+	English    = "en"
+	Default    = Indonesian
+)
+
+var catalogs = map[string]map[string]string{
+	Indonesian: messagesID,
+	English:    messagesEN,
+}
+
+// Translate teks untuk key dalam bahasa lang. Key yang tidak ada di katalog
+// dikirim apa adanya (mis. pesan error dari parser file).
+func Translate(lang, key string, args ...interface{}) string {
+	text, ok := catalogs[lang][key]
+	if !ok {
+		text, ok = catalogs[Default][key]
+	}
+	if !ok {
+		text = key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(text, args...)
+	}
+	return text
+}
+
+// Has true kalau key sudah diterjemahkan di katalog bahasa lang
+func Has(lang, key string) bool {
+	_, ok := catalogs[lang][key]
+	return ok
+}
+
+// Negotiate pilih bahasa dari header Accept-Language (mis. "en-US,en;q=0.9,id;q=0.8")
+// berdasarkan nilai q; bahasa yang tidak didukung dilewati, tanpa kecocokan = Default
+func Negotiate(header string) string {
+	type candidate struct {
+		lang string
+		q    float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if base == "*" {
+			base = Default
+		}
+		if _, ok := catalogs[base]; ok && q > 0 {
+			candidates = append(candidates, candidate{base, q})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	if len(candidates) == 0 {
+		return Default
+	}
+	return candidates[0].lang
+}
+
+// Lang bahasa request yang sudah dinegosiasikan middleware.Language
+func Lang(c *fiber.Ctx) string {
+	if lang, ok := c.Locals("lang").(string); ok && lang != "" {
+		return lang
+	}
+	return Default
+}
+
+// T terjemahkan key ke bahasa request
+func T(c *fiber.Ctx, key string, args ...interface{}) string {
+	return Translate(Lang(c), key, args...)
+}
@@ -0,0 +1,263 @@
+package i18n
+
+// messagesEN katalog bahasa Inggris; key harus sama persis dengan messagesID
+var messagesEN = map[string]string{
+	// Umum
+	"common.invalid_body":      "Invalid request body",
+	"common.invalid_id":        "Invalid ID",
+	"common.not_found":         "Data not found",
+	"common.already_exists":    "Data already exists",
+	"common.invalid_relation":  "Invalid relation, or the data is still referenced by other data",
+	"common.validation_failed": "Invalid data",
+	"common.internal":          "Internal server error",
+	"common.nothing_to_update": "No fields to update",
+	"common.invalid_email":     "Invalid email format",
+	"common.file_required":     "file is required",
+	"common.mongo_unavailable": "MongoDB is not connected",
+	"common.invalid_patch":     "Body must be a JSON Merge Patch object",
+	"common.invalid_if_match":  "Invalid If-Match header, use the ETag from a previous response",
+	"common.version_conflict":  "The data was changed by someone else, reload it and try again",
+
+	// Pagination, sort & filter
+	"list.invalid_page":     "page must be a number of at least 1",
+	"list.invalid_limit":    "limit must be a number between 1 and %d",
+	"list.invalid_order":    "order must be asc or desc",
+	"list.too_many_sort":    "at most %d sort columns",
+	"list.sort_not_allowed": "sort column %q is not allowed, choose from: %s",
+	"list.sort_duplicate":   "sort column %q is listed more than once",
+	"list.filter_number":    "%s must be a number",
+	"list.filter_bool":      "%s must be true or false",
+	"list.filter_date":      "%s must use the YYYY-MM-DD format",
+	"list.cursor_sort":      "sort %s cannot be used with cursor pagination",
+	"list.invalid_cursor":   "Invalid cursor",
+	"list.cursor_mismatch":  "cursor does not match the sort order in use",
+
+	// Auth & sesi
+	"auth.missing_token":            "Missing token",
+	"auth.invalid_token":            "Invalid token",
+	"auth.token_revoked":            "Token has been revoked, please log in again",
+	"auth.account_disabled":         "Account is disabled, please contact an admin",
+	"auth.session_check_failed":     "Failed to verify session",
+	"auth.password_change_required": "Password must be changed first via PUT /api/profile/password",
+	"auth.mfa_enrollment_required":  "Admins must enable 2FA first via /api/mfa/totp/enroll",
+	"auth.permission_denied":        "Access denied, insufficient permission",
+	"auth.access_denied":            "Access denied",
+	"auth.credentials_required":     "Username and password are required",
+	"auth.invalid_credentials":      "Invalid username or password",
+	"auth.login_locked":             "Too many failed login attempts, try again in %d seconds",
+	"auth.login_check_failed":       "Failed to check login attempts",
+	"auth.token_failed":             "Failed to generate token",
+	"auth.refresh_required":         "refresh_token is required",
+	"auth.refresh_invalid":          "Invalid refresh token",
+	"auth.refresh_revoked":          "Refresh token has been revoked",
+	"auth.refresh_reused":           "Refresh token was already used, the session has been revoked. Please log in again",
+	"auth.refresh_expired":          "Refresh token has expired",
+	"auth.refresh_failed":           "Failed to process refresh token",
+	"auth.logout_failed":            "Failed to log out",
+	"auth.logout_all_failed":        "Failed to log out from all devices",
+	"auth.login_success":            "Login successful",
+	"auth.mfa_required":             "Enter your 2FA code to continue logging in",
+	"auth.logout_success":           "Logged out successfully",
+	"auth.logout_all_success":       "All sessions have been revoked",
+	"auth.profile_fetched":          "Profile retrieved successfully",
+	"auth.token_refreshed":          "Token refreshed successfully",
+	"auth.unlock_failed":            "Failed to unlock account",
+	"auth.unlock_success":           "Account login lock has been removed",
+
+	// Password
+	"password.fields_required":            "old_password and new_password are required",
+	"password.reset_fields_required":      "token and new_password are required",
+	"password.too_short":                  "New password must be at least %d characters",
+	"password.same_as_old":                "New password must differ from the old password",
+	"password.wrong_old":                  "Old password is incorrect",
+	"password.hash_failed":                "Failed to process password",
+	"password.save_failed":                "Failed to save password",
+	"password.reset_token_failed":         "Failed to create reset token",
+	"password.reset_token_save_failed":    "Failed to save reset token",
+	"password.reset_token_process_failed": "Failed to process reset token",
+	"password.reset_token_invalid":        "Reset token is invalid or has expired",
+	"password.reset_email_failed":         "Failed to send password reset email",
+	"password.reset_account_disabled":     "The user account is disabled, enable it before resetting the password",
+	"password.changed":                    "Password changed successfully",
+	"password.reset_link_sent":            "Password reset link has been sent to the user's email",
+	"password.reset_success":              "Password has been reset, please log in with the new password",
+
+	// 2FA
+	"mfa.token_required":            "mfa_token is required",
+	"mfa.code_or_recovery_required": "code or recovery_code is required",
+	"mfa.code_required":             "code is required",
+	"mfa.token_invalid":             "MFA token is invalid or expired, please log in again",
+	"mfa.wrong_code":                "Incorrect 2FA code",
+	"mfa.already_enabled":           "2FA is already enabled",
+	"mfa.already_enabled_reenroll":  "2FA is already enabled, disable it first to enroll again",
+	"mfa.not_enabled":               "2FA is not enabled",
+	"mfa.enrollment_not_started":    "2FA enrollment has not been started",
+	"mfa.required_for_admin":        "2FA is mandatory for admins and cannot be disabled",
+	"mfa.secret_failed":             "Failed to create 2FA secret",
+	"mfa.secret_save_failed":        "Failed to save 2FA secret",
+	"mfa.enable_failed":             "Failed to enable 2FA",
+	"mfa.disable_failed":            "Failed to disable 2FA",
+	"mfa.recovery_failed":           "Failed to create recovery codes",
+	"mfa.recovery_save_failed":      "Failed to save recovery codes",
+	"mfa.enroll_started":            "Scan the QR code, then verify with a code from your authenticator app",
+	"mfa.enabled":                   "2FA enabled. Store the recovery codes in a safe place",
+	"mfa.disabled":                  "2FA disabled",
+	"mfa.recovery_regenerated":      "New recovery codes generated",
+
+	// User
+	"user.not_found":             "User not found",
+	"user.fields_required":       "username, email and password are required",
+	"user.password_too_short":    "Password must be at least %d characters",
+	"user.taken":                 "Username or email is already taken",
+	"user.check_failed":          "Failed to check username",
+	"user.create_failed":         "Failed to create user",
+	"user.fetch_failed":          "Failed to fetch users",
+	"user.count_failed":          "Failed to count users",
+	"user.is_active_invalid":     "is_active must be true or false",
+	"user.cannot_disable_self":   "You cannot deactivate your own account",
+	"user.status_failed":         "Failed to change user status",
+	"user.alumni_id_required":    "alumni_id is required",
+	"user.alumni_already_linked": "Alumni is already linked to another user",
+	"user.already_linked":        "User is already linked to another alumni",
+	"user.not_linked":            "User is not linked to an alumni",
+	"user.link_failed":           "Failed to link user to alumni",
+	"user.unlink_failed":         "Failed to unlink user from alumni",
+	"user.created":               "User created",
+	"user.linked":                "User linked to alumni",
+	"user.unlinked":              "User unlinked from alumni",
+	"user.enabled":               "User enabled",
+	"user.disabled":              "User disabled",
+
+	// Role & permission
+	"rbac.role_required":             "role is required",
+	"rbac.role_unknown":              "Unknown role",
+	"rbac.role_not_found":            "Role not found",
+	"rbac.role_exists":               "Role already exists",
+	"rbac.role_name_invalid":         "Role names may only contain lowercase letters, digits and underscores (2-50 characters)",
+	"rbac.permission_unknown":        "Unknown permission: %s",
+	"rbac.admin_permission_required": "The admin role must keep the %s permission",
+	"rbac.role_builtin":              "Built-in roles cannot be deleted",
+	"rbac.role_in_use":               "Role is still used by %d users",
+	"rbac.cannot_change_own_role":    "You cannot change your own role",
+	"rbac.role_escalation":           "Roles with permissions you do not hold can only be granted or revoked with the %s permission",
+	"rbac.role_fetch_failed":         "Failed to fetch roles",
+	"rbac.permission_fetch_failed":   "Failed to fetch permissions",
+	"rbac.permission_check_failed":   "Failed to validate permissions",
+	"rbac.role_usage_failed":         "Failed to check role usage",
+	"rbac.role_create_failed":        "Failed to create role",
+	"rbac.role_update_failed":        "Failed to update role",
+	"rbac.role_delete_failed":        "Failed to delete role",
+	"rbac.user_role_failed":          "Failed to change user role",
+	"rbac.role_created":              "Role created",
+	"rbac.role_updated":              "Role updated",
+	"rbac.role_deleted":              "Role deleted",
+	"rbac.user_role_changed":         "User role changed",
+
+	// Alumni
+	"alumni.not_found":             "Alumni not found",
+	"alumni.not_linked":            "Your account is not linked to an alumni record",
+	"alumni.id_invalid":            "Invalid alumni_id",
+	"alumni.fields_required":       "nim, nama and email are required",
+	"alumni.admin_only_fields":     "The following fields can only be changed by an admin: %s",
+	"alumni.fetch_failed":          "Failed to fetch alumni",
+	"alumni.check_failed":          "Failed to check alumni data",
+	"alumni.create_failed":         "Failed to save alumni",
+	"alumni.update_failed":         "Failed to update alumni",
+	"alumni.death_status_failed":   "Failed to update death status",
+	"alumni.created":               "Alumni created",
+	"alumni.updated":               "Alumni updated",
+	"alumni.not_trashed":           "Alumni not found or not soft-deleted yet",
+	"alumni.soft_deleted_id":       "Alumni %d moved to trash",
+	"alumni.restored_id":           "Alumni %d restored",
+	"alumni.hard_deleted_id":       "Alumni %d and their account permanently deleted",
+	"alumni.death_status_updated":  "Death status updated",
+	"alumni.contact_updated":       "Contact details updated",
+	"alumni.export_format_invalid": "format must be csv, xlsx or pdf",
+
+	// Import alumni
+	"import.file_unreadable":  "File cannot be read",
+	"import.mapping_invalid":  "mapping must be a JSON object",
+	"import.report_not_found": "Report not found",
+	"import.too_many_rows":    "At most %d rows per import",
+	"import.invalid_rows":     "Some rows are invalid, the import was cancelled",
+	"import.save_failed":      "Import cancelled, some rows could not be saved",
+	"import.save_data_failed": "Failed to save imported data",
+	"import.dry_run":          "Dry run finished, no data was saved",
+	"import.success":          "Alumni imported successfully",
+
+	// Pekerjaan
+	"pekerjaan.not_found":          "Job record not found",
+	"pekerjaan.not_owned":          "Data not found or does not belong to this alumni",
+	"pekerjaan.not_trashed":        "Data not found or not soft-deleted yet",
+	"pekerjaan.not_soft_deleted":   "Job record has not been soft-deleted",
+	"pekerjaan.start_date_invalid": "tanggal_mulai_kerja must use the YYYY-MM-DD format",
+	"pekerjaan.end_date_invalid":   "tanggal_selesai_kerja must use the YYYY-MM-DD format",
+	"pekerjaan.fetch_failed":       "Failed to fetch job records",
+	"pekerjaan.save_failed":        "Failed to save job record",
+	"pekerjaan.update_failed":      "Failed to update job record",
+	"pekerjaan.created":            "Job record added",
+	"pekerjaan.updated":            "Job record updated",
+	"pekerjaan.deleted":            "Job record deleted",
+	"pekerjaan.soft_deleted":       "Job record moved to trash",
+	"pekerjaan.restored":           "Job record restored",
+	"pekerjaan.hard_deleted":       "Job record permanently deleted",
+	"pekerjaan.soft_deleted_id":    "Job record %d moved to trash",
+	"pekerjaan.restored_id":        "Job record %d restored",
+	"pekerjaan.hard_deleted_id":    "Job record %d permanently deleted",
+
+	// Pencarian
+	"search.query_invalid": "q must be 2 - 100 characters",
+	"search.type_invalid":  "type must be alumni, pekerjaan or all",
+	"search.failed":        "Search failed",
+
+	// Pendaftaran
+	"registration.not_found":          "Registration not found",
+	"registration.processed":          "Registration has already been processed",
+	"registration.not_pending":        "Registration is not awaiting approval",
+	"registration.pending_exists":     "A registration for this NIM is still being processed",
+	"registration.nim_registered":     "NIM is already registered as an alumni",
+	"registration.roster_mismatch":    "NIM and name do not match the graduation records",
+	"registration.token_required":     "token is required",
+	"registration.token_invalid":      "Verification token is invalid or has expired",
+	"registration.reason_required":    "reason is required",
+	"registration.status_invalid":     "Invalid status",
+	"registration.check_failed":       "Failed to check registration",
+	"registration.token_failed":       "Failed to create verification token",
+	"registration.save_failed":        "Failed to save registration",
+	"registration.email_failed":       "Failed to send verification email",
+	"registration.verify_failed":      "Failed to verify registration",
+	"registration.fetch_failed":       "Failed to fetch registrations",
+	"registration.count_failed":       "Failed to count registrations",
+	"registration.approve_failed":     "Failed to approve registration",
+	"registration.reject_failed":      "Failed to reject registration",
+	"registration.roster_save_failed": "Failed to save graduate roster",
+	"registration.received":           "Registration received, please check your email to verify",
+	"registration.verified":           "Email verified, the registration is awaiting admin approval",
+	"registration.approved":           "Registration approved",
+	"registration.rejected":           "Registration rejected",
+	"registration.roster_imported":    "Graduate roster imported",
+
+	// File
+	"file.not_found":             "File not found",
+	"file.photo_too_large":       "Photos may be at most 1MB",
+	"file.photo_format":          "Photos must be jpeg/png/jpg",
+	"file.certificate_too_large": "Certificates may be at most 2MB",
+	"file.certificate_format":    "Certificates must be PDF",
+	"file.delete_forbidden":      "You cannot delete another user's file",
+	"file.deleted":               "File deleted",
+
+	// Validasi field
+	"validation.required":   "is required",
+	"validation.notblank":   "must not be blank",
+	"validation.nim":        "invalid NIM format",
+	"validation.email":      "invalid email format",
+	"validation.phone":      "invalid phone number (e.g. 081234567890 or +6281234567890)",
+	"validation.date":       "date must use the YYYY-MM-DD format",
+	"validation.oneof":      "must be one of: %s",
+	"validation.min":        "must be at least %d",
+	"validation.max":        "must be at most %d",
+	"validation.min_length": "must be at least %d characters",
+	"validation.max_length": "must be at most %d characters",
+	"validation.gte_number": "must not be less than %s",
+	"validation.gte_date":   "must not be before %s",
+}
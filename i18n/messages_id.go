@@ -0,0 +1,264 @@
+package i18n
+
+// messagesID katalog bahasa Indonesia (bahasa default, juga fallback kalau key
+// belum ada di bahasa lain). Key dipakai apperror dan i18n.T; format mengikuti fmt.
+var messagesID = map[string]string{
+	// Umum
+	"common.invalid_body":      "Request body tidak valid",
+	"common.invalid_id":        "ID tidak valid",
+	"common.not_found":         "Data tidak ditemukan",
+	"common.already_exists":    "Data sudah ada",
+	"common.invalid_relation":  "Relasi data tidak valid atau masih dipakai data lain",
+	"common.validation_failed": "Data tidak valid",
+	"common.internal":          "Terjadi kesalahan pada server",
+	"common.nothing_to_update": "Tidak ada data yang diubah",
+	"common.invalid_email":     "Format email tidak valid",
+	"common.file_required":     "file wajib diupload",
+	"common.mongo_unavailable": "MongoDB belum terhubung",
+	"common.invalid_patch":     "Body harus berupa objek JSON Merge Patch",
+	"common.invalid_if_match":  "Header If-Match tidak valid, gunakan ETag dari respon sebelumnya",
+	"common.version_conflict":  "Data sudah diubah oleh pengguna lain, muat ulang lalu coba lagi",
+
+	// Pagination, sort & filter
+	"list.invalid_page":     "page harus angka minimal 1",
+	"list.invalid_limit":    "limit harus angka 1 - %d",
+	"list.invalid_order":    "order harus asc atau desc",
+	"list.too_many_sort":    "maksimal %d kolom sort",
+	"list.sort_not_allowed": "kolom sort %q tidak diizinkan, pilihan: %s",
+	"list.sort_duplicate":   "kolom sort %q disebut lebih dari sekali",
+	"list.filter_number":    "%s harus berupa angka",
+	"list.filter_bool":      "%s harus true atau false",
+	"list.filter_date":      "%s harus berformat YYYY-MM-DD",
+	"list.cursor_sort":      "sort %s tidak bisa dipakai dengan cursor pagination",
+	"list.invalid_cursor":   "cursor tidak valid",
+	"list.cursor_mismatch":  "cursor tidak cocok dengan urutan (sort) yang dipakai",
+
+	// Auth & sesi
+	"auth.missing_token":            "Token tidak ditemukan",
+	"auth.invalid_token":            "Token tidak valid",
+	"auth.token_revoked":            "Token sudah dicabut, silakan login ulang",
+	"auth.account_disabled":         "Akun dinonaktifkan, hubungi admin",
+	"auth.session_check_failed":     "Gagal memverifikasi sesi",
+	"auth.password_change_required": "Password harus diganti terlebih dahulu melalui PUT /api/profile/password",
+	"auth.mfa_enrollment_required":  "Admin wajib mengaktifkan 2FA terlebih dahulu melalui /api/mfa/totp/enroll",
+	"auth.permission_denied":        "Akses ditolak, permission tidak mencukupi",
+	"auth.access_denied":            "Akses ditolak",
+	"auth.credentials_required":     "Username dan password harus diisi",
+	"auth.invalid_credentials":      "Username atau password salah",
+	"auth.login_locked":             "Terlalu banyak percobaan login gagal, coba lagi dalam %d detik",
+	"auth.login_check_failed":       "Gagal memeriksa percobaan login",
+	"auth.token_failed":             "Gagal generate token",
+	"auth.refresh_required":         "refresh_token harus diisi",
+	"auth.refresh_invalid":          "Refresh token tidak valid",
+	"auth.refresh_revoked":          "Refresh token sudah dicabut",
+	"auth.refresh_reused":           "Refresh token sudah dipakai, sesi dicabut. Silakan login ulang",
+	"auth.refresh_expired":          "Refresh token sudah kadaluarsa",
+	"auth.refresh_failed":           "Gagal memproses refresh token",
+	"auth.logout_failed":            "Gagal logout",
+	"auth.logout_all_failed":        "Gagal logout dari semua perangkat",
+	"auth.login_success":            "Login berhasil",
+	"auth.mfa_required":             "Masukkan kode 2FA untuk melanjutkan login",
+	"auth.logout_success":           "Logout berhasil",
+	"auth.logout_all_success":       "Semua sesi berhasil dicabut",
+	"auth.profile_fetched":          "Profile berhasil diambil",
+	"auth.token_refreshed":          "Token berhasil diperbarui",
+	"auth.unlock_failed":            "Gagal membuka kunci akun",
+	"auth.unlock_success":           "Kunci login akun berhasil dibuka",
+
+	// Password
+	"password.fields_required":            "old_password dan new_password harus diisi",
+	"password.reset_fields_required":      "token dan new_password harus diisi",
+	"password.too_short":                  "Password baru minimal %d karakter",
+	"password.same_as_old":                "Password baru tidak boleh sama dengan password lama",
+	"password.wrong_old":                  "Password lama salah",
+	"password.hash_failed":                "Gagal memproses password",
+	"password.save_failed":                "Gagal menyimpan password",
+	"password.reset_token_failed":         "Gagal membuat token reset",
+	"password.reset_token_save_failed":    "Gagal menyimpan token reset",
+	"password.reset_token_process_failed": "Gagal memproses token reset",
+	"password.reset_token_invalid":        "Token reset tidak valid atau sudah kadaluarsa",
+	"password.reset_email_failed":         "Gagal mengirim email reset password",
+	"password.reset_account_disabled":     "Akun user dinonaktifkan, aktifkan dulu sebelum reset password",
+	"password.changed":                    "Password berhasil diubah",
+	"password.reset_link_sent":            "Link reset password dikirim ke email user",
+	"password.reset_success":              "Password berhasil direset, silakan login dengan password baru",
+
+	// 2FA
+	"mfa.token_required":            "mfa_token harus diisi",
+	"mfa.code_or_recovery_required": "code atau recovery_code harus diisi",
+	"mfa.code_required":             "code harus diisi",
+	"mfa.token_invalid":             "Token MFA tidak valid atau kadaluarsa, silakan login ulang",
+	"mfa.wrong_code":                "Kode 2FA salah",
+	"mfa.already_enabled":           "2FA sudah aktif",
+	"mfa.already_enabled_reenroll":  "2FA sudah aktif, nonaktifkan dulu untuk enrollment ulang",
+	"mfa.not_enabled":               "2FA belum aktif",
+	"mfa.enrollment_not_started":    "Enrollment 2FA belum dimulai",
+	"mfa.required_for_admin":        "2FA wajib untuk admin dan tidak bisa dinonaktifkan",
+	"mfa.secret_failed":             "Gagal membuat secret 2FA",
+	"mfa.secret_save_failed":        "Gagal menyimpan secret 2FA",
+	"mfa.enable_failed":             "Gagal mengaktifkan 2FA",
+	"mfa.disable_failed":            "Gagal menonaktifkan 2FA",
+	"mfa.recovery_failed":           "Gagal membuat recovery code",
+	"mfa.recovery_save_failed":      "Gagal menyimpan recovery code",
+	"mfa.enroll_started":            "Scan QR code lalu verifikasi dengan kode dari aplikasi authenticator",
+	"mfa.enabled":                   "2FA berhasil diaktifkan. Simpan recovery code di tempat aman",
+	"mfa.disabled":                  "2FA berhasil dinonaktifkan",
+	"mfa.recovery_regenerated":      "Recovery code baru berhasil dibuat",
+
+	// User
+	"user.not_found":             "User tidak ditemukan",
+	"user.fields_required":       "username, email, dan password harus diisi",
+	"user.password_too_short":    "Password minimal %d karakter",
+	"user.taken":                 "Username atau email sudah dipakai",
+	"user.check_failed":          "Gagal memeriksa username",
+	"user.create_failed":         "Gagal membuat user",
+	"user.fetch_failed":          "Gagal mengambil data user",
+	"user.count_failed":          "Gagal menghitung data user",
+	"user.is_active_invalid":     "is_active harus true atau false",
+	"user.cannot_disable_self":   "Tidak bisa menonaktifkan akun sendiri",
+	"user.status_failed":         "Gagal mengubah status user",
+	"user.alumni_id_required":    "alumni_id harus diisi",
+	"user.alumni_already_linked": "Alumni sudah terhubung dengan user lain",
+	"user.already_linked":        "User sudah terhubung dengan alumni lain",
+	"user.not_linked":            "User tidak terhubung dengan alumni",
+	"user.link_failed":           "Gagal menghubungkan user dengan alumni",
+	"user.unlink_failed":         "Gagal melepas hubungan user dengan alumni",
+	"user.created":               "User berhasil dibuat",
+	"user.linked":                "User berhasil dihubungkan dengan alumni",
+	"user.unlinked":              "Hubungan user dengan alumni berhasil dilepas",
+	"user.enabled":               "User berhasil diaktifkan",
+	"user.disabled":              "User berhasil dinonaktifkan",
+
+	// Role & permission
+	"rbac.role_required":             "role harus diisi",
+	"rbac.role_unknown":              "Role tidak dikenal",
+	"rbac.role_not_found":            "Role tidak ditemukan",
+	"rbac.role_exists":               "Role sudah ada",
+	"rbac.role_name_invalid":         "Nama role hanya boleh huruf kecil, angka, dan underscore (2-50 karakter)",
+	"rbac.permission_unknown":        "Permission tidak dikenal: %s",
+	"rbac.admin_permission_required": "Role admin wajib memiliki permission %s",
+	"rbac.role_builtin":              "Role bawaan tidak bisa dihapus",
+	"rbac.role_in_use":               "Role masih dipakai %d user",
+	"rbac.cannot_change_own_role":    "Tidak bisa mengganti role akun sendiri",
+	"rbac.role_escalation":           "Role dengan permission yang tidak dimiliki akun sendiri hanya bisa diberikan atau dicabut dengan permission %s",
+	"rbac.role_fetch_failed":         "Gagal mengambil data role",
+	"rbac.permission_fetch_failed":   "Gagal mengambil data permission",
+	"rbac.permission_check_failed":   "Gagal memvalidasi permission",
+	"rbac.role_usage_failed":         "Gagal memeriksa pemakaian role",
+	"rbac.role_create_failed":        "Gagal membuat role",
+	"rbac.role_update_failed":        "Gagal mengubah role",
+	"rbac.role_delete_failed":        "Gagal menghapus role",
+	"rbac.user_role_failed":          "Gagal mengganti role user",
+	"rbac.role_created":              "Role berhasil dibuat",
+	"rbac.role_updated":              "Role berhasil diubah",
+	"rbac.role_deleted":              "Role berhasil dihapus",
+	"rbac.user_role_changed":         "Role user berhasil diganti",
+
+	// Alumni
+	"alumni.not_found":             "Alumni tidak ditemukan",
+	"alumni.not_linked":            "Akun belum terhubung dengan data alumni",
+	"alumni.id_invalid":            "alumni_id tidak valid",
+	"alumni.fields_required":       "nim, nama, dan email harus diisi",
+	"alumni.admin_only_fields":     "Field berikut hanya bisa diubah admin: %s",
+	"alumni.fetch_failed":          "Gagal mengambil data alumni",
+	"alumni.check_failed":          "Gagal memeriksa data alumni",
+	"alumni.create_failed":         "Gagal menyimpan alumni",
+	"alumni.update_failed":         "Gagal mengubah data alumni",
+	"alumni.death_status_failed":   "Gagal update status kematian",
+	"alumni.created":               "Alumni berhasil dibuat",
+	"alumni.updated":               "Data alumni diperbarui",
+	"alumni.not_trashed":           "Alumni tidak ditemukan atau belum dihapus (soft delete)",
+	"alumni.soft_deleted_id":       "Alumni id %d dipindahkan ke trash",
+	"alumni.restored_id":           "Restore alumni id %d sukses",
+	"alumni.hard_deleted_id":       "Alumni id %d dihapus permanen beserta akunnya",
+	"alumni.death_status_updated":  "Status kematian diperbarui",
+	"alumni.contact_updated":       "Data kontak berhasil diubah",
+	"alumni.export_format_invalid": "format harus csv, xlsx, atau pdf",
+
+	// Import alumni
+	"import.file_unreadable":  "File tidak bisa dibaca",
+	"import.mapping_invalid":  "mapping harus berupa JSON object",
+	"import.report_not_found": "Laporan tidak ditemukan",
+	"import.too_many_rows":    "Maksimal %d baris per import",
+	"import.invalid_rows":     "Ada baris yang tidak valid, import dibatalkan",
+	"import.save_failed":      "Import dibatalkan, ada baris yang gagal disimpan",
+	"import.save_data_failed": "Gagal menyimpan data import",
+	"import.dry_run":          "Dry-run selesai, tidak ada data yang disimpan",
+	"import.success":          "Import alumni berhasil",
+
+	// Pekerjaan
+	"pekerjaan.not_found":          "Pekerjaan tidak ditemukan",
+	"pekerjaan.not_owned":          "Data tidak ditemukan atau bukan milik alumni ini",
+	"pekerjaan.not_trashed":        "Data tidak ditemukan atau belum dihapus (soft delete)",
+	"pekerjaan.not_soft_deleted":   "Pekerjaan belum di-soft delete",
+	"pekerjaan.start_date_invalid": "Format tanggal_mulai_kerja harus YYYY-MM-DD",
+	"pekerjaan.end_date_invalid":   "Format tanggal_selesai_kerja harus YYYY-MM-DD",
+	"pekerjaan.fetch_failed":       "Gagal mengambil data pekerjaan",
+	"pekerjaan.save_failed":        "Gagal menyimpan pekerjaan",
+	"pekerjaan.update_failed":      "Gagal mengubah pekerjaan",
+	"pekerjaan.created":            "Data pekerjaan berhasil ditambahkan",
+	"pekerjaan.updated":            "Data pekerjaan berhasil diupdate",
+	"pekerjaan.deleted":            "Pekerjaan berhasil dihapus",
+	"pekerjaan.soft_deleted":       "Soft delete pekerjaan berhasil",
+	"pekerjaan.restored":           "Restore pekerjaan berhasil",
+	"pekerjaan.hard_deleted":       "Hard delete pekerjaan berhasil",
+	"pekerjaan.soft_deleted_id":    "Soft delete pekerjaan id %d sukses",
+	"pekerjaan.restored_id":        "Restore pekerjaan id %d sukses",
+	"pekerjaan.hard_deleted_id":    "Hard delete pekerjaan id %d sukses",
+
+	// Pencarian
+	"search.query_invalid": "q harus 2 - 100 karakter",
+	"search.type_invalid":  "type harus alumni, pekerjaan, atau all",
+	"search.failed":        "Gagal melakukan pencarian",
+
+	// Pendaftaran
+	"registration.not_found":          "Pendaftaran tidak ditemukan",
+	"registration.processed":          "Pendaftaran sudah diproses",
+	"registration.not_pending":        "Pendaftaran tidak sedang menunggu persetujuan",
+	"registration.pending_exists":     "Pendaftaran untuk NIM ini masih diproses",
+	"registration.nim_registered":     "NIM sudah terdaftar sebagai alumni",
+	"registration.roster_mismatch":    "NIM dan nama tidak cocok dengan data kelulusan",
+	"registration.token_required":     "token harus diisi",
+	"registration.token_invalid":      "Token verifikasi tidak valid atau sudah kadaluarsa",
+	"registration.reason_required":    "reason harus diisi",
+	"registration.status_invalid":     "Status tidak valid",
+	"registration.check_failed":       "Gagal memeriksa pendaftaran",
+	"registration.token_failed":       "Gagal membuat token verifikasi",
+	"registration.save_failed":        "Gagal menyimpan pendaftaran",
+	"registration.email_failed":       "Gagal mengirim email verifikasi",
+	"registration.verify_failed":      "Gagal memverifikasi pendaftaran",
+	"registration.fetch_failed":       "Gagal mengambil data pendaftaran",
+	"registration.count_failed":       "Gagal menghitung data pendaftaran",
+	"registration.approve_failed":     "Gagal menyetujui pendaftaran",
+	"registration.reject_failed":      "Gagal menolak pendaftaran",
+	"registration.roster_save_failed": "Gagal menyimpan roster lulusan",
+	"registration.received":           "Pendaftaran diterima, silakan cek email untuk verifikasi",
+	"registration.verified":           "Email terverifikasi, pendaftaran menunggu persetujuan admin",
+	"registration.approved":           "Pendaftaran disetujui",
+	"registration.rejected":           "Pendaftaran ditolak",
+	"registration.roster_imported":    "Roster lulusan berhasil diimport",
+
+	// File
+	"file.not_found":             "File tidak ditemukan",
+	"file.photo_too_large":       "ukuran foto maksimal 1MB",
+	"file.photo_format":          "format foto hanya jpeg/png/jpg",
+	"file.certificate_too_large": "ukuran sertifikat maksimal 2MB",
+	"file.certificate_format":    "format sertifikat hanya PDF",
+	"file.delete_forbidden":      "Tidak boleh menghapus file milik user lain",
+	"file.deleted":               "File berhasil dihapus",
+
+	// Validasi field
+	"validation.required":   "wajib diisi",
+	"validation.notblank":   "tidak boleh kosong",
+	"validation.nim":        "format NIM tidak valid",
+	"validation.email":      "format email tidak valid",
+	"validation.phone":      "format nomor telepon tidak valid (contoh 081234567890 atau +6281234567890)",
+	"validation.date":       "format tanggal harus YYYY-MM-DD",
+	"validation.oneof":      "harus salah satu dari: %s",
+	"validation.min":        "minimal %d",
+	"validation.max":        "maksimal %d",
+	"validation.min_length": "minimal %d karakter",
+	"validation.max_length": "maksimal %d karakter",
+	"validation.gte_number": "tidak boleh lebih kecil dari %s",
+	"validation.gte_date":   "tidak boleh sebelum %s",
+}
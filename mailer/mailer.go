@@ -0,0 +1,146 @@
+package mailer
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"backendgo/config"
+)
+
+// Message email yang akan dikirim
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer pengirim email. Implementasi dipilih lewat env MAIL_DRIVER.
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// ErrInvalidHeader header email (From / To / Subject) mengandung CR atau LF,
+// yang bisa dipakai untuk menyisipkan header atau penerima lain
+var ErrInvalidHeader = errors.New("mailer: header email tidak boleh mengandung baris baru")
+
+var (
+	defaultMailer Mailer
+	defaultOnce   sync.Once
+)
+
+// Default mailer sesuai env MAIL_DRIVER:
+//
+//	log  (default) tulis isi email ke log, untuk development
+//	file simpan email sebagai file .eml di MAIL_FILE_DIR
+//	smtp kirim lewat SMTP_HOST/SMTP_PORT/SMTP_USERNAME/SMTP_PASSWORD
+func Default() Mailer {
+	defaultOnce.Do(func() {
+		switch config.GetEnv("MAIL_DRIVER", "log") {
+		case "smtp":
+			defaultMailer = &SMTPMailer{
+				Host:     config.GetEnv("SMTP_HOST", "localhost"),
+				Port:     config.GetEnv("SMTP_PORT", "587"),
+				Username: config.GetEnv("SMTP_USERNAME", ""),
+				Password: config.GetEnv("SMTP_PASSWORD", ""),
+				From:     config.GetEnv("MAIL_FROM", "no-reply@alumni.local"),
+			}
+		case "file":
+			defaultMailer = &FileMailer{
+				Dir:  config.GetEnv("MAIL_FILE_DIR", "storage/mail"),
+				From: config.GetEnv("MAIL_FROM", "no-reply@alumni.local"),
+			}
+		default:
+			defaultMailer = &LogMailer{}
+		}
+	})
+	return defaultMailer
+}
+
+// SetDefault mengganti mailer default (dipakai di test)
+func SetDefault(m Mailer) {
+	defaultOnce.Do(func() {})
+	defaultMailer = m
+}
+
+// LogMailer menulis email ke log
+type LogMailer struct{}
+
+func (m *LogMailer) Send(msg Message) error {
+	log.Printf("📧 [mail] to=%s subject=%q\n%s\n", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// FileMailer menyimpan setiap email sebagai file .eml
+type FileMailer struct {
+	Dir  string
+	From string
+}
+
+func (m *FileMailer) Send(msg Message) error {
+	if err := os.MkdirAll(m.Dir, os.ModePerm); err != nil {
+		return err
+	}
+	data, err := buildMessage(m.From, msg)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102T150405.000000000"), sanitizeFileName(msg.To))
+	return os.WriteFile(filepath.Join(m.Dir, name), data, 0o644)
+}
+
+// SMTPMailer mengirim email lewat server SMTP
+type SMTPMailer struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+func (m *SMTPMailer) Send(msg Message) error {
+	data, err := buildMessage(m.From, msg)
+	if err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if m.Username != "" {
+		auth = smtp.PlainAuth("", m.Username, m.Password, m.Host)
+	}
+	return smtp.SendMail(m.Host+":"+m.Port, auth, m.From, []string{msg.To}, data)
+}
+
+// buildMessage menyusun email mentah; nilai header ditolak jika mengandung CR/LF
+func buildMessage(from string, msg Message) ([]byte, error) {
+	for _, v := range []string{from, msg.To, msg.Subject} {
+		if strings.ContainsAny(v, "\r\n") {
+			return nil, ErrInvalidHeader
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + msg.To + "\r\n")
+	b.WriteString("Subject: " + msg.Subject + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(msg.Body)
+	return []byte(b.String()), nil
+}
+
+func sanitizeFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '@' || r == '.' || r == '-' || r == '_' ||
+			(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, s)
+}
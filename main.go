@@ -1,9 +1,19 @@
 package main
 
 import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMongo"
+	"backendgo/app/service"
+	"backendgo/app/serviceMongo"
+	"backendgo/config"
 	"backendgo/database"
+	"backendgo/middleware"
 	"backendgo/route"
+	"backendgo/utils"
+	"database/sql"
 	"log"
+	"os"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
@@ -16,6 +26,7 @@ import (
 // @title BackendGo API
 // @version 1.0
 // @description API untuk mengelola data backend menggunakan Fiber dan MongoDB.
+// @description Pesan respon mengikuti header Accept-Language: id (default) atau en.
 // @contact.name Sherly Tanti Virginia
 // @contact.url https://github.com/scherlyz
 // @contact.email scheerly.tnv@gmail.com
@@ -32,21 +43,48 @@ func main() {
 		log.Println("No .env file found")
 	}
 
+	// Tanpa argumen = serve; lihat `go run . help` untuk daftar perintah
+	if err := runCommand(os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// runServe jalankan HTTP server
+func runServe(args []string) error {
+	flags := newFlagSet("serve", "serve [-addr :3000]")
+	addr := flags.String("addr", config.GetEnv("APP_ADDR", ":3000"), "alamat listen HTTP")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
 	database.ConnectDB()
-	database.ConnectMongoDB()
 	defer database.DB.Close()
 
+	if config.GetBool("DB_AUTO_MIGRATE", false) {
+		if err := migrateUp(); err != nil {
+			return err
+		}
+	}
+
+	database.ConnectMongoDB()
+
+	// Siapkan kunci JWT + rotasi terjadwal
+	utils.DefaultKeyStore()
+	stopRotation := make(chan struct{})
+	defer close(stopRotation)
+	utils.StartKeyRotation(stopRotation)
+
+	// Semua error handler diterjemahkan ke envelope model.ErrorResponse
 	app := fiber.New(fiber.Config{
-		ErrorHandler: func(c *fiber.Ctx, err error) error {
-			return c.Status(500).JSON(fiber.Map{"error": err.Error()})
-		},
+		ErrorHandler: middleware.ErrorHandler,
 	})
 
 	
 	app.Use(cors.New(cors.Config{
     AllowOrigins: "http://localhost:3000, http://127.0.0.1:3000",
-    AllowMethods: "GET,POST,PUT,DELETE,OPTIONS",
-    AllowHeaders: "Origin, Content-Type, Accept, Authorization",
+    AllowMethods: "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+    AllowHeaders: "Origin, Content-Type, Accept, Authorization, If-Match",
+    ExposeHeaders: "ETag",
     AllowCredentials: true,
 }))
 
@@ -55,7 +93,53 @@ func main() {
 	app.Get("/swagger/*", fiberSwagger.WrapHandler)
 
 	// Semua route
-	route.SetupRoutes(app)
+	route.SetupRoutes(app, newServices(database.DB))
+
+	return app.Listen(*addr)
+}
+
+// newServices rakit repository PostgreSQL ke service. Test memakai susunan yang sama
+// dengan repository in-memory, tanpa koneksi database.
+func newServices(db *sql.DB) route.Services {
+	users := repository.NewUserRepository(db)
+	alumni := repository.NewAlumniRepository(db)
+	pekerjaan := repository.NewPekerjaanRepository(db)
+	tokens := repository.NewRefreshTokenRepository(db)
+	attempts := repository.NewLoginAttemptRepository(db)
+	mfa := repository.NewMFARepository(db)
+	resets := repository.NewPasswordResetRepository(db)
+	rbac := repository.NewRBACRepository(db)
+	audit := repository.NewAuditRepository(db)
+
+	// AuthRequired / RequirePermission membaca sesi dan permission dari repository yang sama
+	middleware.SetSessionLoader(tokens.GetActiveSession)
+	middleware.SetPermissionLoader(rbac.RolePermissionMap)
+	// MFA_REQUIRED_FOR_ADMIN berlaku untuk semua role yang bisa mengelola user / role,
+	// bukan hanya role bernama admin
+	utils.SetMFAAdminRole(func(role string) bool {
+		return middleware.RoleHasPermission(role, model.PermUsersManage) || middleware.RoleHasPermission(role, model.PermRolesManage)
+	})
+
+	// tanpa MongoDB endpoint file & pekerjaan-mongo menjawab 500 "MongoDB belum terhubung"
+	var files repositoryMongo.FileRepository
+	var pekerjaanMongo repositoryMongo.PekerjaanMongoRepository
+	if database.MongoDB != nil {
+		files = repositoryMongo.NewFileRepository(database.MongoDB)
+		pekerjaanMongo = repositoryMongo.NewPekerjaanMongoRepository(database.MongoDB)
+	}
 
-	log.Fatal(app.Listen(":3000"))
+	return route.Services{
+		Auth:      service.NewAuthService(users, tokens, attempts, mfa, resets, audit),
+		User:      service.NewUserService(users, rbac, tokens, attempts, resets, audit),
+		Me:        service.NewMeService(alumni, pekerjaan),
+		Alumni:    service.NewAlumniService(alumni, users, files, pekerjaanMongo),
+		Pekerjaan: service.NewPekerjaanService(pekerjaan),
+		Files:     serviceMongo.NewFileService(files),
+
+		RBAC:           service.NewRBACService(rbac, audit),
+		Registration:   service.NewRegistrationService(repository.NewRegistrationRepository(db), resets, audit),
+		AlumniImport:   service.NewAlumniImportService(repository.NewAlumniImportRepository(db), audit),
+		Search:         service.NewSearchService(repository.NewSearchRepository(db)),
+		PekerjaanMongo: serviceMongo.NewPekerjaanMongoService(pekerjaanMongo),
+	}
 }
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"backendgo/app/model"
+	"backendgo/apperror"
 	"backendgo/utils"
 	"strings"
 	"log"
@@ -8,13 +10,40 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+// Route yang tetap boleh diakses selama user wajib ganti password
+var passwordChangeAllowed = map[string]bool{
+	"GET /api/profile":          true,
+	"PUT /api/profile/password": true,
+	"POST /api/logout":          true,
+	"POST /api/logout-all":      true,
+}
+
+// Route yang tetap boleh diakses selama admin wajib mengaktifkan 2FA
+var mfaEnrollmentAllowed = map[string]bool{
+	"GET /api/profile":           true,
+	"PUT /api/profile/password":  true,
+	"POST /api/logout":           true,
+	"POST /api/logout-all":       true,
+	"GET /api/mfa/status":        true,
+	"POST /api/mfa/totp/enroll":  true,
+	"POST /api/mfa/totp/verify":  true,
+}
+
+// loadActiveSession sumber sesi login, dipasang saat wiring lewat SetSessionLoader
+var loadActiveSession func(sessionID string) (*model.ActiveSession, error)
+
+// SetSessionLoader memasang sumber sesi aktif, mis. RefreshTokenRepository.GetActiveSession
+func SetSessionLoader(loader func(sessionID string) (*model.ActiveSession, error)) {
+	loadActiveSession = loader
+}
+
 // Middleware untuk verifikasi JWT
 func AuthRequired() fiber.Handler {
     return func(c *fiber.Ctx) error {
 		log.Println("AuthRequired dijalankan")
         tokenString := c.Get("Authorization")
         if tokenString == "" {
-            return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Missing token"})
+            return apperror.Unauthorized("auth.missing_token")
         }
 
         // Hapus prefix Bearer jika ada
@@ -24,35 +53,68 @@ func AuthRequired() fiber.Handler {
         claims, err := utils.ValidateToken(tokenString)
         if err != nil {
             log.Println("Token invalid:", err)
-            return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Invalid token"})
+            return apperror.Unauthorized("auth.invalid_token")
         }
 
         log.Printf("Claims: %+v\n", claims)
 
 
         // Ambil nilai dari MapClaims
-        userID := int(claims["user_id"].(float64))
-        username := claims["username"].(string)
-        role := claims["role"].(string)
+        userIDClaim, okUserID := claims["user_id"].(float64)
+        username, okUsername := claims["username"].(string)
+        sessionID, _ := claims["sid"].(string)
+        if !okUserID || !okUsername {
+            return apperror.Unauthorized("auth.invalid_token")
+        }
+        userID := int(userIDClaim)
+
+        // Tolak token dari sesi yang sudah logout / dicabut
+        if sessionID == "" {
+            return apperror.Unauthorized("auth.invalid_token")
+        }
+        if loadActiveSession == nil {
+            return apperror.Internal("auth.session_check_failed")
+        }
+        session, err := loadActiveSession(sessionID)
+        if err != nil {
+            log.Println("Gagal cek sesi:", err)
+            return apperror.Internal("auth.session_check_failed").Wrap(err)
+        }
+        if session == nil || session.UserID != userID {
+            return apperror.Unauthorized("auth.token_revoked")
+        }
+        if !session.IsActive {
+            return apperror.Forbidden("auth.account_disabled")
+        }
+
+        // User dengan password awal / hasil reset wajib ganti password dulu
+        route := c.Method() + " " + strings.TrimSuffix(c.Path(), "/")
+        if mustChange, _ := claims["mcp"].(bool); mustChange {
+            if !passwordChangeAllowed[route] {
+                return apperror.Forbidden("auth.password_change_required").
+                    WithCode(apperror.CodePasswordChangeRequired).
+                    WithDetails(fiber.Map{"must_change_password": true})
+            }
+        }
+
+        // Admin wajib 2FA (MFA_REQUIRED_FOR_ADMIN) tapi belum enrollment
+        if enroll, _ := claims["mfa_enroll"].(bool); enroll {
+            if !mfaEnrollmentAllowed[route] {
+                return apperror.Forbidden("auth.mfa_enrollment_required").
+                    WithCode(apperror.CodeMFAEnrollmentRequired).
+                    WithDetails(fiber.Map{"mfa_enrollment_required": true})
+            }
+        }
 
         // Simpan ke context
         c.Locals("user_id", userID)
         c.Locals("username", username)
-        c.Locals("role", role)
+        c.Locals("role", session.Role) // role terbaru dari DB, bukan dari claim
+        c.Locals("session_id", sessionID)
+        c.Locals("alumni_id", session.AlumniID) // 0 = belum terhubung ke alumni
 
         return c.Next()
     }
 }
 
 
-// Middleware tambahan untuk cek role admin
-func AdminOnly() fiber.Handler {
-	return func(c *fiber.Ctx) error {
-		role := c.Locals("role")
-		log.Println("AdminOnly dijalankan, role:", role) 
-		if role == nil || role.(string) != "admin" {
-			return c.Status(403).JSON(fiber.Map{"error": "Akses ditolak, hanya admin yang diizinkan"})
-		}
-		return c.Next()
-	}
-}
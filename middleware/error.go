@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"backendgo/app/model"
+	"backendgo/apperror"
+	"backendgo/i18n"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/lib/pq"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RequestID pasang X-Request-ID (pakai punya client kalau dikirim) untuk setiap request
+func RequestID() fiber.Handler {
+	return requestid.New()
+}
+
+// ErrorHandler dipasang di fiber.Config. Semua error dari handler / middleware
+// berakhir di sini dan dikirim sebagai model.ErrorResponse dalam bahasa request.
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	appErr := toAppError(err)
+	requestID, _ := c.Locals("requestid").(string)
+	lang := i18n.Lang(c)
+	if appErr.Status >= fiber.StatusInternalServerError {
+		log.Printf("❌ [%s] %s %s: %v\n", requestID, c.Method(), c.Path(), err)
+	}
+
+	return c.Status(appErr.Status).JSON(model.ErrorResponse{
+		Success: false,
+		Error: model.ErrorBody{
+			Code:      appErr.Code,
+			Message:   appErr.Message(lang),
+			Details:   translateDetails(lang, appErr.Details),
+			RequestID: requestID,
+		},
+	})
+}
+
+// toAppError petakan error apa pun ke error domain; error driver mentah tidak
+// pernah dikirim ke client
+func toAppError(err error) *apperror.Error {
+	if appErr := apperror.As(err); appErr != nil {
+		return appErr
+	}
+
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return apperror.New(fiberErr.Code, fiberErr.Message)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, mongo.ErrNoDocuments) {
+		return apperror.NotFound("common.not_found").Wrap(err)
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505": // unique_violation
+			return apperror.Conflict("common.already_exists").Wrap(err)
+		case "23503": // foreign_key_violation
+			return apperror.Conflict("common.invalid_relation").Wrap(err)
+		}
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return apperror.Conflict("common.already_exists").Wrap(err)
+	}
+
+	return apperror.Internal("common.internal").Wrap(err)
+}
+
+// translateDetails terjemahkan pesan per field (422) ke bahasa request
+func translateDetails(lang string, details interface{}) interface{} {
+	fields, ok := details.([]model.FieldError)
+	if !ok {
+		return details
+	}
+	out := make([]model.FieldError, len(fields))
+	for i, f := range fields {
+		out[i] = f
+		if f.Key != "" {
+			out[i].Message = i18n.Translate(lang, f.Key, f.Args...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"backendgo/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Language pilih bahasa respon dari Accept-Language (default Indonesia) dan simpan
+// di context untuk i18n.T / ErrorHandler
+func Language() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		lang := i18n.Negotiate(c.Get(fiber.HeaderAcceptLanguage))
+		c.Locals("lang", lang)
+		c.Set(fiber.HeaderContentLanguage, lang)
+		c.Vary(fiber.HeaderAcceptLanguage)
+		return c.Next()
+	}
+}
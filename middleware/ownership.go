@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"backendgo/apperror"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Lapisan kepemilikan data. AuthRequired menyimpan alumni_id milik user yang
+// login (hasil relasi alumni.user_id) di context; service memakai helper di
+// bawah untuk memutuskan apakah user boleh menyentuh data alumni / user lain.
+
+// AlumniID ID alumni yang terhubung dengan user login (false kalau belum terhubung)
+func AlumniID(c *fiber.Ctx) (int, bool) {
+	id, _ := c.Locals("alumni_id").(int)
+	return id, id > 0
+}
+
+// OwnsAlumni true kalau data alumni tersebut milik user yang login
+func OwnsAlumni(c *fiber.Ctx, alumniID int) bool {
+	own, ok := AlumniID(c)
+	return ok && own == alumniID
+}
+
+// CanAccessAlumni pemilik data selalu boleh; selain itu butuh permission perm
+func CanAccessAlumni(c *fiber.Ctx, alumniID int, perm string) bool {
+	return OwnsAlumni(c, alumniID) || HasPermission(c, perm)
+}
+
+// CanAccessUser sama seperti CanAccessAlumni untuk data yang dimiliki per user (mis. file)
+func CanAccessUser(c *fiber.Ctx, ownerUserID int, perm string) bool {
+	userID, _ := c.Locals("user_id").(int)
+	return (userID > 0 && userID == ownerUserID) || HasPermission(c, perm)
+}
+
+// ErrAlumniNotLinked 403 untuk user yang belum terhubung ke data alumni
+var ErrAlumniNotLinked = apperror.Forbidden("alumni.not_linked").WithCode(apperror.CodeAlumniNotLinked)
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"backendgo/apperror"
+	"backendgo/config"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// loadRolePermissions sumber peta role → permission, dipasang saat wiring lewat SetPermissionLoader
+var loadRolePermissions func() (map[string][]string, error)
+
+// SetPermissionLoader memasang sumber permission, mis. RBACRepository.RolePermissionMap
+// (di test: peta statis tanpa database)
+func SetPermissionLoader(loader func() (map[string][]string, error)) {
+	loadRolePermissions = loader
+	InvalidatePermissionCache()
+}
+
+// permissionCache peta role → permission dari database. Di-cache sebentar supaya
+// tidak query setiap request; perubahan role lewat API langsung menghapus cache.
+// generation naik setiap invalidasi, hasil load yang dimulai sebelum invalidasi
+// tidak disimpan supaya permission yang sudah dicabut tidak kembali ke cache.
+var permissionCache struct {
+	mu         sync.RWMutex
+	perms      map[string]map[string]bool
+	loadedAt   time.Time
+	generation uint64
+}
+
+// InvalidatePermissionCache dipanggil setelah role / permission diubah
+func InvalidatePermissionCache() {
+	permissionCache.mu.Lock()
+	permissionCache.perms = nil
+	permissionCache.generation++
+	permissionCache.mu.Unlock()
+}
+
+func rolePermissions(role string) (map[string]bool, error) {
+	ttl := config.GetDuration("RBAC_CACHE_TTL", 30*time.Second)
+
+	permissionCache.mu.RLock()
+	perms, loadedAt, generation := permissionCache.perms, permissionCache.loadedAt, permissionCache.generation
+	permissionCache.mu.RUnlock()
+	if perms != nil && time.Since(loadedAt) < ttl {
+		return perms[role], nil
+	}
+
+	if loadRolePermissions == nil {
+		return nil, errors.New("sumber permission belum dipasang (SetPermissionLoader)")
+	}
+	raw, err := loadRolePermissions()
+	if err != nil {
+		return nil, err
+	}
+	perms = make(map[string]map[string]bool, len(raw))
+	for r, codes := range raw {
+		set := make(map[string]bool, len(codes))
+		for _, code := range codes {
+			set[code] = true
+		}
+		perms[r] = set
+	}
+
+	permissionCache.mu.Lock()
+	if permissionCache.generation == generation {
+		permissionCache.perms = perms
+		permissionCache.loadedAt = time.Now()
+	}
+	permissionCache.mu.Unlock()
+	return perms[role], nil
+}
+
+// HasPermission cek apakah user yang sedang login punya permission tertentu.
+// Dipakai service untuk percabangan "boleh akses data semua user" vs "hanya miliknya".
+func HasPermission(c *fiber.Ctx, perm string) bool {
+	role, _ := c.Locals("role").(string)
+	if role == "" {
+		return false
+	}
+	return RoleHasPermission(role, perm)
+}
+
+// RoleHasPermission cek permission sebuah role tanpa request, mis. untuk kebijakan 2FA admin
+func RoleHasPermission(role, perm string) bool {
+	perms, err := rolePermissions(role)
+	if err != nil {
+		log.Println("Gagal memuat permission role:", err)
+		return false
+	}
+	return perms[perm]
+}
+
+// RequirePermission menolak request (403) kalau role user tidak punya
+// salah satu dari permission yang diminta. Dipasang setelah AuthRequired.
+func RequirePermission(perms ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		for _, perm := range perms {
+			if HasPermission(c, perm) {
+				return c.Next()
+			}
+		}
+		return apperror.Forbidden("auth.permission_denied").
+			WithCode(apperror.CodePermissionDenied).
+			WithDetails(fiber.Map{"required_permission": perms})
+	}
+}
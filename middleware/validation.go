@@ -0,0 +1,15 @@
+package middleware
+
+import (
+	"backendgo/apperror"
+	"backendgo/utils"
+)
+
+// Validate jalankan utils.Validate pada request; error non-nil = 422 (details berisi
+// semua field yang tidak valid) yang tinggal dikembalikan handler
+func Validate(req interface{}) error {
+	if errs := utils.Validate(req); len(errs) > 0 {
+		return apperror.Validation("common.validation_failed", errs)
+	}
+	return nil
+}
@@ -1,20 +1,31 @@
 package route
 
 import (
+	"backendgo/app/model"
 	"backendgo/app/service"
 	"backendgo/middleware"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func AlumniRoute(api fiber.Router) {
+func AlumniRoute(api fiber.Router, s *service.AlumniService, imports *service.AlumniImportService) {
 	alumni := api.Group("/alumni")
 
-	alumni.Get("/", middleware.AuthRequired(), service.GetAllAlumniService)
-	alumni.Get("/list", middleware.AuthRequired(), service.GetAlumniWithPaginationService)
-	alumni.Get("/:id", middleware.AuthRequired(), service.GetAlumniByIDService)
-	alumni.Post("/", middleware.AuthRequired(), middleware.AdminOnly(), service.CreateAlumniService)
-	alumni.Put("/:id", middleware.AuthRequired(), middleware.AdminOnly(), service.UpdateAlumniService)
-	alumni.Delete("/:id", middleware.AuthRequired(), middleware.AdminOnly(), service.DeleteAlumniService)
-	alumni.Put("/:id/kematian", middleware.AuthRequired(), middleware.AdminOnly(), service.UpdateStatusKematianService)
+	alumni.Get("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniRead), s.GetAllAlumniService)
+	alumni.Get("/list", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniRead), s.GetAlumniWithPaginationService)
+	alumni.Get("/export", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniRead), s.ExportAlumniService)
+	alumni.Post("/import", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniWrite), imports.ImportAlumniService)
+	alumni.Get("/import/reports/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniWrite), imports.DownloadAlumniImportReportService)
+	alumni.Get("/trashed", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniDelete), s.GetTrashedAlumniService)
+	alumni.Get("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniRead), s.GetAlumniByIDService)
+	alumni.Post("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniWrite), s.CreateAlumniService)
+	alumni.Put("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniWrite), s.UpdateAlumniService)
+	alumni.Patch("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniWrite), s.PatchAlumniService)
+	alumni.Delete("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniDelete), s.DeleteAlumniService)
+	alumni.Put("/:id/kematian", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniWrite), s.UpdateStatusKematianService)
+
+	// 🔹 TRASH, RESTORE, HARD DELETE (DELETE /:id juga memindah ke trash)
+	alumni.Put("/:id/soft-delete", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniDelete), s.SoftDeleteAlumniService)
+	alumni.Put("/:id/restore", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniDelete), s.RestoreAlumniService)
+	alumni.Delete("/:id/hard-delete", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniHardDelete), s.HardDeleteAlumniService)
 }
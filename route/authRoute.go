@@ -7,9 +7,15 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func AuthRoute(api fiber.Router) {
-	api.Post("/login", service.LoginService)
+func AuthRoute(api fiber.Router, s *service.AuthService) {
+	api.Post("/login", s.LoginService)
+	api.Post("/login/mfa", s.LoginMFAService)
+	api.Post("/token/refresh", s.RefreshTokenService)
+	api.Post("/password/reset", s.ResetPasswordService)
 
 	protected := api.Group("", middleware.AuthRequired())
-	protected.Get("/profile", service.GetProfileService) 
+	protected.Get("/profile", s.GetProfileService) 
+	protected.Put("/profile/password", s.ChangePasswordService)
+	protected.Post("/logout", s.LogoutService)
+	protected.Post("/logout-all", s.LogoutAllService)
 }
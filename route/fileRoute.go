@@ -6,11 +6,11 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupFileRoutes(api fiber.Router) {
+func SetupFileRoutes(api fiber.Router, s *serviceMongo.FileService) {
 	files := api.Group("/files")
 
-	files.Post("/upload", middleware.AuthRequired(), serviceMongo.UploadFile)
-	files.Get("/", middleware.AuthRequired(), serviceMongo.GetAllFiles)
-	files.Get("/:id", middleware.AuthRequired(), serviceMongo.GetFileByID)
-	files.Delete("/:id", middleware.AuthRequired(), serviceMongo.DeleteFile)
+	files.Post("/upload", middleware.AuthRequired(), s.UploadFile)
+	files.Get("/", middleware.AuthRequired(), s.GetAllFiles)
+	files.Get("/:id", middleware.AuthRequired(), s.GetFileByID)
+	files.Delete("/:id", middleware.AuthRequired(), s.DeleteFile)
 }
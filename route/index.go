@@ -1,15 +1,51 @@
 package route
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"backendgo/app/service"
+	"backendgo/app/serviceMongo"
+	"backendgo/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Services handler yang sudah dirakit dengan repository-nya (lihat newServices di main.go)
+type Services struct {
+	Auth      *service.AuthService
+	User      *service.UserService
+	Me        *service.MeService
+	Alumni    *service.AlumniService
+	Pekerjaan *service.PekerjaanService
+	Files     *serviceMongo.FileService
+
+	RBAC           *service.RBACService
+	Registration   *service.RegistrationService
+	AlumniImport   *service.AlumniImportService
+	Search         *service.SearchService
+	PekerjaanMongo *serviceMongo.PekerjaanMongoService
+}
+
+func SetupRoutes(app *fiber.App, s Services) {
+	// X-Request-ID untuk setiap request; ikut dikirim di envelope error (request_id)
+	app.Use(middleware.RequestID())
+	// Bahasa pesan respon dari Accept-Language (id default, en)
+	app.Use(middleware.Language())
+
+	// Kunci publik JWT untuk aplikasi internal lain
+	app.Get("/.well-known/jwks.json", service.JWKSService)
 
-func SetupRoutes(app *fiber.App) {
 	api := app.Group("/api")
 
-	AuthRoute(api)       // login
-	AlumniRoute(api)     // alumni CRUD + role
-	PekerjaanRoute(api)  // pekerjaan CRUD + role
-	PekerjaanMongoRoute(api)
-	SetupFileRoutes(api)
+	RegistrationRoute(api, s.Registration) // pendaftaran mandiri (publik → harus sebelum AuthRoute)
+	AuthRoute(api, s.Auth)       // login
+	UserRoute(api, s.User)       // manajemen user (admin)
+	RBACRoute(api, s.RBAC)       // role & permission
+	MFARoute(api, s.Auth)        // 2FA (TOTP)
+	MeRoute(api, s.Me)         // self-service alumni
+	AlumniRoute(api, s.Alumni, s.AlumniImport)     // alumni CRUD + permission
+	PekerjaanRoute(api, s.Pekerjaan)  // pekerjaan CRUD + permission
+	PekerjaanMongoRoute(api, s.PekerjaanMongo)
+	SearchRoute(api, s.Search)     // pencarian alumni + pekerjaan
+	SetupFileRoutes(api, s.Files)
 
 
 }
@@ -0,0 +1,19 @@
+package route
+
+import (
+	"backendgo/app/service"
+	"backendgo/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MeRoute endpoint self-service untuk alumni (data miliknya sendiri)
+func MeRoute(api fiber.Router, s *service.MeService) {
+	me := api.Group("/me", middleware.AuthRequired())
+
+	me.Get("/alumni", s.GetMyAlumniService)
+	me.Patch("/alumni", s.UpdateMyAlumniService)
+	me.Get("/pekerjaan", s.GetMyPekerjaanService)
+	me.Post("/pekerjaan", s.CreateMyPekerjaanService)
+	me.Put("/pekerjaan/:id", s.UpdateMyPekerjaanService)
+}
@@ -0,0 +1,18 @@
+package route
+
+import (
+	"backendgo/app/service"
+	"backendgo/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func MFARoute(api fiber.Router, s *service.AuthService) {
+	mfa := api.Group("/mfa")
+
+	mfa.Get("/status", middleware.AuthRequired(), s.MFAStatusService)
+	mfa.Post("/totp/enroll", middleware.AuthRequired(), s.MFAEnrollService)
+	mfa.Post("/totp/verify", middleware.AuthRequired(), s.MFAVerifyService)
+	mfa.Post("/totp/disable", middleware.AuthRequired(), s.MFADisableService)
+	mfa.Post("/recovery-codes", middleware.AuthRequired(), s.MFARecoveryCodesService)
+}
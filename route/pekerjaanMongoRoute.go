@@ -1,19 +1,26 @@
 package route
 
 import (
+	"backendgo/app/model"
 	"backendgo/app/serviceMongo"
 	"backendgo/middleware"
 	"github.com/gofiber/fiber/v2"
 )
 
-func PekerjaanMongoRoute(api fiber.Router) {
+func PekerjaanMongoRoute(api fiber.Router, s *serviceMongo.PekerjaanMongoService) {
     pekerjaan := api.Group("/pekerjaan-mongo") // ← beda prefix
 
-    pekerjaan.Get("/", middleware.AuthRequired(), serviceMongo.GetAllPekerjaanMongoService)
-    pekerjaan.Get("/:id", middleware.AuthRequired(), serviceMongo.GetPekerjaanByIDMongoService)
-    pekerjaan.Get("/alumni/:alumni_id", middleware.AuthRequired(), middleware.AdminOnly(), serviceMongo.GetPekerjaanByAlumniMongoService)
-    pekerjaan.Post("/", middleware.AuthRequired(), middleware.AdminOnly(), serviceMongo.CreatePekerjaanMongoService)
-    pekerjaan.Put("/:id", middleware.AuthRequired(), middleware.AdminOnly(), serviceMongo.UpdatePekerjaanMongoService)
-    pekerjaan.Delete("/:id", middleware.AuthRequired(), middleware.AdminOnly(), serviceMongo.DeletePekerjaanMongoService)
+    pekerjaan.Get("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanRead), s.GetAllPekerjaanMongoService)
+    pekerjaan.Get("/trashed", middleware.AuthRequired(), s.GetTrashedPekerjaanMongoService)
+    pekerjaan.Get("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanRead), s.GetPekerjaanByIDMongoService)
+    pekerjaan.Get("/alumni/:alumni_id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanRead), s.GetPekerjaanByAlumniMongoService)
+    pekerjaan.Post("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanWrite), s.CreatePekerjaanMongoService)
+    pekerjaan.Put("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanWrite), s.UpdatePekerjaanMongoService)
+    pekerjaan.Delete("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanDelete), s.DeletePekerjaanMongoService)
+
+    // Trash: pemilik data (alumni) atau permission manage_all / hard_delete
+    pekerjaan.Put("/:id/soft-delete", middleware.AuthRequired(), s.SoftDeletePekerjaanMongoService)
+    pekerjaan.Put("/:id/restore", middleware.AuthRequired(), s.RestorePekerjaanMongoService)
+    pekerjaan.Delete("/:id/hard-delete", middleware.AuthRequired(), s.HardDeleteTrashedPekerjaanMongoService)
 }
 
@@ -1,29 +1,32 @@
 package route
 
 import (
+	"backendgo/app/model"
 	"backendgo/app/service"
 	"backendgo/middleware"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func PekerjaanRoute(api fiber.Router) {
+func PekerjaanRoute(api fiber.Router, s *service.PekerjaanService) {
 	pekerjaan := api.Group("/pekerjaan")
 
 	// 🔹 READ (statis & spesifik dulu)
-	pekerjaan.Get("/", middleware.AuthRequired(), service.GetAllPekerjaanService)
-	pekerjaan.Get("/list", middleware.AuthRequired(), service.GetAllPekerjaanPaginationService)
-	pekerjaan.Get("/trashed", middleware.AuthRequired(), service.GetTrashedPekerjaanService)
-	pekerjaan.Get("/alumni/:alumni_id", middleware.AuthRequired(), middleware.AdminOnly(), service.GetPekerjaanByAlumniIDService)
-	pekerjaan.Get("/:id", middleware.AuthRequired(), service.GetPekerjaanByIDService)
+	pekerjaan.Get("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanRead), s.GetAllPekerjaanService)
+	pekerjaan.Get("/list", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanRead), s.GetAllPekerjaanPaginationService)
+	pekerjaan.Get("/export", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanRead), s.ExportPekerjaanService)
+	pekerjaan.Get("/trashed", middleware.AuthRequired(), s.GetTrashedPekerjaanService)
+	pekerjaan.Get("/alumni/:alumni_id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanRead), s.GetPekerjaanByAlumniIDService)
+	pekerjaan.Get("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanRead), s.GetPekerjaanByIDService)
 
-	// 🔹 CREATE & UPDATE (khusus admin)
-	pekerjaan.Post("/", middleware.AuthRequired(), middleware.AdminOnly(), service.CreatePekerjaanService)
-	pekerjaan.Put("/:id", middleware.AuthRequired(), middleware.AdminOnly(), service.UpdatePekerjaanService)
-	pekerjaan.Delete("/:id", middleware.AuthRequired(), middleware.AdminOnly(), service.DeletePekerjaanService)
+	// 🔹 CREATE & UPDATE (butuh permission)
+	pekerjaan.Post("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanWrite), s.CreatePekerjaanService)
+	pekerjaan.Put("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanWrite), s.UpdatePekerjaanService)
+	pekerjaan.Patch("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanWrite), s.PatchPekerjaanService)
+	pekerjaan.Delete("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermPekerjaanDelete), s.DeletePekerjaanService)
 
-	// 🔹 TRASH, RESTORE, HARD DELETE (pakai JWT role di service)
-	pekerjaan.Put("/:id/soft-delete", middleware.AuthRequired(), service.SoftDeletePekerjaanService)
-	pekerjaan.Put("/:id/restore", middleware.AuthRequired(), service.RestorePekerjaanService)
-	pekerjaan.Delete("/:id/hard-delete", middleware.AuthRequired(), service.HardDeletePekerjaanService)
+	// 🔹 TRASH, RESTORE, HARD DELETE (permission dicek di service: data sendiri vs semua alumni)
+	pekerjaan.Put("/:id/soft-delete", middleware.AuthRequired(), s.SoftDeletePekerjaanService)
+	pekerjaan.Put("/:id/restore", middleware.AuthRequired(), s.RestorePekerjaanService)
+	pekerjaan.Delete("/:id/hard-delete", middleware.AuthRequired(), s.HardDeletePekerjaanService)
 }
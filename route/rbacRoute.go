@@ -0,0 +1,19 @@
+package route
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/service"
+	"backendgo/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func RBACRoute(api fiber.Router, s *service.RBACService) {
+	api.Get("/permissions", middleware.AuthRequired(), middleware.RequirePermission(model.PermRolesManage), s.GetPermissionsService)
+
+	roles := api.Group("/roles")
+	roles.Get("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermRolesManage), s.GetRolesService)
+	roles.Post("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermRolesManage), s.CreateRoleService)
+	roles.Put("/:name", middleware.AuthRequired(), middleware.RequirePermission(model.PermRolesManage), s.UpdateRoleService)
+	roles.Delete("/:name", middleware.AuthRequired(), middleware.RequirePermission(model.PermRolesManage), s.DeleteRoleService)
+}
@@ -0,0 +1,22 @@
+package route
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/service"
+	"backendgo/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegistrationRoute endpoint pendaftaran mandiri. Harus didaftarkan sebelum AuthRoute
+// karena /register dan /register/verify publik.
+func RegistrationRoute(api fiber.Router, s *service.RegistrationService) {
+	api.Post("/register", s.RegisterService)
+	api.Post("/register/verify", s.VerifyRegistrationService)
+
+	regs := api.Group("/registrations")
+	regs.Get("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermRegistrationsManage), s.GetRegistrationsService)
+	regs.Post("/roster", middleware.AuthRequired(), middleware.RequirePermission(model.PermRegistrationsManage), s.ImportGraduateRosterService)
+	regs.Post("/:id/approve", middleware.AuthRequired(), middleware.RequirePermission(model.PermRegistrationsManage), s.ApproveRegistrationService)
+	regs.Post("/:id/reject", middleware.AuthRequired(), middleware.RequirePermission(model.PermRegistrationsManage), s.RejectRegistrationService)
+}
@@ -0,0 +1,14 @@
+package route
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/service"
+	"backendgo/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SearchRoute(api fiber.Router, s *service.SearchService) {
+	// Cukup salah satu permission; tipe hasil disaring lagi di service
+	api.Get("/search", middleware.AuthRequired(), middleware.RequirePermission(model.PermAlumniRead, model.PermPekerjaanRead), s.SearchService)
+}
@@ -0,0 +1,23 @@
+package route
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/service"
+	"backendgo/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func UserRoute(api fiber.Router, s *service.UserService) {
+	users := api.Group("/users")
+
+	users.Get("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.GetUsersService)
+	users.Post("/", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.CreateUserService)
+	users.Get("/:id", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.GetUserByIDService)
+	users.Put("/:id/status", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.UpdateUserStatusService)
+	users.Put("/:id/role", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.UpdateUserRoleService)
+	users.Put("/:id/alumni", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.LinkUserAlumniService)
+	users.Delete("/:id/alumni", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.UnlinkUserAlumniService)
+	users.Post("/:id/password-reset", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.AdminResetPasswordService)
+	users.Post("/:id/unlock", middleware.AuthRequired(), middleware.RequirePermission(model.PermUsersManage), s.UnlockUserService)
+}
@@ -0,0 +1,131 @@
+package test
+
+import (
+	"archive/zip"
+	"backendgo/utils"
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func buildXLSX(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadSpreadsheet_XLSX(t *testing.T) {
+	data := buildXLSX(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+			<sheets><sheet name="Lulusan" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+			<Relationship Id="rId1" Target="worksheets/data.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst><si><t>NIM</t></si><si><t>Nama</t></si><si><r><t>Budi </t></r><r><t>Santoso</t></r></si></sst>`,
+		"xl/worksheets/data.xml": `<worksheet><sheetData>
+			<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="D1" t="inlineStr"><is><t>Angkatan</t></is></c></row>
+			<row r="2"><c r="A2"><v>2101</v></c><c r="B2" t="s"><v>2</v></c><c r="D2"><v>2019</v></c></row>
+		</sheetData></worksheet>`,
+	})
+
+	rows, err := utils.ReadSpreadsheet("lulusan.XLSX", data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := [][]string{{"NIM", "Nama", "", "Angkatan"}, {"2101", "Budi Santoso", "", "2019"}}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d: %q", len(rows), len(want), rows)
+	}
+	for i := range want {
+		if strings.Join(rows[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("row %d: got %q, want %q", i, rows[i], want[i])
+		}
+	}
+}
+
+func TestReadSpreadsheet_UnsupportedFormat(t *testing.T) {
+	if _, err := utils.ReadSpreadsheet("lulusan.xls", []byte("x")); err == nil {
+		t.Fatal("expected error for .xls")
+	}
+	if _, err := utils.ReadSpreadsheet("lulusan.xlsx", []byte("bukan zip")); err == nil {
+		t.Fatal("expected error for invalid xlsx")
+	}
+}
+
+func TestMapAlumniImportRows(t *testing.T) {
+	rows, err := utils.ReadSpreadsheet("alumni.csv", []byte(
+		"NIM Mahasiswa,Nama Lengkap,Email,Angkatan,Tahun_Lulus,Status_Kematian\n"+
+			"2101,Budi,budi@example.com,2017,2021.0,tidak\n"+
+			",,,,,\n"+
+			"2102,Siti,siti-example.com,2018,2022,\n"+
+			"2101,Budi Lagi,budi2@example.com,2017,2021,\n"+
+			"2103,Andi,andi@example.com,2019,2018,mungkin\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid, errs, err := utils.MapAlumniImportRows(rows, map[string]string{
+		"nim":  "NIM Mahasiswa",
+		"nama": "nama lengkap",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(valid) != 1 || valid[0].Row != 2 || valid[0].Data.TahunLulus != 2021 || valid[0].Data.StatusKematian {
+		t.Fatalf("unexpected valid rows: %+v", valid)
+	}
+
+	got := map[string]bool{}
+	for _, e := range errs {
+		got[fmt.Sprintf("%s@%d", e.Column, e.Row)] = true
+	}
+	for _, want := range []string{"email@4", "nim@5", "tahun_lulus@6", "status_kematian@6"} {
+		if !got[want] {
+			t.Errorf("missing error %s in %+v", want, errs)
+		}
+	}
+	if len(errs) != 4 {
+		t.Errorf("expected 4 errors, got %+v", errs)
+	}
+}
+
+func TestMapAlumniImportRows_BadMapping(t *testing.T) {
+	rows := [][]string{{"nim", "nama", "email"}, {"1", "A", "a@example.com"}}
+	if _, _, err := utils.MapAlumniImportRows(rows, map[string]string{"gaji": "Gaji"}); err == nil {
+		t.Error("expected error for unknown mapping target")
+	}
+	if _, _, err := utils.MapAlumniImportRows(rows, map[string]string{"nim": "Nomor Induk"}); err == nil {
+		t.Error("expected error when mapped header is missing")
+	}
+	if _, _, err := utils.MapAlumniImportRows([][]string{{"nim", "nama"}}, nil); err == nil {
+		t.Error("expected error when email column is missing")
+	}
+}
+
+func TestBuildImportErrorReport(t *testing.T) {
+	rows := [][]string{{"nim", "nama", "email"}, {"1", "", "a@example.com"}}
+	_, errs, err := utils.MapAlumniImportRows(rows, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := utils.BuildImportErrorReport(errs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(report) != "baris,nim,kolom,pesan\n2,1,nama,wajib diisi\n" {
+		t.Errorf("unexpected report: %q", report)
+	}
+}
@@ -1,8 +1,9 @@
 package test
 
 import (
-	"backendgo/app/service"
+	"backendgo/app/model"
 	"bytes"
+	"encoding/json"
 	"net/http/httptest"
 	"testing"
 )
@@ -10,7 +11,7 @@ import (
 
 func TestGetAllAlumniService(t *testing.T) {
 	app := setupApp()
-	app.Get("/api/alumni", service.GetAllAlumniService)
+	app.Get("/api/alumni", newAlumniService().GetAllAlumniService)
 
 	req := httptest.NewRequest("GET", "/api/alumni", nil)
 	resp, _ := app.Test(req)
@@ -23,7 +24,7 @@ func TestGetAllAlumniService(t *testing.T) {
 
 func TestGetAlumniByID_InvalidID(t *testing.T) {
 	app := setupApp()
-	app.Get("/api/alumni/:id", service.GetAlumniByIDService)
+	app.Get("/api/alumni/:id", newAlumniService().GetAlumniByIDService)
 
 	req := httptest.NewRequest("GET", "/api/alumni/abc", nil)
 	resp, _ := app.Test(req)
@@ -36,7 +37,7 @@ func TestGetAlumniByID_InvalidID(t *testing.T) {
 
 func TestCreateAlumni_InvalidBody(t *testing.T) {
 	app := setupApp()
-	app.Post("/api/alumni", service.CreateAlumniService)
+	app.Post("/api/alumni", newAlumniService().CreateAlumniService)
 
 	body := bytes.NewBuffer([]byte(`{ invalid json }`))
 	req := httptest.NewRequest("POST", "/api/alumni", body)
@@ -51,7 +52,7 @@ func TestCreateAlumni_InvalidBody(t *testing.T) {
 
 func TestUpdateAlumni_InvalidID(t *testing.T) {
 	app := setupApp()
-	app.Put("/api/alumni/:id", service.UpdateAlumniService)
+	app.Put("/api/alumni/:id", newAlumniService().UpdateAlumniService)
 
 	body := bytes.NewBuffer([]byte(`{"nama":"Test"}`))
 	req := httptest.NewRequest("PUT", "/api/alumni/abc", body)
@@ -65,7 +66,7 @@ func TestUpdateAlumni_InvalidID(t *testing.T) {
 
 func TestUpdateAlumni_InvalidBody(t *testing.T) {
 	app := setupApp()
-	app.Put("/api/alumni/:id", service.UpdateAlumniService)
+	app.Put("/api/alumni/:id", newAlumniService().UpdateAlumniService)
 
 	body := bytes.NewBuffer([]byte(`{ invalid }`))
 	req := httptest.NewRequest("PUT", "/api/alumni/1", body)
@@ -80,7 +81,7 @@ func TestUpdateAlumni_InvalidBody(t *testing.T) {
 
 func TestDeleteAlumni_InvalidID(t *testing.T) {
 	app := setupApp()
-	app.Delete("/api/alumni/:id", service.DeleteAlumniService)
+	app.Delete("/api/alumni/:id", newAlumniService().DeleteAlumniService)
 
 	req := httptest.NewRequest("DELETE", "/api/alumni/xyz", nil)
 	resp, _ := app.Test(req)
@@ -93,7 +94,7 @@ func TestDeleteAlumni_InvalidID(t *testing.T) {
 
 func TestUpdateStatusKematian_InvalidID(t *testing.T) {
 	app := setupApp()
-	app.Put("/api/alumni/:id/kematian", service.UpdateStatusKematianService)
+	app.Put("/api/alumni/:id/kematian", newAlumniService().UpdateStatusKematianService)
 
 	body := bytes.NewBuffer([]byte(`{"status_kematian": true}`))
 	req := httptest.NewRequest("PUT", "/api/alumni/abc/kematian", body)
@@ -107,7 +108,7 @@ func TestUpdateStatusKematian_InvalidID(t *testing.T) {
 
 func TestUpdateStatusKematian_InvalidBody(t *testing.T) {
 	app := setupApp()
-	app.Put("/api/alumni/:id/kematian", service.UpdateStatusKematianService)
+	app.Put("/api/alumni/:id/kematian", newAlumniService().UpdateStatusKematianService)
 
 	body := bytes.NewBuffer([]byte(`{ invalid }`))
 	req := httptest.NewRequest("PUT", "/api/alumni/1/kematian", body)
@@ -119,10 +120,24 @@ func TestUpdateStatusKematian_InvalidBody(t *testing.T) {
 	}
 }
 
+func TestUpdateStatusKematian_UnknownAlumni(t *testing.T) {
+	app := setupApp()
+	app.Put("/api/alumni/:id/kematian", newAlumniService().UpdateStatusKematianService)
+
+	body := bytes.NewBuffer([]byte(`{"status_kematian": true}`))
+	req := httptest.NewRequest("PUT", "/api/alumni/99/kematian", body)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, _ := app.Test(req)
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected 404 for unknown alumni, got %d", resp.StatusCode)
+	}
+}
+
 
 func TestGetAlumniPagination(t *testing.T) {
 	app := setupApp()
-	app.Get("/api/alumni/pagination", service.GetAlumniWithPaginationService)
+	app.Get("/api/alumni/pagination", newAlumniService().GetAlumniWithPaginationService)
 
 	req := httptest.NewRequest("GET", "/api/alumni/pagination?page=1&limit=10", nil)
 	resp, _ := app.Test(req)
@@ -131,3 +146,34 @@ func TestGetAlumniPagination(t *testing.T) {
 		t.Errorf("Expected 200, got %d", resp.StatusCode)
 	}
 }
+
+func TestGetAlumniByID_FromRepository(t *testing.T) {
+	app := setupApp()
+	app.Get("/api/alumni/:id", newAlumniService().GetAlumniByIDService)
+
+	resp, _ := app.Test(httptest.NewRequest("GET", "/api/alumni/2", nil))
+	var body struct {
+		Data model.Alumni `json:"data"`
+	}
+	json.NewDecoder(resp.Body).Decode(&body)
+	if resp.StatusCode != 200 || body.Data.NIM != "20200002" {
+		t.Errorf("Expected alumni 2, got %d %+v", resp.StatusCode, body.Data)
+	}
+
+	resp, _ = app.Test(httptest.NewRequest("GET", "/api/alumni/99", nil))
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected 404 for unknown alumni, got %d", resp.StatusCode)
+	}
+}
+
+func TestGetAlumniPagination_Meta(t *testing.T) {
+	app := setupApp()
+	app.Get("/api/alumni/list", newAlumniService().GetAlumniWithPaginationService)
+
+	resp, _ := app.Test(httptest.NewRequest("GET", "/api/alumni/list?page=2&limit=1", nil))
+	var body model.AlumniResponse
+	json.NewDecoder(resp.Body).Decode(&body)
+	if resp.StatusCode != 200 || body.Meta.Total != 2 || body.Meta.Pages != 2 || len(body.Data) != 1 || body.Data[0].ID != 2 {
+		t.Errorf("unexpected page: %d %+v", resp.StatusCode, body)
+	}
+}
@@ -0,0 +1,190 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/modelmongo"
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/service"
+	serviceMongo "backendgo/app/serviceMongo"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestAlumniTrash_Lifecycle(t *testing.T) {
+	stubPermissions()
+	store := sampleStore()
+	alumniRepo := repositoryMemory.NewAlumniRepository(store)
+	fileRepo := repositoryMemory.NewFileRepository(store)
+	budi, _ := alumniRepo.GetByID(1)
+
+	foto := filepath.Join(t.TempDir(), "budi.png")
+	os.WriteFile(foto, []byte("png"), 0o644)
+	fileRepo.Create(&modelmongo.File{UserID: budi.UserID, FileName: "budi.png", FilePath: foto, FileCategory: "foto"})
+
+	pekerjaanMongo := repositoryMemory.NewPekerjaanMongoRepository(store)
+	aktif, _ := pekerjaanMongo.Create(modelmongo.PekerjaanAlumni{AlumniID: budi.ID, NamaPerusahaan: "PT Aktif", PosisiJabatan: "Engineer"})
+	lama, _ := pekerjaanMongo.Create(modelmongo.PekerjaanAlumni{AlumniID: budi.ID, NamaPerusahaan: "PT Lama", PosisiJabatan: "Engineer"})
+	pekerjaanMongo.SoftDelete(lama.ID.Hex())
+	pekerjaanMongo.Create(modelmongo.PekerjaanAlumni{AlumniID: 2, NamaPerusahaan: "PT Siti", PosisiJabatan: "Analyst"})
+
+	s := service.NewAlumniService(alumniRepo, repositoryMemory.NewUserRepository(store), fileRepo, pekerjaanMongo)
+	app := setupApp()
+	app.Get("/api/alumni/trashed", s.GetTrashedAlumniService)
+	app.Get("/api/alumni/list", s.GetAlumniWithPaginationService)
+	app.Get("/api/alumni/:id", s.GetAlumniByIDService)
+	app.Delete("/api/alumni/:id", s.DeleteAlumniService)
+	app.Put("/api/alumni/:id/soft-delete", s.SoftDeleteAlumniService)
+	app.Put("/api/alumni/:id/restore", s.RestoreAlumniService)
+	app.Delete("/api/alumni/:id/hard-delete", s.HardDeleteAlumniService)
+	app.Get("/api/files", asUser(budi.UserID, budi.ID, model.RoleUser), serviceMongo.NewFileService(fileRepo).GetAllFiles)
+
+	send := func(method, path string) (int, json.RawMessage) {
+		resp, err := app.Test(httptest.NewRequest(method, path, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var body struct {
+			Data json.RawMessage `json:"data"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+		return resp.StatusCode, body.Data
+	}
+	count := func(path string) int {
+		_, data := send("GET", path)
+		var list []json.RawMessage
+		json.Unmarshal(data, &list)
+		return len(list)
+	}
+
+	for _, tc := range []struct {
+		method, path string
+		status       int
+	}{
+		{"PUT", "/api/alumni/abc/soft-delete", fiber.StatusBadRequest},
+		{"PUT", "/api/alumni/99/soft-delete", fiber.StatusNotFound},
+		{"PUT", "/api/alumni/1/restore", fiber.StatusNotFound},
+		{"DELETE", "/api/alumni/1/hard-delete", fiber.StatusNotFound},
+		{"DELETE", "/api/alumni/1", fiber.StatusOK},
+		{"DELETE", "/api/alumni/1", fiber.StatusNotFound},
+		{"GET", "/api/alumni/1", fiber.StatusNotFound},
+	} {
+		if status, _ := send(tc.method, tc.path); status != tc.status {
+			t.Errorf("%s %s: expected %d, got %d", tc.method, tc.path, tc.status, status)
+		}
+	}
+
+	// alumni di trash hilang dari daftar, file-nya ikut tersembunyi
+	if n := count("/api/alumni/list"); n != 1 {
+		t.Errorf("expected 1 alumni in list, got %d", n)
+	}
+	if n := count("/api/alumni/trashed"); n != 1 {
+		t.Errorf("expected 1 alumni in trash, got %d", n)
+	}
+	if n := count("/api/files"); n != 0 {
+		t.Errorf("expected files of trashed alumni hidden, got %d", n)
+	}
+	if active, _ := pekerjaanMongo.GetByAlumni("1"); len(active) != 0 {
+		t.Errorf("expected MongoDB pekerjaan trashed with alumni, got %+v", active)
+	}
+
+	if status, _ := send("PUT", "/api/alumni/1/restore"); status != fiber.StatusOK {
+		t.Fatalf("restore: expected 200, got %d", status)
+	}
+	if n := count("/api/files"); n != 1 {
+		t.Errorf("expected file restored with alumni, got %d", n)
+	}
+	if n := count("/api/alumni/trashed"); n != 0 {
+		t.Errorf("expected empty trash after restore, got %d", n)
+	}
+	// hanya pekerjaan yang ikut di-trash bersama alumni yang dipulihkan
+	if active, _ := pekerjaanMongo.GetByAlumni("1"); len(active) != 1 || active[0].ID != aktif.ID {
+		t.Errorf("expected only PT Aktif restored in MongoDB, got %+v", active)
+	}
+	if trashed, _ := pekerjaanMongo.GetTrashedByAlumni(1); len(trashed) != 1 || trashed[0].ID != lama.ID {
+		t.Errorf("expected PT Lama to stay in MongoDB trash, got %+v", trashed)
+	}
+
+	// hard delete: akun user, record file, dan file fisik ikut dihapus
+	send("PUT", "/api/alumni/1/soft-delete")
+	if status, _ := send("DELETE", "/api/alumni/1/hard-delete"); status != fiber.StatusOK {
+		t.Fatalf("hard delete: expected 200, got %d", status)
+	}
+	if _, err := repositoryMemory.NewUserRepository(store).GetByID(budi.UserID); err != repository.ErrUserNotFound {
+		t.Errorf("expected user deleted, got %v", err)
+	}
+	if files, _ := fileRepo.FindAll(); len(files) != 0 {
+		t.Errorf("expected file records deleted, got %+v", files)
+	}
+	if _, err := os.Stat(foto); !os.IsNotExist(err) {
+		t.Errorf("expected file removed from disk, got %v", err)
+	}
+	if all, _ := pekerjaanMongo.GetAll(); len(all) != 1 || all[0].AlumniID != 2 {
+		t.Errorf("expected only other alumni's MongoDB pekerjaan left, got %+v", all)
+	}
+	if trashed, _ := pekerjaanMongo.GetTrashedByAlumni(1); len(trashed) != 0 {
+		t.Errorf("expected MongoDB trash of deleted alumni emptied, got %+v", trashed)
+	}
+	if status, _ := send("PUT", "/api/alumni/1/restore"); status != fiber.StatusNotFound {
+		t.Errorf("restore after hard delete: expected 404, got %d", status)
+	}
+}
+
+// Alumni yang ditautkan ke akun selain role user (admin, staf dengan role kustom): akun
+// tetap ada saat hard delete, jadi file-nya tidak boleh ikut di-trash maupun dihapus.
+func TestAlumniTrash_KeepsLinkedStaffAccounts(t *testing.T) {
+	for _, role := range []string{model.RoleAdmin, "operator_prodi"} {
+		t.Run(role, func(t *testing.T) {
+			store := sampleStore()
+			alumniRepo := repositoryMemory.NewAlumniRepository(store)
+			users := repositoryMemory.NewUserRepository(store)
+			fileRepo := repositoryMemory.NewFileRepository(store)
+
+			budi, _ := alumniRepo.GetByID(1)
+			users.UnlinkAlumni(budi.UserID)
+			staffID, err := users.Create("staf1", "staf1@example.com", "hash", role)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := users.LinkAlumni(staffID, budi.ID); err != nil {
+				t.Fatal(err)
+			}
+
+			foto := filepath.Join(t.TempDir(), "staf.png")
+			os.WriteFile(foto, []byte("png"), 0o644)
+			fileRepo.Create(&modelmongo.File{UserID: staffID, FileName: "staf.png", FilePath: foto, FileCategory: "foto"})
+
+			s := service.NewAlumniService(alumniRepo, users, fileRepo, repositoryMemory.NewPekerjaanMongoRepository(store))
+			app := setupApp()
+			app.Put("/api/alumni/:id/soft-delete", s.SoftDeleteAlumniService)
+			app.Delete("/api/alumni/:id/hard-delete", s.HardDeleteAlumniService)
+
+			resp, _ := app.Test(httptest.NewRequest("PUT", "/api/alumni/1/soft-delete", nil))
+			if resp.StatusCode != fiber.StatusOK {
+				t.Fatalf("soft delete: expected 200, got %d", resp.StatusCode)
+			}
+			if files, _ := fileRepo.FindByUser(staffID); len(files) != 1 || files[0].IsDeleted {
+				t.Errorf("expected %s file untouched by soft delete, got %+v", role, files)
+			}
+
+			resp, _ = app.Test(httptest.NewRequest("DELETE", "/api/alumni/1/hard-delete", nil))
+			if resp.StatusCode != fiber.StatusOK {
+				t.Fatalf("hard delete: expected 200, got %d", resp.StatusCode)
+			}
+			if _, err := users.GetByID(staffID); err != nil {
+				t.Errorf("expected %s account kept, got %v", role, err)
+			}
+			if files, _ := fileRepo.FindByUser(staffID); len(files) != 1 {
+				t.Errorf("expected %s file record kept, got %+v", role, files)
+			}
+			if _, err := os.Stat(foto); err != nil {
+				t.Errorf("expected %s file kept on disk, got %v", role, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package test
+
+import (
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/repositoryMongo"
+	serviceMongo "backendgo/app/serviceMongo"
+	"backendgo/apperror"
+	"backendgo/utils"
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+var (
+	idKinds          = []utils.CursorKind{utils.CursorInt}
+	errInvalidCursor = apperror.BadRequest("list.invalid_cursor")
+)
+
+func TestCursor_RoundTripAndSortCheck(t *testing.T) {
+	raw := utils.EncodeCursor(utils.Cursor{Sort: "id:asc", Values: []string{"10"}, Dir: utils.CursorNext})
+
+	cur, err := utils.DecodeCursor(raw, "id:asc", idKinds)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cur.Values[0] != "10" || cur.Dir != utils.CursorNext {
+		t.Errorf("unexpected cursor: %+v", cur)
+	}
+
+	if _, err := utils.DecodeCursor(raw, "nama:asc,id:asc", []utils.CursorKind{utils.CursorText, utils.CursorInt}); err == nil {
+		t.Error("cursor from another sort order should be rejected")
+	}
+	for _, bad := range []string{"bukan-cursor!", utils.EncodeCursor(utils.Cursor{Sort: "id:asc", Dir: "sideways", Values: []string{"1"}})} {
+		if _, err := utils.DecodeCursor(bad, "id:asc", idKinds); err == nil {
+			t.Errorf("%q: expected error", bad)
+		}
+	}
+}
+
+// cursor yang diubah client: nilai harus cocok dengan tipe kolom sort dan jumlah kolomnya
+func TestCursor_RejectsMalformedValues(t *testing.T) {
+	for _, c := range []struct {
+		sort   string
+		kinds  []utils.CursorKind
+		values []string
+	}{
+		{"id:asc", idKinds, []string{"abc"}},
+		{"id:asc", idKinds, []string{"1", "2"}},
+		{"id:asc", idKinds, nil},
+		{"status_kematian:asc,id:asc", []utils.CursorKind{utils.CursorBool, utils.CursorInt}, []string{"mungkin", "1"}},
+		{"created_at:asc,id:asc", []utils.CursorKind{utils.CursorTimestamp, utils.CursorInt}, []string{"kemarin", "1"}},
+		{repositoryMongo.PekerjaanMongoSortKey("desc"), repositoryMongo.PekerjaanMongoCursorKinds, []string{"2024-01-01T00:00:00Z", "bukan-objectid"}},
+		{repositoryMongo.PekerjaanMongoSortKey("desc"), repositoryMongo.PekerjaanMongoCursorKinds, []string{"kemarin", "650000000000000000000001"}},
+	} {
+		raw := utils.EncodeCursor(utils.Cursor{Sort: c.sort, Values: c.values, Dir: utils.CursorNext})
+		_, err := utils.DecodeCursor(raw, c.sort, c.kinds)
+		if !errors.Is(err, errInvalidCursor) {
+			t.Errorf("%s %v: expected invalid cursor, got %v", c.sort, c.values, err)
+		}
+	}
+}
+
+func TestBuildCursorPage(t *testing.T) {
+	ids := func(n ...int) []int { return n }
+	values := func(i int) []string { return []string{strconv.Itoa(i)} }
+
+	// halaman pertama, masih ada data berikutnya
+	list, page := utils.BuildCursorPage(ids(1, 2, 3), 2, nil, "id:asc", values)
+	if len(list) != 2 || page.Next == "" || page.Prev != "" {
+		t.Fatalf("first page: list=%v page=%+v", list, page)
+	}
+	next, _ := utils.DecodeCursor(page.Next, "id:asc", idKinds)
+	if next.Values[0] != "2" {
+		t.Errorf("next cursor should point at last row, got %v", next.Values)
+	}
+
+	// halaman terakhir lewat next
+	list, page = utils.BuildCursorPage(ids(3), 2, next, "id:asc", values)
+	if len(list) != 1 || page.Next != "" || page.Prev == "" {
+		t.Fatalf("last page: list=%v page=%+v", list, page)
+	}
+
+	// kembali lewat prev: hasil query terbalik (3 2 1) → dibalik jadi 2 3, masih ada data sebelumnya
+	prev, _ := utils.DecodeCursor(page.Prev, "id:asc", idKinds)
+	list, page = utils.BuildCursorPage(ids(2, 1, 0), 2, prev, "id:asc", values)
+	if len(list) != 2 || list[0] != 1 || list[1] != 2 || page.Next == "" || page.Prev == "" {
+		t.Fatalf("prev page: list=%v page=%+v", list, page)
+	}
+}
+
+func TestListQuery_CursorQuery(t *testing.T) {
+	q, err := repository.ParseListQuery(repository.AlumniListSpec, queryFrom(map[string]string{
+		"sort":    "-angkatan,nama",
+		"jurusan": "Informatika",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.SortKey() != "angkatan:desc,nama:asc,id:asc" {
+		t.Fatalf("unexpected sort key %q", q.SortKey())
+	}
+
+	clause, args, err := q.CursorQuery(&utils.Cursor{Values: []string{"2019", "Budi", "7"}, Dir: utils.CursorNext}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "WHERE LOWER(jurusan) = LOWER($1) AND ((angkatan < $2) OR (angkatan = $2 AND nama > $3) OR (angkatan = $2 AND nama = $3 AND id > $4)) ORDER BY angkatan DESC, nama ASC, id ASC LIMIT $5"
+	if clause != want {
+		t.Errorf("next:\n got %s\nwant %s", clause, want)
+	}
+	if len(args) != 5 || args[4] != 11 {
+		t.Errorf("unexpected args %v", args)
+	}
+
+	clause, _, _ = q.CursorQuery(&utils.Cursor{Values: []string{"2019", "Budi", "7"}, Dir: utils.CursorPrev}, 10)
+	want = "WHERE LOWER(jurusan) = LOWER($1) AND ((angkatan > $2) OR (angkatan = $2 AND nama < $3) OR (angkatan = $2 AND nama = $3 AND id < $4)) ORDER BY angkatan ASC, nama DESC, id DESC LIMIT $5"
+	if clause != want {
+		t.Errorf("prev:\n got %s\nwant %s", clause, want)
+	}
+
+	if _, _, err := q.CursorQuery(&utils.Cursor{Values: []string{"2019"}, Dir: utils.CursorNext}, 10); err == nil {
+		t.Error("cursor with wrong number of values should be rejected")
+	}
+	_, _, err = q.CursorQuery(&utils.Cursor{Values: []string{"abc", "Budi", "7"}, Dir: utils.CursorNext}, 10)
+	if !errors.Is(err, errInvalidCursor) {
+		t.Errorf("non-numeric angkatan: expected invalid cursor, got %v", err)
+	}
+}
+
+func TestListQuery_CursorRejectsNullableSort(t *testing.T) {
+	q, err := repository.ParseListQuery(repository.PekerjaanListSpec, queryFrom(map[string]string{"sortBy": "tanggal_selesai_kerja"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.CheckCursorSort(); err == nil {
+		t.Error("nullable sort column should not be allowed with cursor pagination")
+	}
+}
+
+func TestAlumniList_RejectsInvalidPaging(t *testing.T) {
+	app := setupApp()
+	app.Get("/api/alumni/list", newAlumniService().GetAlumniWithPaginationService)
+
+	for _, query := range []string{
+		"limit=0",
+		"limit=1000",
+		"page=0",
+		"limit=abc",
+		"pagination=cursor&limit=0",
+		"cursor=bukan-cursor",
+		"cursor=" + url.QueryEscape(utils.EncodeCursor(utils.Cursor{Sort: "id:asc", Values: []string{"abc"}, Dir: utils.CursorNext})),
+		"sortBy=angkatan&cursor=" + url.QueryEscape(utils.EncodeCursor(utils.Cursor{Sort: "angkatan:asc,id:asc", Values: []string{"2019", "1; DROP TABLE alumni"}, Dir: utils.CursorNext})),
+		"sortBy=password_hash",
+	} {
+		resp, err := app.Test(httptest.NewRequest("GET", "/api/alumni/list?"+query, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("%s: expected 400, got %d", query, resp.StatusCode)
+		}
+	}
+}
+
+func TestPekerjaanMongoList_RejectsTamperedCursor(t *testing.T) {
+	app := setupApp()
+	app.Get("/api/pekerjaan-mongo", serviceMongo.NewPekerjaanMongoService(repositoryMemory.NewPekerjaanMongoRepository(sampleStore())).GetAllPekerjaanMongoService)
+
+	sort := repositoryMongo.PekerjaanMongoSortKey("desc")
+	for _, values := range [][]string{
+		{"2024-01-01T00:00:00Z", "bukan-objectid"},
+		{"kemarin", "650000000000000000000001"},
+		{"2024-01-01T00:00:00Z"},
+	} {
+		raw := utils.EncodeCursor(utils.Cursor{Sort: sort, Values: values, Dir: utils.CursorNext})
+		resp, err := app.Test(httptest.NewRequest("GET", "/api/pekerjaan-mongo?cursor="+url.QueryEscape(raw), nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("%v: expected 400, got %d", values, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMongo"
+	"backendgo/apperror"
+	"backendgo/middleware"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lib/pq"
+)
+
+func errorEnvelope(t *testing.T, app *fiber.App, method, path string) (int, model.ErrorResponse, string) {
+	t.Helper()
+	resp, err := app.Test(httptest.NewRequest(method, path, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body model.ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("%s %s: body bukan JSON: %v", method, path, err)
+	}
+	return resp.StatusCode, body, resp.Header.Get(fiber.HeaderXRequestID)
+}
+
+func TestErrorHandler_MapsErrorsToEnvelope(t *testing.T) {
+	cases := map[string]struct {
+		err     error
+		status  int
+		code    string
+		message string
+	}{
+		"not found":     {apperror.NotFound("alumni.not_found"), 404, apperror.CodeNotFound, "Alumni tidak ditemukan"},
+		"conflict":      {apperror.Conflict("rbac.role_in_use", 3), 409, apperror.CodeConflict, "Role masih dipakai 3 user"},
+		"forbidden":     {middleware.ErrAlumniNotLinked, 403, apperror.CodeAlumniNotLinked, "Akun belum terhubung dengan data alumni"},
+		"validation":    {middleware.Validate(&model.CreateAlumniRequest{}), 422, apperror.CodeValidation, "Data tidak valid"},
+		"repo sentinel": {fmt.Errorf("hapus file: %w", repositoryMongo.ErrFileNotFound), 404, apperror.CodeNotFound, "File tidak ditemukan"},
+		"repo conflict": {repository.ErrRegistrationNotPending, 409, apperror.CodeConflict, "Pendaftaran tidak sedang menunggu persetujuan"},
+		"sql no rows":   {fmt.Errorf("get alumni: %w", sql.ErrNoRows), 404, apperror.CodeNotFound, "Data tidak ditemukan"},
+		"unique":        {&pq.Error{Code: "23505"}, 409, apperror.CodeConflict, "Data sudah ada"},
+		"fiber error":   {fiber.ErrMethodNotAllowed, 405, "METHOD_NOT_ALLOWED", "Method Not Allowed"},
+		"driver error":  {errors.New("pq: connection refused"), 500, apperror.CodeInternal, "Terjadi kesalahan pada server"},
+		"internal": {
+			apperror.Internal("alumni.create_failed").Wrap(errors.New("pq: deadlock detected")),
+			500, apperror.CodeInternal, "Gagal menyimpan alumni",
+		},
+	}
+
+	for name, tc := range cases {
+		app := setupApp()
+		app.Get("/x", func(c *fiber.Ctx) error { return tc.err })
+
+		status, body, requestID := errorEnvelope(t, app, "GET", "/x")
+		if status != tc.status {
+			t.Errorf("%s: expected %d, got %d", name, tc.status, status)
+		}
+		if body.Success || body.Error.Code != tc.code || body.Error.Message != tc.message {
+			t.Errorf("%s: unexpected body %+v", name, body)
+		}
+		if requestID == "" || body.Error.RequestID != requestID {
+			t.Errorf("%s: request_id %q tidak sama dengan header %q", name, body.Error.RequestID, requestID)
+		}
+	}
+}
+
+func TestErrorHandler_ValidationDetails(t *testing.T) {
+	app := setupApp()
+	app.Get("/x", func(c *fiber.Ctx) error {
+		return middleware.Validate(&model.CreateAlumniRequest{NIM: "1"})
+	})
+
+	resp, _ := app.Test(httptest.NewRequest("GET", "/x", nil))
+	var body struct {
+		Error struct {
+			Details []model.FieldError `json:"details"`
+		} `json:"error"`
+	}
+	json.NewDecoder(resp.Body).Decode(&body)
+	if got := fieldErrors(body.Error.Details); got["nim"] == "" || got["nama"] == "" {
+		t.Errorf("expected field errors for nim and nama, got %v", got)
+	}
+}
+
+func TestErrorHandler_UnknownRouteAndClientRequestID(t *testing.T) {
+	app := setupApp()
+
+	req := httptest.NewRequest("GET", "/api/tidak-ada", nil)
+	req.Header.Set(fiber.HeaderXRequestID, "req-123")
+	resp, _ := app.Test(req)
+	if resp.StatusCode != 404 {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+	var body model.ErrorResponse
+	json.NewDecoder(resp.Body).Decode(&body)
+	if body.Error.Code != apperror.CodeNotFound || body.Error.RequestID != "req-123" {
+		t.Errorf("unexpected body %+v", body)
+	}
+}
+
+func TestAppError_SentinelsSurviveCopies(t *testing.T) {
+	err := fmt.Errorf("link: %w", middleware.ErrAlumniNotLinked.WithDetails(fiber.Map{"user_id": 1}))
+	if !errors.Is(err, middleware.ErrAlumniNotLinked) {
+		t.Error("expected errors.Is to match sentinel after WithDetails")
+	}
+	if middleware.ErrAlumniNotLinked.Details != nil {
+		t.Error("WithDetails must not modify the sentinel")
+	}
+	if errors.Is(err, apperror.Forbidden("auth.access_denied")) {
+		t.Error("different key must not match")
+	}
+}
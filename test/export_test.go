@@ -0,0 +1,105 @@
+package test
+
+import (
+	"backendgo/utils"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func writeTable(t *testing.T, format string, rows [][]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw, err := utils.NewTableWriter(format, &buf, "Data Alumni", []float64{1, 2, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.WriteHeader([]string{"NIM", "Nama", "Status"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rows {
+		if err := tw.WriteRow(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestTableWriter_UnknownFormat(t *testing.T) {
+	if _, err := utils.NewTableWriter("docx", &bytes.Buffer{}, "", nil); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
+
+func TestTableWriter_CSVAndXLSXRoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"2101", "Budi, S.Kom", "Hidup"},
+		{"2102", `Siti "Ami" <Aminah> & co`, "Wafat"},
+	}
+	for _, format := range []string{utils.ExportCSV, utils.ExportXLSX} {
+		got, err := utils.ReadSpreadsheet("export."+format, writeTable(t, format, rows))
+		if err != nil {
+			t.Fatalf("%s: read back: %v", format, err)
+		}
+		want := append([][]string{{"NIM", "Nama", "Status"}}, rows...)
+		if len(got) != len(want) {
+			t.Fatalf("%s: got %d rows, want %d", format, len(got), len(want))
+		}
+		for i := range want {
+			if strings.Join(got[i], "|") != strings.Join(want[i], "|") {
+				t.Errorf("%s row %d: got %q, want %q", format, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestTableWriter_PDF(t *testing.T) {
+	var rows [][]string
+	for i := 0; i < 120; i++ {
+		rows = append(rows, []string{strconv.Itoa(2100 + i), fmt.Sprintf("Alumni (%d) \\ Ñoño 漢字", i), "Hidup"})
+	}
+	pdf := writeTable(t, utils.ExportPDF, rows)
+
+	if !bytes.HasPrefix(pdf, []byte("%PDF-1.4")) || !bytes.HasSuffix(pdf, []byte("%%EOF\n")) {
+		t.Fatal("missing PDF header or trailer")
+	}
+
+	// 120 baris tidak muat di satu halaman A4 landscape
+	count := regexp.MustCompile(`/Count (\d+)`).FindSubmatch(pdf)
+	if count == nil {
+		t.Fatal("missing /Count in Pages object")
+	}
+	if n, _ := strconv.Atoi(string(count[1])); n < 2 {
+		t.Errorf("expected multiple pages, got %d", n)
+	}
+
+	// startxref dan setiap entri xref harus menunjuk ke posisi yang benar
+	start := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	if start == nil {
+		t.Fatal("missing startxref")
+	}
+	xrefAt, _ := strconv.Atoi(string(start[1]))
+	if !bytes.HasPrefix(pdf[xrefAt:], []byte("xref\n")) {
+		t.Fatalf("startxref %d does not point to xref table", xrefAt)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(pdf[xrefAt:], -1)
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		if !bytes.HasPrefix(pdf[off:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))) {
+			t.Errorf("xref entry for object %d points to wrong offset %d", i+1, off)
+		}
+	}
+}
+
+func TestTableWriter_PDFEmpty(t *testing.T) {
+	pdf := writeTable(t, utils.ExportPDF, nil)
+	if !bytes.Contains(pdf, []byte("/Count 1")) {
+		t.Error("empty export should still produce one page")
+	}
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"backendgo/app/modelmongo"
+	"backendgo/app/serviceMongo"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type fakeFileRepo struct {
+	files []modelmongo.File
+}
+
+func (r *fakeFileRepo) Create(file *modelmongo.File) error {
+	file.ID = primitive.NewObjectID()
+	r.files = append(r.files, *file)
+	return nil
+}
+
+func (r *fakeFileRepo) FindAll() ([]modelmongo.File, error) {
+	return append([]modelmongo.File(nil), r.files...), nil
+}
+
+func (r *fakeFileRepo) FindByUser(userID int) ([]modelmongo.File, error) {
+	var out []modelmongo.File
+	for _, f := range r.files {
+		if f.UserID == userID {
+			out = append(out, f)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeFileRepo) Delete(id string) error {
+	for i, f := range r.files {
+		if f.ID.Hex() == id {
+			r.files = append(r.files[:i], r.files[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("file tidak ditemukan")
+}
+
+func (r *fakeFileRepo) SetDeletedByUser(userID int, deleted bool) error {
+	for i := range r.files {
+		if r.files[i].UserID == userID {
+			r.files[i].IsDeleted = deleted
+		}
+	}
+	return nil
+}
+
+func (r *fakeFileRepo) DeleteByUser(userID int) ([]modelmongo.File, error) {
+	var deleted, kept []modelmongo.File
+	for _, f := range r.files {
+		if f.UserID == userID {
+			deleted = append(deleted, f)
+		} else {
+			kept = append(kept, f)
+		}
+	}
+	r.files = kept
+	return deleted, nil
+}
+
+func TestReconcileFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string) string {
+		path := filepath.Join(dir, "foto", name)
+		os.MkdirAll(filepath.Dir(path), 0o755)
+		if err := os.WriteFile(path, []byte("x"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	repo := &fakeFileRepo{}
+	repo.Create(&modelmongo.File{UserID: 1, FilePath: write("ok.png")})
+	repo.Create(&modelmongo.File{UserID: 1, FilePath: filepath.Join(dir, "foto", "hilang.png")})
+	repo.Create(&modelmongo.File{UserID: 99, FilePath: write("tanpa-user.png")})
+	orphan := write("orphan.png")
+	write(".gitkeep")
+
+	users := func(ids []int) (map[int]bool, error) { return map[int]bool{1: true}, nil }
+
+	report, err := serviceMongo.ReconcileFiles(repo, dir, users, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Checked != 3 || len(report.MissingOnDisk) != 1 || len(report.OrphanOwner) != 1 ||
+		len(report.OrphanOnDisk) != 1 || report.OrphanOnDisk[0] != orphan || report.Removed != 0 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if len(repo.files) != 3 {
+		t.Fatal("report-only mode must not delete records")
+	}
+
+	report, err = serviceMongo.ReconcileFiles(repo, dir, users, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Removed != 3 || len(repo.files) != 1 || repo.files[0].FilePath != filepath.Join(dir, "foto", "ok.png") {
+		t.Fatalf("fix: removed=%d files=%+v", report.Removed, repo.files)
+	}
+	if _, err := os.Stat(orphan); !os.IsNotExist(err) {
+		t.Error("orphan file should be removed")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "foto", "tanpa-user.png")); !os.IsNotExist(err) {
+		t.Error("file of deleted user should be removed")
+	}
+}
@@ -1,10 +1,12 @@
 package test
 
 import (
+    "backendgo/app/modelmongo"
+    "backendgo/app/repositoryMemory"
     serviceMongo "backendgo/app/serviceMongo"
-    "backendgo/database"
 
     "bytes"
+    "encoding/json"
     "mime/multipart"
     "net/http/httptest"
     "testing"
@@ -26,14 +28,13 @@ func createMultipartFile(field, filename string, content []byte) (*bytes.Buffer,
 
 
 func TestUploadFile_NoFile(t *testing.T) {
-    
-    database.MongoDB = nil
+
 
     app := setupApp()
     app.Post("/api/files/upload", func(c *fiber.Ctx) error {
         c.Locals("role", "user")
         c.Locals("user_id", 10)
-        return serviceMongo.UploadFile(c)
+        return serviceMongo.NewFileService(nil).UploadFile(c)
     })
 
     req := httptest.NewRequest("POST", "/api/files/upload", nil)
@@ -45,13 +46,11 @@ func TestUploadFile_NoFile(t *testing.T) {
 }
 
 func TestUploadFile_WrongFormat(t *testing.T) {
-    database.MongoDB = nil
-
     app := setupApp()
     app.Post("/api/files/upload", func(c *fiber.Ctx) error {
         c.Locals("role", "user")
         c.Locals("user_id", 10)
-        return serviceMongo.UploadFile(c)
+        return serviceMongo.NewFileService(nil).UploadFile(c)
     })
 
     body, contentType := createMultipartFile("file", "test.txt", []byte("hai"))
@@ -68,13 +67,11 @@ func TestUploadFile_WrongFormat(t *testing.T) {
 
 
 func TestGetAllFiles_NoMongo(t *testing.T) {
-    database.MongoDB = nil
-
     app := setupApp()
     app.Get("/api/files", func(c *fiber.Ctx) error {
         c.Locals("role", "user")
         c.Locals("user_id", 10)
-        return serviceMongo.GetAllFiles(c)
+        return serviceMongo.NewFileService(nil).GetAllFiles(c)
     })
 
     req := httptest.NewRequest("GET", "/api/files", nil)
@@ -87,13 +84,11 @@ func TestGetAllFiles_NoMongo(t *testing.T) {
 
 
 func TestGetFileByID_InvalidID(t *testing.T) {
-    database.MongoDB = nil
-
     app := setupApp()
     app.Get("/api/files/:id", func(c *fiber.Ctx) error {
         c.Locals("role", "user")
         c.Locals("user_id", 10)
-        return serviceMongo.GetFileByID(c)
+        return serviceMongo.NewFileService(nil).GetFileByID(c)
     })
 
     req := httptest.NewRequest("GET", "/api/files/invalid-hex", nil)
@@ -107,13 +102,11 @@ func TestGetFileByID_InvalidID(t *testing.T) {
 
 
 func TestDeleteFile_InvalidID(t *testing.T) {
-    database.MongoDB = nil
-
     app := setupApp()
     app.Delete("/api/files/:id", func(c *fiber.Ctx) error {
         c.Locals("role", "user")
         c.Locals("user_id", 10)
-        return serviceMongo.DeleteFile(c)
+        return serviceMongo.NewFileService(nil).DeleteFile(c)
     })
 
     req := httptest.NewRequest("DELETE", "/api/files/salahID", nil)
@@ -124,3 +117,80 @@ func TestDeleteFile_InvalidID(t *testing.T) {
         t.Errorf("Expected 500 because MongoDB nil, got %d", resp.StatusCode)
     }
 }
+
+
+func newFileService() (*serviceMongo.FileService, []modelmongo.File) {
+    repo := repositoryMemory.NewFileRepository(repositoryMemory.NewStore())
+    files := []modelmongo.File{
+        {UserID: 10, FileName: "a.png", FilePath: "uploads/foto/tidak-ada-a.png", FileCategory: "foto"},
+        {UserID: 11, FileName: "b.pdf", FilePath: "uploads/sertifikat/tidak-ada-b.pdf", FileCategory: "sertifikat"},
+    }
+    for i := range files {
+        repo.Create(&files[i])
+    }
+    return serviceMongo.NewFileService(repo), files
+}
+
+func TestUploadFile_WrongFormatWithRepository(t *testing.T) {
+    stubPermissions()
+    files, _ := newFileService()
+
+    app := setupApp()
+    app.Post("/api/files/upload", asUser(10, 0, "user"), files.UploadFile)
+
+    body, contentType := createMultipartFile("file", "test.txt", []byte("hai"))
+    req := httptest.NewRequest("POST", "/api/files/upload", body)
+    req.Header.Set("Content-Type", contentType)
+
+    resp, _ := app.Test(req)
+    if resp.StatusCode != 400 {
+        t.Errorf("Expected 400 for non-image foto, got %d", resp.StatusCode)
+    }
+}
+
+func TestGetAllFiles_OwnOrAll(t *testing.T) {
+    stubPermissions()
+    files, _ := newFileService()
+
+    app := setupApp()
+    app.Get("/user", asUser(10, 0, "user"), files.GetAllFiles)
+    app.Get("/admin", asUser(1, 0, "admin"), files.GetAllFiles)
+
+    for path, want := range map[string]int{"/user": 1, "/admin": 2} {
+        resp, _ := app.Test(httptest.NewRequest("GET", path, nil))
+        var body struct {
+            Data []modelmongo.File `json:"data"`
+        }
+        json.NewDecoder(resp.Body).Decode(&body)
+        if resp.StatusCode != 200 || len(body.Data) != want {
+            t.Errorf("%s: expected %d files, got %d (status %d)", path, want, len(body.Data), resp.StatusCode)
+        }
+    }
+}
+
+func TestDeleteFile_Ownership(t *testing.T) {
+    stubPermissions()
+    files, data := newFileService()
+
+    app := setupApp()
+    app.Delete("/api/files/:id", asUser(10, 0, "user"), files.DeleteFile)
+    app.Get("/api/files/:id", asUser(10, 0, "user"), files.GetFileByID)
+
+    other := "/api/files/" + data[1].ID.Hex()
+    own := "/api/files/" + data[0].ID.Hex()
+
+    resp, _ := app.Test(httptest.NewRequest("DELETE", other, nil))
+    if resp.StatusCode != 403 {
+        t.Errorf("Expected 403 deleting another user's file, got %d", resp.StatusCode)
+    }
+
+    resp, _ = app.Test(httptest.NewRequest("DELETE", own, nil))
+    if resp.StatusCode != 200 {
+        t.Errorf("Expected 200 deleting own file, got %d", resp.StatusCode)
+    }
+
+    resp, _ = app.Test(httptest.NewRequest("GET", own, nil))
+    if resp.StatusCode != 404 {
+        t.Errorf("Expected 404 after delete, got %d", resp.StatusCode)
+    }
+}
@@ -0,0 +1,138 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/i18n"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestNegotiateLanguage(t *testing.T) {
+	cases := map[string]string{
+		"":                        i18n.Indonesian,
+		"en":                      i18n.English,
+		"en-US,en;q=0.9":          i18n.English,
+		"id-ID,en;q=0.8":          i18n.Indonesian,
+		"fr-FR,en;q=0.5,id;q=0.4": i18n.English,
+		"id;q=0.3,EN-gb;q=0.7":    i18n.English,
+		"fr, de":                  i18n.Indonesian,
+		"*":                       i18n.Indonesian,
+		"en;q=0, id;q=0.1":        i18n.Indonesian,
+		"en;q=abc":                i18n.Indonesian,
+	}
+	for header, want := range cases {
+		if got := i18n.Negotiate(header); got != want {
+			t.Errorf("Negotiate(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestTranslate_FallbackAndArgs(t *testing.T) {
+	if got := i18n.Translate(i18n.English, "auth.login_locked", 30); got != "Too many failed login attempts, try again in 30 seconds" {
+		t.Errorf("unexpected translation %q", got)
+	}
+	if got := i18n.Translate("fr", "alumni.not_found"); got != "Alumni tidak ditemukan" {
+		t.Errorf("unknown language should fall back to Indonesian, got %q", got)
+	}
+	if got := i18n.Translate(i18n.English, "CSV tidak valid: baris 3"); got != "CSV tidak valid: baris 3" {
+		t.Errorf("free text should pass through, got %q", got)
+	}
+}
+
+// semua key yang dipakai kode harus ada di kedua katalog
+func TestMessageCatalog_CoversAllKeys(t *testing.T) {
+	usage := regexp.MustCompile(`(?:apperror\.\w+\(|i18n\.T\(c, |return )"((?:common|list|auth|password|mfa|user|rbac|alumni|import|pekerjaan|search|registration|file|validation)\.[a-z_]+)"`)
+
+	found := 0
+	for _, dir := range []string{"../app", "../middleware", "../utils"} {
+		filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || !strings.HasSuffix(path, ".go") {
+				return err
+			}
+			src, _ := os.ReadFile(path)
+			for _, m := range usage.FindAllStringSubmatch(string(src), -1) {
+				found++
+				for _, lang := range []string{i18n.Indonesian, i18n.English} {
+					if !i18n.Has(lang, m[1]) {
+						t.Errorf("%s: key %s belum ada di katalog %s", path, m[1], lang)
+					}
+				}
+			}
+			return nil
+		})
+	}
+	if found < 100 {
+		t.Errorf("expected to find message keys in the sources, found %d", found)
+	}
+}
+
+func TestErrorResponse_English(t *testing.T) {
+	app := setupApp()
+	app.Get("/api/me/alumni", asUser(10, 0, "user"), newMeService().GetMyAlumniService)
+
+	req := httptest.NewRequest("GET", "/api/me/alumni", nil)
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	resp, _ := app.Test(req)
+	if resp.StatusCode != 403 {
+		t.Fatalf("expected 403, got %d", resp.StatusCode)
+	}
+	if lang := resp.Header.Get("Content-Language"); lang != "en" {
+		t.Errorf("expected Content-Language en, got %q", lang)
+	}
+	var body model.ErrorResponse
+	json.NewDecoder(resp.Body).Decode(&body)
+	if body.Error.Message != "Your account is not linked to an alumni record" {
+		t.Errorf("unexpected message %q", body.Error.Message)
+	}
+}
+
+func TestValidationErrors_English(t *testing.T) {
+	app := setupApp()
+	app.Post("/api/alumni", newAlumniService().CreateAlumniService)
+
+	req := httptest.NewRequest("POST", "/api/alumni", strings.NewReader(`{"nim":"12345678","nama":"A","jurusan":"TI","angkatan":2020,"tahun_lulus":2019,"email":"x"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "en")
+	resp, _ := app.Test(req)
+	if resp.StatusCode != 422 {
+		t.Fatalf("expected 422, got %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Error struct {
+			Message string             `json:"message"`
+			Details []model.FieldError `json:"details"`
+		} `json:"error"`
+	}
+	json.NewDecoder(resp.Body).Decode(&body)
+	got := fieldErrors(body.Error.Details)
+	if body.Error.Message != "Invalid data" {
+		t.Errorf("unexpected message %q", body.Error.Message)
+	}
+	if got["email"] != "invalid email format" || got["tahun_lulus"] != "must not be less than angkatan" {
+		t.Errorf("unexpected field errors %v", got)
+	}
+}
+
+func TestSuccessMessage_FollowsLanguage(t *testing.T) {
+	for lang, want := range map[string]string{"": "Alumni berhasil dibuat", "en": "Alumni created"} {
+		app := setupApp()
+		app.Post("/api/alumni", newAlumniService().CreateAlumniService)
+
+		req := httptest.NewRequest("POST", "/api/alumni", strings.NewReader(`{"nim":"99990001","nama":"Baru","jurusan":"TI","angkatan":2020,"tahun_lulus":2024,"email":"baru.i18n@example.com"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", lang)
+		resp, _ := app.Test(req)
+
+		var body map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&body)
+		if body["message"] != want {
+			t.Errorf("Accept-Language %q: expected %q, got %v (status %d)", lang, want, body["message"], resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package test
+
+import (
+	"backendgo/utils"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestKeyStore_SignAndVerifyAcrossRotation(t *testing.T) {
+	for _, alg := range []string{utils.AlgRS256, utils.AlgEdDSA} {
+		t.Run(alg, func(t *testing.T) {
+			ks, err := utils.NewKeyStore(t.TempDir(), alg, nil)
+			if err != nil {
+				t.Fatalf("NewKeyStore: %v", err)
+			}
+
+			claims := jwt.MapClaims{"user_id": 1, "exp": time.Now().Add(time.Minute).Unix()}
+			oldToken, err := ks.Sign(claims)
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+
+			if err := ks.Rotate(); err != nil {
+				t.Fatalf("Rotate: %v", err)
+			}
+			newToken, _ := ks.Sign(claims)
+
+			// token yang ditandatangani kunci lama tetap valid setelah rotasi
+			for _, tok := range []string{oldToken, newToken} {
+				if _, err := ks.Parse(tok); err != nil {
+					t.Errorf("Parse: %v", err)
+				}
+			}
+
+			if got := len(ks.JWKS().Keys); got != 2 {
+				t.Errorf("Expected 2 keys in JWKS, got %d", got)
+			}
+		})
+	}
+}
+
+func TestKeyStore_ReloadPicksUpKeysFromDir(t *testing.T) {
+	dir := t.TempDir()
+	signer, err := utils.NewKeyStore(dir, utils.AlgEdDSA, nil)
+	if err != nil {
+		t.Fatalf("NewKeyStore: %v", err)
+	}
+	verifier, _ := utils.NewKeyStore(dir, utils.AlgEdDSA, nil)
+
+	claims := jwt.MapClaims{"exp": time.Now().Add(time.Minute).Unix()}
+	signer.Rotate()
+	token, _ := signer.Sign(claims)
+
+	// kid baru dari instance lain langsung dikenali lewat reload otomatis
+	if _, err := verifier.Parse(token); err != nil {
+		t.Fatalf("Expected kid rotated by another instance to be reloaded, got %v", err)
+	}
+
+	// reload otomatis dibatasi; rotasi berikutnya baru dikenali setelah Reload
+	signer.Rotate()
+	token, _ = signer.Sign(claims)
+	if _, err := verifier.Parse(token); err == nil {
+		t.Fatal("Expected unknown kid while automatic reload is rate limited")
+	}
+	verifier.Reload()
+	if _, err := verifier.Parse(token); err != nil {
+		t.Errorf("Expected valid token after reload, got %v", err)
+	}
+}
+
+func TestKeyStore_RejectsOtherAlgorithm(t *testing.T) {
+	hs, _ := utils.NewKeyStore("", utils.AlgHS256, []byte("secret"))
+	ed, _ := utils.NewKeyStore(t.TempDir(), utils.AlgEdDSA, nil)
+
+	token, _ := hs.Sign(jwt.MapClaims{"exp": time.Now().Add(time.Minute).Unix()})
+	if _, err := ed.Parse(token); err == nil {
+		t.Error("Expected HS256 token to be rejected by EdDSA key store")
+	}
+}
+
+func TestKeyStore_PruneKeepsActiveKey(t *testing.T) {
+	dir := t.TempDir()
+	ks, _ := utils.NewKeyStore(dir, utils.AlgEdDSA, nil)
+
+	old := time.Now().Add(-48 * time.Hour)
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.pem"))
+	for _, m := range matches {
+		os.Chtimes(m, old, old)
+	}
+	ks.Reload()
+	ks.Rotate()
+	ks.Prune(time.Hour)
+
+	matches, _ = filepath.Glob(filepath.Join(dir, "*.pem"))
+	if len(matches) != 1 {
+		t.Errorf("Expected only the active key to remain, got %d files", len(matches))
+	}
+}
+
+func tokenKid(t *testing.T, token string) string {
+	t.Helper()
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified: %v", err)
+	}
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+// withKid ganti header kid token tanpa menandatangani ulang
+func withKid(t *testing.T, token, kid string) string {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	header := map[string]interface{}{}
+	raw, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	json.Unmarshal(raw, &header)
+	if kid == "" {
+		delete(header, "kid")
+	} else {
+		header["kid"] = kid
+	}
+	raw, _ = json.Marshal(header)
+	parts[0] = base64.RawURLEncoding.EncodeToString(raw)
+	return strings.Join(parts, ".")
+}
+
+func TestKeyStore_SignsWithNewestKid(t *testing.T) {
+	dir := t.TempDir()
+	ks, _ := utils.NewKeyStore(dir, utils.AlgEdDSA, nil)
+	claims := jwt.MapClaims{"exp": time.Now().Add(time.Minute).Unix()}
+
+	oldToken, _ := ks.Sign(claims)
+	ks.Rotate()
+	newToken, _ := ks.Sign(claims)
+
+	keys := ks.JWKS().Keys
+	if len(keys) != 2 {
+		t.Fatalf("Expected 2 keys, got %d", len(keys))
+	}
+	// JWKS urut dari kunci paling lama ke paling baru
+	if got := tokenKid(t, oldToken); got != keys[0].Kid {
+		t.Errorf("Expected old token kid %s, got %s", keys[0].Kid, got)
+	}
+	if got := tokenKid(t, newToken); got != keys[1].Kid {
+		t.Errorf("Expected new token kid %s, got %s", keys[1].Kid, got)
+	}
+
+	// instance lain yang membaca direktori yang sama memakai kunci terbaru untuk sign
+	other, _ := utils.NewKeyStore(dir, utils.AlgEdDSA, nil)
+	token, _ := other.Sign(claims)
+	if got := tokenKid(t, token); got != keys[1].Kid {
+		t.Errorf("Expected reloaded store to sign with newest kid %s, got %s", keys[1].Kid, got)
+	}
+}
+
+func TestKeyStore_ParseSelectsKeyByKid(t *testing.T) {
+	ks, _ := utils.NewKeyStore(t.TempDir(), utils.AlgEdDSA, nil)
+	claims := jwt.MapClaims{"exp": time.Now().Add(time.Minute).Unix()}
+	oldToken, _ := ks.Sign(claims)
+	ks.Rotate()
+	newKid := tokenKid(t, mustSign(t, ks, claims))
+
+	if _, err := ks.Parse(oldToken); err != nil {
+		t.Fatalf("Expected old token valid with its own kid, got %v", err)
+	}
+	// kid menunjuk kunci lain → signature tidak cocok
+	for name, tok := range map[string]string{
+		"other kid":   withKid(t, oldToken, newKid),
+		"unknown kid": withKid(t, oldToken, "kid-palsu"),
+		"no kid":      withKid(t, oldToken, ""),
+	} {
+		if _, err := ks.Parse(tok); err == nil {
+			t.Errorf("%s: expected token to be rejected", name)
+		}
+	}
+}
+
+func mustSign(t *testing.T, ks *utils.KeyStore, claims jwt.Claims) string {
+	t.Helper()
+	token, err := ks.Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return token
+}
+
+// Token bisa diverifikasi pihak lain hanya dengan JWKS (tanpa akses ke kunci privat)
+func TestKeyStore_JWKSVerifiesTokens(t *testing.T) {
+	for _, alg := range []string{utils.AlgRS256, utils.AlgEdDSA} {
+		t.Run(alg, func(t *testing.T) {
+			ks, _ := utils.NewKeyStore(t.TempDir(), alg, nil)
+			token := mustSign(t, ks, jwt.MapClaims{"user_id": 7, "exp": time.Now().Add(time.Minute).Unix()})
+
+			keys := map[string]interface{}{}
+			for _, k := range ks.JWKS().Keys {
+				switch k.Kty {
+				case "RSA":
+					n, _ := base64.RawURLEncoding.DecodeString(k.N)
+					e, _ := base64.RawURLEncoding.DecodeString(k.E)
+					keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+				case "OKP":
+					x, _ := base64.RawURLEncoding.DecodeString(k.X)
+					keys[k.Kid] = ed25519.PublicKey(x)
+				}
+			}
+
+			parsed, err := jwt.Parse(token, func(tok *jwt.Token) (interface{}, error) {
+				kid, _ := tok.Header["kid"].(string)
+				return keys[kid], nil
+			}, jwt.WithValidMethods([]string{alg}))
+			if err != nil || !parsed.Valid {
+				t.Errorf("Expected token verifiable from JWKS, got %v", err)
+			}
+		})
+	}
+}
+
+func TestKeyStore_HS256HasNoRotationOrJWKS(t *testing.T) {
+	ks, _ := utils.NewKeyStore("", utils.AlgHS256, []byte("secret"))
+	if err := ks.Rotate(); err == nil {
+		t.Error("Expected rotation to be refused for HS256")
+	}
+	if keys := ks.JWKS().Keys; len(keys) != 0 {
+		t.Errorf("HS256 secret must not be published, got %+v", keys)
+	}
+}
+
+func TestKeyStore_StartRotationRotatesWhenDue(t *testing.T) {
+	ks, _ := utils.NewKeyStore(t.TempDir(), utils.AlgEdDSA, nil)
+	claims := jwt.MapClaims{"exp": time.Now().Add(time.Minute).Unix()}
+	oldToken := mustSign(t, ks, claims)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	ks.StartRotation(50*time.Millisecond, time.Hour, stop)
+
+	deadline := time.Now().Add(3 * time.Second)
+	for tokenKid(t, mustSign(t, ks, claims)) == tokenKid(t, oldToken) {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected active key to be rotated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// kunci lama masih dalam retention → token lama tetap valid
+	if _, err := ks.Parse(oldToken); err != nil {
+		t.Errorf("Expected old token valid during retention, got %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"backendgo/app/repository"
+	"strings"
+	"testing"
+	"time"
+)
+
+func queryFrom(params map[string]string) func(string, ...string) string {
+	return func(key string, defaultValue ...string) string {
+		if v, ok := params[key]; ok {
+			return v
+		}
+		if len(defaultValue) > 0 {
+			return defaultValue[0]
+		}
+		return ""
+	}
+}
+
+func TestParseListQuery_Sort(t *testing.T) {
+	cases := []struct {
+		params map[string]string
+		want   string
+	}{
+		{map[string]string{}, "ORDER BY id ASC"},
+		{map[string]string{"sortBy": "nama", "order": "DESC"}, "ORDER BY nama DESC, id ASC"},
+		{map[string]string{"sort": "-angkatan,nama"}, "ORDER BY angkatan DESC, nama ASC, id ASC"},
+		{map[string]string{"sort": "tahun_lulus:desc,id:desc"}, "ORDER BY tahun_lulus DESC, id DESC"},
+		{map[string]string{"order": "desc"}, "ORDER BY id DESC"},
+	}
+	for _, tc := range cases {
+		q, err := repository.ParseListQuery(repository.AlumniListSpec, queryFrom(tc.params))
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tc.params, err)
+		}
+		if got := q.OrderBy(); got != tc.want {
+			t.Errorf("%v: got %q, want %q", tc.params, got, tc.want)
+		}
+	}
+}
+
+func TestParseListQuery_RejectsUnsafeSort(t *testing.T) {
+	bad := []map[string]string{
+		{"sortBy": "id; DROP TABLE alumni"},
+		{"sortBy": "password_hash"},
+		{"sortBy": "nama", "order": "asc, (SELECT 1)"},
+		{"sort": "nama,nama"},
+		{"sort": "id,nim,nama,jurusan"},
+		{"sort": "nama:sideways"},
+	}
+	for _, params := range bad {
+		if _, err := repository.ParseListQuery(repository.AlumniListSpec, queryFrom(params)); err == nil {
+			t.Errorf("%v: expected error", params)
+		}
+	}
+}
+
+func TestParseListQuery_Filters(t *testing.T) {
+	q, err := repository.ParseListQuery(repository.AlumniListSpec, queryFrom(map[string]string{
+		"search":          "budi",
+		"jurusan":         "Informatika",
+		"angkatan_min":    "2017",
+		"status_kematian": "false",
+		"created_to":      "2024-01-31",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	where, args := q.Where([]interface{}{"existing"})
+	for _, part := range []string{
+		"(nama ILIKE $2 OR email ILIKE $2 OR jurusan ILIKE $2)",
+		"LOWER(jurusan) = LOWER($3)",
+		"angkatan >= $4",
+		"status_kematian = $5",
+		"created_at < $6",
+	} {
+		if !strings.Contains(where, part) {
+			t.Errorf("WHERE %q missing %q", where, part)
+		}
+	}
+	if len(args) != 6 || args[1] != "%budi%" || args[3] != 2017 || args[4] != false {
+		t.Fatalf("unexpected args: %#v", args)
+	}
+	// batas atas tanggal inklusif → kurang dari hari berikutnya
+	if to, ok := args[5].(time.Time); !ok || !to.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("created_to: got %v", args[5])
+	}
+	if len(q.Filters) != 4 || q.Filters["angkatan_min"] != "2017" {
+		t.Errorf("unexpected applied filters: %v", q.Filters)
+	}
+}
+
+func TestParseListQuery_InvalidFilterValues(t *testing.T) {
+	bad := []map[string]string{
+		{"angkatan_min": "dua ribu"},
+		{"status_kematian": "mungkin"},
+		{"created_from": "31-01-2024"},
+	}
+	for _, params := range bad {
+		if _, err := repository.ParseListQuery(repository.AlumniListSpec, queryFrom(params)); err == nil {
+			t.Errorf("%v: expected error", params)
+		}
+	}
+}
+
+func TestParseListQuery_NoFilters(t *testing.T) {
+	q, err := repository.ParseListQuery(repository.PekerjaanListSpec, queryFrom(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if where, args := q.Where(nil); where != "" || len(args) != 0 {
+		t.Errorf("expected empty WHERE, got %q %v", where, args)
+	}
+	if got := q.OrderBy(); got != "ORDER BY created_at DESC, id ASC" {
+		t.Errorf("unexpected default order: %q", got)
+	}
+}
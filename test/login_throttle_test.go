@@ -0,0 +1,66 @@
+package test
+
+import (
+	"backendgo/utils"
+	"testing"
+	"time"
+)
+
+func testThrottle() utils.LoginThrottle {
+	return utils.LoginThrottle{
+		MaxFailures:     5,
+		LockoutDuration: 15 * time.Minute,
+		BackoffBase:     time.Second,
+		BackoffMax:      10 * time.Second,
+		FailureWindow:   time.Hour,
+	}
+}
+
+func TestLoginThrottle_ExponentialBackoff(t *testing.T) {
+	p := testThrottle()
+	expected := map[int]time.Duration{
+		0: 0,
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		4: 8 * time.Second,
+		5: 10 * time.Second, // dibatasi BackoffMax
+		9: 10 * time.Second,
+	}
+	for failures, want := range expected {
+		if got := p.Backoff(failures); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", failures, got, want)
+		}
+	}
+}
+
+func TestLoginThrottle_RetryAt(t *testing.T) {
+	p := testThrottle()
+	now := time.Now()
+
+	if got := p.RetryAt(3, now.Add(-time.Second), nil, now); !got.Equal(now.Add(3 * time.Second)) {
+		t.Errorf("Expected backoff until %s, got %s", now.Add(3*time.Second), got)
+	}
+	if got := p.RetryAt(3, now.Add(-time.Minute), nil, now); !got.IsZero() {
+		t.Errorf("Expected no restriction after backoff elapsed, got %s", got)
+	}
+
+	locked := now.Add(10 * time.Minute)
+	if got := p.RetryAt(5, now.Add(-time.Minute), &locked, now); !got.Equal(locked) {
+		t.Errorf("Expected locked until %s, got %s", locked, got)
+	}
+
+	if got := p.RetryAt(4, now.Add(-2*time.Hour), nil, now); !got.IsZero() {
+		t.Errorf("Expected failures outside window to be ignored, got %s", got)
+	}
+}
+
+func TestLoginThrottle_ShouldLock(t *testing.T) {
+	p := testThrottle()
+	if p.ShouldLock(4) {
+		t.Error("Expected no lock below MaxFailures")
+	}
+	if !p.ShouldLock(5) {
+		t.Error("Expected lock at MaxFailures")
+	}
+}
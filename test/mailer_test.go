@@ -0,0 +1,30 @@
+package test
+
+import (
+	"backendgo/mailer"
+	"errors"
+	"os"
+	"testing"
+)
+
+// Header dengan CR/LF ditolak supaya tidak bisa menyisipkan header atau penerima lain
+func TestFileMailer_RejectsHeaderInjection(t *testing.T) {
+	dir := t.TempDir()
+	m := &mailer.FileMailer{Dir: dir, From: "no-reply@alumni.local"}
+
+	for name, msg := range map[string]mailer.Message{
+		"to":      {To: "sari@example.com\r\nBcc: lain@example.com", Subject: "Halo", Body: "isi"},
+		"subject": {To: "sari@example.com", Subject: "Halo\nBcc: lain@example.com", Body: "isi"},
+	} {
+		if err := m.Send(msg); !errors.Is(err, mailer.ErrInvalidHeader) {
+			t.Errorf("%s: expected ErrInvalidHeader, got %v", name, err)
+		}
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("expected no email written, got %d files", len(entries))
+	}
+
+	if err := m.Send(mailer.Message{To: "sari@example.com", Subject: "Halo", Body: "baris 1\nbaris 2"}); err != nil {
+		t.Errorf("valid message: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/apperror"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUpdateMyAlumni_AdminOnlyFieldsRejected(t *testing.T) {
+	app := setupApp()
+	app.Patch("/api/me/alumni", asUser(10, 1, "user"), newMeService().UpdateMyAlumniService)
+
+	bodies := []string{
+		`{"nim": "99999999"}`,
+		`{"alamat": "Jl. Baru", "status_kematian": true}`,
+		`{"angkatan": 2010}`,
+	}
+	for _, body := range bodies {
+		req := httptest.NewRequest("PATCH", "/api/me/alumni", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, _ := app.Test(req)
+		if resp.StatusCode != 403 {
+			t.Errorf("body %s: expected 403, got %d", body, resp.StatusCode)
+		}
+	}
+}
+
+func TestUpdateMyAlumni_InvalidRequest(t *testing.T) {
+	app := setupApp()
+	app.Patch("/api/me/alumni", asUser(10, 1, "user"), newMeService().UpdateMyAlumniService)
+
+	cases := map[string]struct {
+		body   string
+		status int
+	}{
+		"body kosong":     {`{}`, 400},
+		"json tidak sah":  {`{"email":`, 400},
+		"email invalid":   {`{"email": "bukan-email"}`, 422},
+		"telepon invalid": {`{"no_telepon": "12345"}`, 422},
+	}
+	for name, tc := range cases {
+		req := httptest.NewRequest("PATCH", "/api/me/alumni", strings.NewReader(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, _ := app.Test(req)
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s: expected %d, got %d", name, tc.status, resp.StatusCode)
+		}
+	}
+}
+
+func TestMeEndpoints_RequireLinkedAlumni(t *testing.T) {
+	app := setupApp()
+	app.Get("/api/me/alumni", asUser(10, 0, "user"), newMeService().GetMyAlumniService)
+	app.Patch("/api/me/alumni", asUser(10, 0, "user"), newMeService().UpdateMyAlumniService)
+	app.Get("/api/me/pekerjaan", asUser(10, 0, "user"), newMeService().GetMyPekerjaanService)
+	app.Post("/api/me/pekerjaan", asUser(10, 0, "user"), newMeService().CreateMyPekerjaanService)
+	app.Put("/api/me/pekerjaan/:id", asUser(10, 0, "user"), newMeService().UpdateMyPekerjaanService)
+
+	requests := []struct{ method, path string }{
+		{"GET", "/api/me/alumni"},
+		{"PATCH", "/api/me/alumni"},
+		{"GET", "/api/me/pekerjaan"},
+		{"POST", "/api/me/pekerjaan"},
+		{"PUT", "/api/me/pekerjaan/1"},
+	}
+	for _, r := range requests {
+		req := httptest.NewRequest(r.method, r.path, strings.NewReader(`{"alamat":"x"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, _ := app.Test(req)
+		if resp.StatusCode != 403 {
+			t.Errorf("%s %s: expected 403, got %d", r.method, r.path, resp.StatusCode)
+		}
+		var body model.ErrorResponse
+		json.NewDecoder(resp.Body).Decode(&body)
+		if body.Error.Code != apperror.CodeAlumniNotLinked || body.Error.Message != "Akun belum terhubung dengan data alumni" {
+			t.Errorf("%s %s: unexpected error %+v", r.method, r.path, body.Error)
+		}
+	}
+}
+
+func TestCreateMyPekerjaan_InvalidDate(t *testing.T) {
+	app := setupApp()
+	app.Post("/api/me/pekerjaan", asUser(10, 1, "user"), newMeService().CreateMyPekerjaanService)
+
+	body := `{"nama_perusahaan":"PT A","posisi_jabatan":"Dev","tanggal_mulai_kerja":"01-08-2023"}`
+	req := httptest.NewRequest("POST", "/api/me/pekerjaan", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := app.Test(req)
+	if resp.StatusCode != 422 {
+		t.Errorf("expected 422, got %d", resp.StatusCode)
+	}
+}
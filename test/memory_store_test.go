@@ -0,0 +1,79 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/repositoryMongo"
+	"backendgo/app/service"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Test handler memakai repository in-memory (app/repositoryMemory), tanpa
+// koneksi PostgreSQL / MongoDB.
+
+func sampleAlumni() []model.CreateAlumniRequest {
+	return []model.CreateAlumniRequest{
+		{NIM: "20200001", Nama: "Budi Santoso", Jurusan: "Informatika", Angkatan: 2020, TahunLulus: 2024, Email: "budi@example.com"},
+		{NIM: "20200002", Nama: "Siti Aminah", Jurusan: "Sistem Informasi", Angkatan: 2020, TahunLulus: 2024, Email: "siti@example.com"},
+	}
+}
+
+// sampleStore Store berisi sampleAlumni (alumni id 1 dan 2, masing-masing dengan user-nya)
+func sampleStore() *repositoryMemory.Store {
+	store := repositoryMemory.NewStore()
+	alumni := repositoryMemory.NewAlumniRepository(store)
+	for _, a := range sampleAlumni() {
+		if _, err := alumni.Create(a); err != nil {
+			panic(err)
+		}
+	}
+	return store
+}
+
+func newAlumniService() *service.AlumniService {
+	return service.NewAlumniService(repositoryMemory.NewAlumniRepository(sampleStore()), nil, nil, nil)
+}
+
+func newPekerjaanService() *service.PekerjaanService {
+	return service.NewPekerjaanService(repositoryMemory.NewPekerjaanRepository(sampleStore()))
+}
+
+func newMeService() *service.MeService {
+	store := sampleStore()
+	return service.NewMeService(repositoryMemory.NewAlumniRepository(store), repositoryMemory.NewPekerjaanRepository(store))
+}
+
+// newAuthService AuthService di atas store
+func newAuthService(store *repositoryMemory.Store) *service.AuthService {
+	return service.NewAuthService(
+		repositoryMemory.NewUserRepository(store),
+		repositoryMemory.NewRefreshTokenRepository(store),
+		repositoryMemory.NewLoginAttemptRepository(store),
+		repositoryMemory.NewMFARepository(store),
+		repositoryMemory.NewPasswordResetRepository(store),
+		repositoryMemory.NewAuditRepository(store),
+	)
+}
+
+// Repository memori mengembalikan error bertipe yang sama dengan repository PostgreSQL / MongoDB
+func TestMemoryStore_TypedNotFoundErrors(t *testing.T) {
+	store := sampleStore()
+
+	if _, err := repositoryMemory.NewRefreshTokenRepository(store).GetByHash("tidak-ada"); !errors.Is(err, repository.ErrRefreshTokenNotFound) {
+		t.Errorf("refresh token: expected ErrRefreshTokenNotFound, got %v", err)
+	}
+	if _, err := repositoryMemory.NewMFARepository(store).GetTOTP(999); !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("totp: expected ErrUserNotFound, got %v", err)
+	}
+	files := repositoryMemory.NewFileRepository(store)
+	if err := files.Delete("bukan-hex"); !errors.Is(err, repositoryMongo.ErrFileInvalidID) {
+		t.Errorf("file: expected ErrFileInvalidID, got %v", err)
+	}
+	if err := files.Delete(primitive.NewObjectID().Hex()); !errors.Is(err, repositoryMongo.ErrFileNotFound) {
+		t.Errorf("file: expected ErrFileNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package test
+
+import (
+	"backendgo/database"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := database.Migrations()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(migrations) == 0 || migrations[0].Name != "base_tables" {
+		t.Fatalf("first migration should be base_tables, got %+v", migrations)
+	}
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Errorf("versions must be contiguous: index %d has version %d", i, m.Version)
+		}
+	}
+
+	// is_deleted dipakai SoftDeletePekerjaanAdmin, harus ada di skema dasar
+	if !strings.Contains(migrations[0].Up, "is_deleted") {
+		t.Error("base migration should create pekerjaan_alumni.is_deleted")
+	}
+}
+
+// Down migration hanya membatalkan skema dan data seed miliknya, tidak menghapus data aplikasi
+func TestDownMigrations_KeepApplicationData(t *testing.T) {
+	migrations, err := database.Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	seedTables := map[string]bool{"permissions": true, "role_permissions": true}
+	for _, m := range migrations {
+		for _, part := range strings.Split(m.Down, "DELETE FROM ")[1:] {
+			if table := strings.Fields(part)[0]; !seedTables[table] {
+				t.Errorf("%04d_%s.down.sql deletes rows from %s", m.Version, m.Name, table)
+			}
+		}
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	file := func(s string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(s)} }
+
+	list, err := database.LoadMigrations(fstest.MapFS{
+		"0002_b.up.sql":   file("CREATE TABLE b ();"),
+		"0002_b.down.sql": file("DROP TABLE b;"),
+		"0001_a.up.sql":   file("CREATE TABLE a ();"),
+		"0001_a.down.sql": file("DROP TABLE a;"),
+		"README.md":       file("diabaikan"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list) != 2 || list[0].Name != "a" || list[1].Name != "b" || list[1].Down != "DROP TABLE b;" {
+		t.Fatalf("unexpected migrations: %+v", list)
+	}
+
+	cases := map[string]fstest.MapFS{
+		"missing down": {"0001_a.up.sql": file("x")},
+		"bad name":     {"a.up.sql": file("x"), "a.down.sql": file("x")},
+		"bad suffix":   {"0001_a.sql": file("x")},
+		"duplicate version": {
+			"0001_a.up.sql": file("x"), "0001_a.down.sql": file("x"),
+			"0001_b.up.sql": file("x"), "0001_b.down.sql": file("x"),
+		},
+	}
+	for name, fsys := range cases {
+		if _, err := database.LoadMigrations(fsys); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestMongoCollectionSpecs(t *testing.T) {
+	names := map[string]bool{}
+	for _, spec := range database.MongoCollectionSpecs() {
+		names[spec.Name] = true
+		if spec.Validator["$jsonSchema"] == nil || len(spec.Indexes) == 0 {
+			t.Errorf("%s: validator and indexes expected", spec.Name)
+		}
+	}
+	if !names["files"] || !names["pekerjaan_alumni"] {
+		t.Errorf("unexpected collections: %v", names)
+	}
+}
+
+// Tanggal kerja hanya boleh tersimpan sebagai date (tanggal selesai boleh null)
+func TestMongoCollectionSpecs_PekerjaanDatesAreDates(t *testing.T) {
+	for _, spec := range database.MongoCollectionSpecs() {
+		if spec.Name != "pekerjaan_alumni" {
+			continue
+		}
+		props := spec.Validator["$jsonSchema"].(bson.M)["properties"].(bson.M)
+		if got := props["tanggal_mulai_kerja"].(bson.M)["bsonType"]; got != "date" {
+			t.Errorf("tanggal_mulai_kerja: expected date, got %v", got)
+		}
+		if got := fmt.Sprint(props["tanggal_selesai_kerja"].(bson.M)["bsonType"]); got != "[null date]" {
+			t.Errorf("tanggal_selesai_kerja: expected null or date, got %v", got)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/service"
+	"backendgo/app/serviceMongo"
+	"backendgo/middleware"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func stubPermissions() {
+	middleware.SetPermissionLoader(func() (map[string][]string, error) {
+		return map[string][]string{
+			"admin": {model.PermPekerjaanManageAll, model.PermPekerjaanHardDelete, model.PermFilesReadAll, model.PermFilesManageAll},
+			"user":  {model.PermAlumniRead, model.PermPekerjaanRead},
+		}, nil
+	})
+}
+
+// asUser mensimulasikan context hasil AuthRequired
+func asUser(userID, alumniID int, role string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals("user_id", userID)
+		c.Locals("role", role)
+		c.Locals("alumni_id", alumniID)
+		return c.Next()
+	}
+}
+
+func TestOwnership_CrossAlumniDenied(t *testing.T) {
+	stubPermissions()
+
+	cases := []struct {
+		name     string
+		userID   int
+		alumniID int
+		role     string
+		target   int
+		want     bool
+	}{
+		{"pemilik data", 10, 1, "user", 1, true},
+		{"alumni lain", 10, 1, "user", 2, false},
+		{"belum terhubung alumni", 11, 0, "user", 0, false},
+		{"permission manage_all", 1, 0, "admin", 2, true},
+	}
+
+	for _, tc := range cases {
+		app := setupApp()
+		app.Get("/", asUser(tc.userID, tc.alumniID, tc.role), func(c *fiber.Ctx) error {
+			if middleware.CanAccessAlumni(c, tc.target, model.PermPekerjaanManageAll) {
+				return c.SendStatus(200)
+			}
+			return c.SendStatus(403)
+		})
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/", nil))
+		if got := resp.StatusCode == 200; got != tc.want {
+			t.Errorf("%s: akses = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestOwnership_CrossUserFileDenied(t *testing.T) {
+	stubPermissions()
+
+	cases := []struct {
+		name   string
+		userID int
+		role   string
+		owner  int
+		want   bool
+	}{
+		{"file sendiri", 10, "user", 10, true},
+		{"file user lain", 10, "user", 20, false},
+		{"permission files:read_all", 1, "admin", 20, true},
+	}
+
+	for _, tc := range cases {
+		app := setupApp()
+		app.Get("/", asUser(tc.userID, 0, tc.role), func(c *fiber.Ctx) error {
+			if middleware.CanAccessUser(c, tc.owner, model.PermFilesReadAll) {
+				return c.SendStatus(200)
+			}
+			return c.SendStatus(403)
+		})
+
+		resp, _ := app.Test(httptest.NewRequest("GET", "/", nil))
+		if got := resp.StatusCode == 200; got != tc.want {
+			t.Errorf("%s: akses = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+// User tanpa alumni tidak boleh jatuh ke query "milik sendiri" dengan user_id
+func TestOwnership_UnlinkedUserRejectedBeforeQuery(t *testing.T) {
+	stubPermissions()
+
+	app := setupApp()
+	app.Put("/api/pekerjaan/:id/soft-delete", asUser(10, 0, "user"), newPekerjaanService().SoftDeletePekerjaanService)
+	app.Put("/api/pekerjaan/:id/restore", asUser(10, 0, "user"), newPekerjaanService().RestorePekerjaanService)
+	app.Delete("/api/pekerjaan/:id/hard-delete", asUser(10, 0, "user"), newPekerjaanService().HardDeletePekerjaanService)
+	app.Get("/api/pekerjaan/trashed", asUser(10, 0, "user"), newPekerjaanService().GetTrashedPekerjaanService)
+	app.Get("/api/pekerjaan-mongo/trashed", asUser(10, 0, "user"), serviceMongo.NewPekerjaanMongoService(repositoryMemory.NewPekerjaanMongoRepository(sampleStore())).GetTrashedPekerjaanMongoService)
+
+	requests := []struct{ method, path string }{
+		{"PUT", "/api/pekerjaan/5/soft-delete"},
+		{"PUT", "/api/pekerjaan/5/restore"},
+		{"DELETE", "/api/pekerjaan/5/hard-delete"},
+		{"GET", "/api/pekerjaan/trashed"},
+		{"GET", "/api/pekerjaan-mongo/trashed"},
+	}
+	for _, r := range requests {
+		resp, err := app.Test(httptest.NewRequest(r.method, r.path, nil))
+		if err != nil {
+			t.Fatalf("%s %s: %v", r.method, r.path, err)
+		}
+		if resp.StatusCode != 403 {
+			t.Errorf("%s %s: expected 403, got %d", r.method, r.path, resp.StatusCode)
+		}
+	}
+}
+
+func TestOwnership_SoftDeleteOtherAlumniNotFound(t *testing.T) {
+	stubPermissions()
+
+	store := sampleStore()
+	repo := repositoryMemory.NewPekerjaanRepository(store)
+	p, _ := repo.Create(model.PekerjaanAlumni{AlumniID: 2, NamaPerusahaan: "PT Maju Jaya"})
+	path := "/api/pekerjaan/" + strconv.Itoa(p.ID) + "/soft-delete"
+
+	app := setupApp()
+	app.Put("/api/pekerjaan/:id/soft-delete", asUser(1, 1, "user"), service.NewPekerjaanService(repo).SoftDeletePekerjaanService)
+	app.Put("/own/:id/soft-delete", asUser(2, 2, "user"), service.NewPekerjaanService(repo).SoftDeletePekerjaanService)
+
+	resp, _ := app.Test(httptest.NewRequest("PUT", path, nil))
+	if resp.StatusCode != 404 {
+		t.Errorf("expected 404 for pekerjaan of another alumni, got %d", resp.StatusCode)
+	}
+
+	resp, _ = app.Test(httptest.NewRequest("PUT", "/own/"+strconv.Itoa(p.ID)+"/soft-delete", nil))
+	if resp.StatusCode != 200 {
+		t.Errorf("expected 200 for own pekerjaan, got %d", resp.StatusCode)
+	}
+}
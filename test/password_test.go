@@ -0,0 +1,249 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/service"
+	"backendgo/mailer"
+	"backendgo/middleware"
+	"backendgo/utils"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// captureMailer simpan email yang dikirim supaya link reset bisa dibaca test
+type captureMailer struct {
+	sent []mailer.Message
+}
+
+func (m *captureMailer) Send(msg mailer.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// resetToken ambil token dari link reset di email terakhir
+func (m *captureMailer) resetToken(t *testing.T) string {
+	t.Helper()
+	if len(m.sent) == 0 {
+		t.Fatal("no email sent")
+	}
+	body := m.sent[len(m.sent)-1].Body
+	i := strings.Index(body, "token=")
+	if i < 0 {
+		t.Fatalf("no reset link in email: %q", body)
+	}
+	return strings.Fields(body[i+len("token="):])[0]
+}
+
+// passwordUser user dengan password "password-lama" dan satu sesi aktif "sesi-1"
+func passwordUser(t *testing.T, store *repositoryMemory.Store, mustChange bool) int {
+	t.Helper()
+	users := repositoryMemory.NewUserRepository(store)
+	hash, _ := utils.HashPassword("password-lama")
+	userID, err := users.Create("sari", "sari@example.com", hash, model.RoleUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := users.UpdatePassword(userID, hash, mustChange); err != nil {
+		t.Fatal(err)
+	}
+	repositoryMemory.NewRefreshTokenRepository(store).Create(userID, utils.HashToken("refresh-sari"), "sesi-1", time.Now().Add(time.Hour))
+	return userID
+}
+
+func sendJSON(t *testing.T, app *fiber.App, method, path, body string, header ...string) int {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode
+}
+
+func TestChangePassword(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	userID := passwordUser(t, store, true)
+	app := setupApp()
+	app.Put("/api/profile/password", asUser(userID, 0, model.RoleUser), newAuthService(store).ChangePasswordService)
+
+	for _, tc := range []struct {
+		body   string
+		status int
+	}{
+		{`{"old_password":"salah-sekali","new_password":"password-baru"}`, fiber.StatusUnauthorized},
+		{`{"old_password":"password-lama","new_password":"pendek"}`, fiber.StatusBadRequest},
+		{`{"old_password":"password-lama","new_password":"password-lama"}`, fiber.StatusBadRequest},
+		{`{"old_password":"password-lama"}`, fiber.StatusBadRequest},
+	} {
+		if status := sendJSON(t, app, "PUT", "/api/profile/password", tc.body); status != tc.status {
+			t.Errorf("%s: expected %d, got %d", tc.body, tc.status, status)
+		}
+	}
+
+	if status := sendJSON(t, app, "PUT", "/api/profile/password", `{"old_password":"password-lama","new_password":"password-baru"}`); status != 200 {
+		t.Fatalf("change password: expected 200, got %d", status)
+	}
+	user, _ := repositoryMemory.NewUserRepository(store).GetByID(userID)
+	if !utils.CheckPassword("password-baru", user.PasswordHash) || user.MustChangePassword {
+		t.Errorf("expected new password saved and must_change_password cleared, got %+v", user)
+	}
+	if session, _ := repositoryMemory.NewRefreshTokenRepository(store).GetActiveSession("sesi-1"); session != nil {
+		t.Error("expected old sessions revoked after password change")
+	}
+}
+
+// Token dengan mcp=true hanya boleh dipakai untuk ganti password (dan profil / logout)
+func TestAuthRequired_EnforcesMustChangePassword(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	userID := passwordUser(t, store, true)
+	middleware.SetSessionLoader(repositoryMemory.NewRefreshTokenRepository(store).GetActiveSession)
+
+	user, _ := repositoryMemory.NewUserRepository(store).GetByID(userID)
+	token, _, err := utils.GenerateToken(*user, "sesi-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := setupApp()
+	ok := func(c *fiber.Ctx) error { return c.SendString("ok") }
+	app.Get("/api/alumni", middleware.AuthRequired(), ok)
+	app.Put("/api/profile/password", middleware.AuthRequired(), ok)
+
+	auth := []string{"Authorization", "Bearer " + token}
+	if status := sendJSON(t, app, "GET", "/api/alumni", "", auth...); status != fiber.StatusForbidden {
+		t.Errorf("expected 403 before password change, got %d", status)
+	}
+	if status := sendJSON(t, app, "PUT", "/api/profile/password", "", auth...); status != 200 {
+		t.Errorf("expected password change route allowed, got %d", status)
+	}
+
+	user.MustChangePassword = false
+	token, _, _ = utils.GenerateToken(*user, "sesi-1")
+	if status := sendJSON(t, app, "GET", "/api/alumni", "", "Authorization", "Bearer "+token); status != 200 {
+		t.Errorf("expected 200 without must_change_password, got %d", status)
+	}
+}
+
+func TestAdminPasswordReset(t *testing.T) {
+	mail := &captureMailer{}
+	mailer.SetDefault(mail)
+	defer mailer.SetDefault(&mailer.LogMailer{})
+
+	store := repositoryMemory.NewStore()
+	userID := passwordUser(t, store, false)
+	users := service.NewUserService(
+		repositoryMemory.NewUserRepository(store),
+		nil,
+		repositoryMemory.NewRefreshTokenRepository(store),
+		repositoryMemory.NewLoginAttemptRepository(store),
+		repositoryMemory.NewPasswordResetRepository(store),
+		repositoryMemory.NewAuditRepository(store),
+	)
+	app := setupApp()
+	app.Post("/api/users/:id/password-reset", asUser(99, 0, model.RoleAdmin), users.AdminResetPasswordService)
+	app.Post("/api/password/reset", newAuthService(store).ResetPasswordService)
+
+	if status := sendJSON(t, app, "POST", "/api/users/999/password-reset", ""); status != fiber.StatusNotFound {
+		t.Errorf("unknown user: expected 404, got %d", status)
+	}
+
+	// hanya link reset terakhir yang berlaku
+	sendJSON(t, app, "POST", "/api/users/"+strconv.Itoa(userID)+"/password-reset", "")
+	first := mail.resetToken(t)
+	if status := sendJSON(t, app, "POST", "/api/users/"+strconv.Itoa(userID)+"/password-reset", ""); status != 200 {
+		t.Fatalf("reset link: expected 200, got %d", status)
+	}
+	second := mail.resetToken(t)
+	if mail.sent[len(mail.sent)-1].To != "sari@example.com" {
+		t.Errorf("expected email to user, got %+v", mail.sent[len(mail.sent)-1])
+	}
+
+	reset := func(token, password string) int {
+		return sendJSON(t, app, "POST", "/api/password/reset", `{"token":"`+token+`","new_password":"`+password+`"}`)
+	}
+	for name, tc := range map[string]struct {
+		token, password string
+	}{
+		"superseded token": {first, "password-baru"},
+		"unknown token":    {"token-palsu", "password-baru"},
+		"short password":   {second, "pendek"},
+	} {
+		if status := reset(tc.token, tc.password); status != fiber.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", name, status)
+		}
+	}
+
+	if status := reset(second, "password-baru"); status != 200 {
+		t.Fatalf("reset: expected 200, got %d", status)
+	}
+	user, _ := repositoryMemory.NewUserRepository(store).GetByID(userID)
+	if !utils.CheckPassword("password-baru", user.PasswordHash) {
+		t.Error("expected password changed by reset")
+	}
+	if session, _ := repositoryMemory.NewRefreshTokenRepository(store).GetActiveSession("sesi-1"); session != nil {
+		t.Error("expected sessions revoked after reset")
+	}
+	// token sekali pakai
+	if status := reset(second, "password-lain"); status != fiber.StatusBadRequest {
+		t.Errorf("reused token: expected 400, got %d", status)
+	}
+}
+
+func TestPasswordReset_RejectsExpiredToken(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	userID := passwordUser(t, store, false)
+	repositoryMemory.NewPasswordResetRepository(store).Create(userID, 99, utils.HashToken("token-lama"), time.Now().Add(-time.Minute))
+
+	app := setupApp()
+	app.Post("/api/password/reset", newAuthService(store).ResetPasswordService)
+	if status := sendJSON(t, app, "POST", "/api/password/reset", `{"token":"token-lama","new_password":"password-baru"}`); status != fiber.StatusBadRequest {
+		t.Errorf("expired token: expected 400, got %d", status)
+	}
+}
+
+// Akun nonaktif tidak bisa diberi link reset, dan token yang sudah terkirim tidak bisa dipakai
+func TestPasswordReset_RejectsDisabledAccount(t *testing.T) {
+	mail := &captureMailer{}
+	mailer.SetDefault(mail)
+	defer mailer.SetDefault(&mailer.LogMailer{})
+
+	store := repositoryMemory.NewStore()
+	userID := passwordUser(t, store, false)
+	users := repositoryMemory.NewUserRepository(store)
+	resets := repositoryMemory.NewPasswordResetRepository(store)
+	resets.Create(userID, 99, utils.HashToken("token-sari"), time.Now().Add(time.Hour))
+	users.SetActive(userID, false)
+
+	app := setupApp()
+	app.Post("/api/users/:id/password-reset", asUser(99, 0, model.RoleAdmin), newUserService(store).AdminResetPasswordService)
+	app.Post("/api/password/reset", newAuthService(store).ResetPasswordService)
+
+	if status := sendJSON(t, app, "POST", "/api/users/"+strconv.Itoa(userID)+"/password-reset", ""); status != fiber.StatusConflict {
+		t.Errorf("reset link for disabled account: expected 409, got %d", status)
+	}
+	if len(mail.sent) != 0 {
+		t.Errorf("expected no email for disabled account, got %+v", mail.sent)
+	}
+
+	if status := sendJSON(t, app, "POST", "/api/password/reset", `{"token":"token-sari","new_password":"password-baru"}`); status != fiber.StatusForbidden {
+		t.Errorf("reset disabled account: expected 403, got %d", status)
+	}
+	if user, _ := users.GetByID(userID); !utils.CheckPassword("password-lama", user.PasswordHash) {
+		t.Error("password of disabled account should not change")
+	}
+
+	// token belum terpakai, jadi tetap berlaku setelah akun diaktifkan lagi
+	users.SetActive(userID, true)
+	if status := sendJSON(t, app, "POST", "/api/password/reset", `{"token":"token-sari","new_password":"password-baru"}`); status != 200 {
+		t.Errorf("reset after enable: expected 200, got %d", status)
+	}
+}
@@ -0,0 +1,182 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/service"
+	"backendgo/apperror"
+	"backendgo/utils"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// contoh dari RFC 7386 Appendix A
+func TestApplyMergePatch_RFCExamples(t *testing.T) {
+	cases := []struct{ target, patch, want string }{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, tc := range cases {
+		got, err := utils.ApplyMergePatch([]byte(tc.target), []byte(tc.patch))
+		if err != nil || string(got) != tc.want {
+			t.Errorf("patch %s on %s: got %s, %v; want %s", tc.patch, tc.target, got, err, tc.want)
+		}
+	}
+
+	for _, patch := range []string{`["a"]`, `"a"`, `null`} {
+		if _, err := utils.ApplyMergePatch([]byte(`{}`), []byte(patch)); err != utils.ErrMergePatchNotObject {
+			t.Errorf("patch %s: expected ErrMergePatchNotObject, got %v", patch, err)
+		}
+	}
+}
+
+type patchResult struct {
+	status int
+	etag   string
+	code   string
+	data   map[string]interface{}
+}
+
+func sendPatch(t *testing.T, app *fiber.App, method, path, ifMatch, body string) patchResult {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if method == "PATCH" {
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+	}
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw struct {
+		Data  map[string]interface{} `json:"data"`
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	json.NewDecoder(resp.Body).Decode(&raw)
+	return patchResult{resp.StatusCode, resp.Header.Get(fiber.HeaderETag), raw.Error.Code, raw.Data}
+}
+
+func TestPatchAlumni_MergePatchAndETag(t *testing.T) {
+	app := setupApp()
+	s := service.NewAlumniService(repositoryMemory.NewAlumniRepository(sampleStore()), nil, nil, nil)
+	app.Get("/api/alumni/:id", s.GetAlumniByIDService)
+	app.Put("/api/alumni/:id", s.UpdateAlumniService)
+	app.Patch("/api/alumni/:id", s.PatchAlumniService)
+
+	got := sendPatch(t, app, "GET", "/api/alumni/1", "", "")
+	if got.status != 200 || got.etag != `"1"` {
+		t.Fatalf("GET: expected 200 with ETag \"1\", got %d %q", got.status, got.etag)
+	}
+
+	// field yang tidak dikirim tetap, null mengosongkan field opsional
+	got = sendPatch(t, app, "PATCH", "/api/alumni/1", `"1"`, `{"jurusan": "Sistem Informasi", "alamat": "Jl. Baru", "no_telepon": null}`)
+	if got.status != 200 || got.etag != `"2"` {
+		t.Fatalf("PATCH: expected 200 with ETag \"2\", got %d %q (%s)", got.status, got.etag, got.code)
+	}
+	if got.data["jurusan"] != "Sistem Informasi" || got.data["alamat"] != "Jl. Baru" ||
+		got.data["nama"] != "Budi Santoso" || got.data["nim"] != "20200001" || got.data["version"] != float64(2) {
+		t.Errorf("unexpected patched data %v", got.data)
+	}
+
+	// ETag lama: 412, data tidak berubah
+	if got = sendPatch(t, app, "PATCH", "/api/alumni/1", `"1"`, `{"nama": "Budi Lama"}`); got.status != 412 || got.code != apperror.CodePreconditionFailed {
+		t.Errorf("stale If-Match on PATCH: expected 412 PRECONDITION_FAILED, got %d %s", got.status, got.code)
+	}
+	put := `{"nim":"20200001","nama":"Budi Lama","jurusan":"TI","angkatan":2020,"tahun_lulus":2024,"email":"budi@example.com"}`
+	if got = sendPatch(t, app, "PUT", "/api/alumni/1", `"1"`, put); got.status != 412 {
+		t.Errorf("stale If-Match on PUT: expected 412, got %d", got.status)
+	}
+	if got = sendPatch(t, app, "GET", "/api/alumni/1", "", ""); got.data["nama"] != "Budi Santoso" || got.etag != `"2"` {
+		t.Errorf("rejected writes must not change data, got %v (ETag %s)", got.data, got.etag)
+	}
+
+	// PUT dengan ETag terbaru (W/ dari proxy juga diterima) dan tanpa If-Match
+	if got = sendPatch(t, app, "PUT", "/api/alumni/1", `W/"2"`, put); got.status != 200 || got.etag != `"3"` {
+		t.Errorf("PUT with current If-Match: expected 200 with ETag \"3\", got %d %q", got.status, got.etag)
+	}
+	if got = sendPatch(t, app, "PATCH", "/api/alumni/1", "", `{"alamat": "Jl. Lain"}`); got.status != 200 || got.etag != `"4"` {
+		t.Errorf("PATCH without If-Match: expected 200 with ETag \"4\", got %d %q", got.status, got.etag)
+	}
+}
+
+func TestPatchAlumni_InvalidRequests(t *testing.T) {
+	app := setupApp()
+	app.Patch("/api/alumni/:id", newAlumniService().PatchAlumniService)
+
+	cases := map[string]struct {
+		path, ifMatch, body string
+		status              int
+	}{
+		"id tidak valid":      {"/api/alumni/abc", "", `{}`, 400},
+		"alumni tidak ada":    {"/api/alumni/99", "", `{}`, 404},
+		"bukan objek":         {"/api/alumni/1", "", `["nama"]`, 400},
+		"json tidak sah":      {"/api/alumni/1", "", `{"nama":`, 400},
+		"tipe salah":          {"/api/alumni/1", "", `{"angkatan": "dua ribu"}`, 400},
+		"if-match tidak sah":  {"/api/alumni/1", "3", `{}`, 400},
+		"field wajib dihapus": {"/api/alumni/1", "", `{"nama": null}`, 422},
+		"hasil tidak valid":   {"/api/alumni/1", "", `{"tahun_lulus": 2010}`, 422},
+	}
+	for name, tc := range cases {
+		if got := sendPatch(t, app, "PATCH", tc.path, tc.ifMatch, tc.body); got.status != tc.status {
+			t.Errorf("%s: expected %d, got %d", name, tc.status, got.status)
+		}
+	}
+}
+
+func TestPatchPekerjaan_ClearsEndDate(t *testing.T) {
+	store := sampleStore()
+	repo := repositoryMemory.NewPekerjaanRepository(store)
+	selesai := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	p, err := repo.Create(model.PekerjaanAlumni{
+		AlumniID: 1, NamaPerusahaan: "PT Maju Jaya", PosisiJabatan: "Engineer",
+		TanggalMulaiKerja: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), TanggalSelesaiKerja: &selesai,
+		StatusPekerjaan: "selesai", CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := setupApp()
+	s := service.NewPekerjaanService(repo)
+	app.Patch("/api/pekerjaan/:id", s.PatchPekerjaanService)
+	app.Put("/api/pekerjaan/:id", s.UpdatePekerjaanService)
+
+	got := sendPatch(t, app, "PATCH", "/api/pekerjaan/1", `"1"`, `{"tanggal_selesai_kerja": null, "status_pekerjaan": "aktif"}`)
+	if got.status != 200 || got.etag != `"2"` {
+		t.Fatalf("PATCH: expected 200 with ETag \"2\", got %d %q (%s)", got.status, got.etag, got.code)
+	}
+	if got.data["tanggal_selesai_kerja"] != nil || got.data["status_pekerjaan"] != "aktif" ||
+		got.data["nama_perusahaan"] != "PT Maju Jaya" || got.data["alumni_id"] != float64(p.AlumniID) {
+		t.Errorf("unexpected patched data %v", got.data)
+	}
+
+	if got = sendPatch(t, app, "PATCH", "/api/pekerjaan/1", `"1"`, `{"posisi_jabatan": "Lead"}`); got.status != 412 {
+		t.Errorf("stale If-Match: expected 412, got %d", got.status)
+	}
+	if got = sendPatch(t, app, "PATCH", "/api/pekerjaan/1", "", `{"tanggal_mulai_kerja": "01-08-2023"}`); got.status != 422 {
+		t.Errorf("invalid date: expected 422, got %d", got.status)
+	}
+	put := `{"nama_perusahaan":"PT Lain","posisi_jabatan":"Lead","tanggal_mulai_kerja":"2023-08-01","status_pekerjaan":"aktif"}`
+	if got = sendPatch(t, app, "PUT", "/api/pekerjaan/99", "", put); got.status != 404 {
+		t.Errorf("PUT missing pekerjaan: expected 404, got %d", got.status)
+	}
+}
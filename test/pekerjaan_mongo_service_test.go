@@ -1,9 +1,13 @@
 package test
 
 import (
+	"backendgo/app/modelmongo"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/serviceMongo"
 	"bytes"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -129,3 +133,44 @@ func TestDeletePekerjaanMongo(t *testing.T) {
 		t.Errorf("Expected 200, got %d", resp.StatusCode)
 	}
 }
+
+// Update parsial: tanggal yang dikirim dibandingkan dengan pasangannya yang tersimpan
+func TestUpdatePekerjaanMongo_DatesAgainstStoredValues(t *testing.T) {
+	repo := repositoryMemory.NewPekerjaanMongoRepository(repositoryMemory.NewStore())
+	mulai := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	selesai := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	data, err := repo.Create(modelmongo.PekerjaanAlumni{
+		AlumniID: 1, NamaPerusahaan: "PT Maju", PosisiJabatan: "Staff",
+		TanggalMulaiKerja: &mulai, TanggalSelesaiKerja: &selesai, StatusPekerjaan: "selesai",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := setupApp()
+	app.Put("/api/pekerjaan-mongo/:id", serviceMongo.NewPekerjaanMongoService(repo).UpdatePekerjaanMongoService)
+	path := "/api/pekerjaan-mongo/" + data.ID.Hex()
+
+	for _, body := range []string{
+		`{"tanggal_selesai_kerja":"2019-06-01"}`,
+		`{"tanggal_mulai_kerja":"2022-01-01"}`,
+	} {
+		if status := sendJSON(t, app, "PUT", path, body); status != fiber.StatusUnprocessableEntity {
+			t.Errorf("%s: expected 422, got %d", body, status)
+		}
+	}
+
+	if status := sendJSON(t, app, "PUT", path, `{"tanggal_selesai_kerja":"2021-06-01"}`); status != 200 {
+		t.Fatalf("valid end date: expected 200, got %d", status)
+	}
+	got, _ := repo.GetByID(data.ID.Hex())
+	if got.TanggalSelesaiKerja == nil || got.TanggalSelesaiKerja.Format("2006-01-02") != "2021-06-01" {
+		t.Errorf("expected end date stored as date, got %v", got.TanggalSelesaiKerja)
+	}
+
+	if status := sendJSON(t, app, "PUT", path, `{"tanggal_selesai_kerja":""}`); status != 200 {
+		t.Fatalf("clear end date: expected 200, got %d", status)
+	}
+	if got, _ := repo.GetByID(data.ID.Hex()); got.TanggalSelesaiKerja != nil {
+		t.Errorf("expected end date cleared, got %v", got.TanggalSelesaiKerja)
+	}
+}
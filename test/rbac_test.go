@@ -0,0 +1,191 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/service"
+	"backendgo/apperror"
+	"backendgo/middleware"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// rbacApp route /api/roles seperti route.RBACRoute, permission dibaca dari role di store.
+// Route /api/alumni-write dijaga alumni:write untuk mengecek efek perubahan role.
+func rbacApp(store *repositoryMemory.Store, userID int, role string) *fiber.App {
+	rbac := repositoryMemory.NewRBACRepository(store)
+	middleware.SetPermissionLoader(rbac.RolePermissionMap)
+	s := service.NewRBACService(rbac, repositoryMemory.NewAuditRepository(store))
+
+	app := setupApp()
+	guard := []fiber.Handler{asUser(userID, 0, role), middleware.RequirePermission(model.PermRolesManage)}
+	app.Post("/api/roles", append(guard, s.CreateRoleService)...)
+	app.Put("/api/roles/:name", append(guard, s.UpdateRoleService)...)
+	app.Delete("/api/roles/:name", append(guard, s.DeleteRoleService)...)
+	return app
+}
+
+// protected route yang hanya bisa diakses dengan permission tertentu, role diambil dari header X-Role
+func protected(perms ...string) *fiber.App {
+	app := setupApp()
+	app.Get("/api/protected", func(c *fiber.Ctx) error {
+		c.Locals("role", c.Get("X-Role"))
+		return c.Next()
+	}, middleware.RequirePermission(perms...), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	return app
+}
+
+func TestRequirePermission_AllowsAndDenies(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	middleware.SetPermissionLoader(repositoryMemory.NewRBACRepository(store).RolePermissionMap)
+	app := protected(model.PermUsersManage, model.PermRolesManage)
+
+	for role, want := range map[string]int{
+		model.RoleAdmin: 200,
+		model.RoleUser:  fiber.StatusForbidden,
+		"tidak_ada":     fiber.StatusForbidden,
+		"":              fiber.StatusForbidden,
+	} {
+		req := httptest.NewRequest("GET", "/api/protected", nil)
+		req.Header.Set("X-Role", role)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != want {
+			t.Errorf("role %q: expected %d, got %d", role, want, resp.StatusCode)
+			continue
+		}
+		if want != 200 {
+			var body model.ErrorResponse
+			json.NewDecoder(resp.Body).Decode(&body)
+			if body.Error.Code != apperror.CodePermissionDenied {
+				t.Errorf("role %q: expected code %s, got %+v", role, apperror.CodePermissionDenied, body.Error)
+			}
+		}
+	}
+}
+
+func TestRoleCRUD(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	app := rbacApp(store, 1, model.RoleAdmin)
+	users := repositoryMemory.NewUserRepository(store)
+	operatorID, _ := users.Create("operator", "operator@example.com", "x", model.RoleUser)
+	alumniWrite := protected(model.PermAlumniWrite)
+	canWrite := func() bool {
+		req := httptest.NewRequest("GET", "/api/protected", nil)
+		req.Header.Set("X-Role", "operator_prodi")
+		resp, _ := alumniWrite.Test(req)
+		return resp.StatusCode == 200
+	}
+
+	for name, tc := range map[string]struct {
+		body   string
+		status int
+	}{
+		"invalid name":       {`{"name":"Operator Prodi","permissions":["alumni:read"]}`, fiber.StatusBadRequest},
+		"unknown permission": {`{"name":"operator_prodi","permissions":["alumni:fly"]}`, fiber.StatusBadRequest},
+		"built-in name":      {`{"name":"admin","permissions":["alumni:read"]}`, fiber.StatusConflict},
+	} {
+		if status := sendJSON(t, app, "POST", "/api/roles", tc.body); status != tc.status {
+			t.Errorf("create %s: expected %d, got %d", name, tc.status, status)
+		}
+	}
+
+	if status := sendJSON(t, app, "POST", "/api/roles", `{"name":"operator_prodi","description":"Operator","permissions":["alumni:read","alumni:write"]}`); status != fiber.StatusCreated {
+		t.Fatalf("create: expected 201, got %d", status)
+	}
+	if status := sendJSON(t, app, "POST", "/api/roles", `{"name":"operator_prodi","permissions":["alumni:read"]}`); status != fiber.StatusConflict {
+		t.Errorf("duplicate create: expected 409, got %d", status)
+	}
+	if !canWrite() {
+		t.Fatal("new role should get its permissions immediately")
+	}
+
+	// permission yang dicabut langsung berlaku, tidak menunggu TTL cache
+	if status := sendJSON(t, app, "PUT", "/api/roles/operator_prodi", `{"description":"Operator","permissions":["alumni:read"]}`); status != 200 {
+		t.Fatalf("update: expected 200, got %d", status)
+	}
+	if canWrite() {
+		t.Error("revoked permission should be denied right after update")
+	}
+	if status := sendJSON(t, app, "PUT", "/api/roles/tidak_ada", `{"permissions":[]}`); status != fiber.StatusNotFound {
+		t.Errorf("update unknown role: expected 404, got %d", status)
+	}
+
+	users.UpdateRole(operatorID, "operator_prodi")
+	if status := sendJSON(t, app, "DELETE", "/api/roles/operator_prodi", ""); status != fiber.StatusConflict {
+		t.Errorf("delete role in use: expected 409, got %d", status)
+	}
+	users.UpdateRole(operatorID, model.RoleUser)
+	if status := sendJSON(t, app, "DELETE", "/api/roles/operator_prodi", ""); status != 200 {
+		t.Fatalf("delete: expected 200, got %d", status)
+	}
+	if _, err := repositoryMemory.NewRBACRepository(store).GetRoleByName("operator_prodi"); err == nil {
+		t.Error("role should be deleted")
+	}
+	if status := sendJSON(t, app, "DELETE", "/api/roles/operator_prodi", ""); status != fiber.StatusNotFound {
+		t.Errorf("delete unknown role: expected 404, got %d", status)
+	}
+}
+
+func TestRoleGuards_ProtectBuiltInRoles(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	app := rbacApp(store, 1, model.RoleAdmin)
+
+	// admin tanpa roles:manage berarti tidak ada lagi yang bisa mengelola role
+	if status := sendJSON(t, app, "PUT", "/api/roles/admin", `{"permissions":["users:manage"]}`); status != fiber.StatusBadRequest {
+		t.Errorf("admin without roles:manage: expected 400, got %d", status)
+	}
+	for _, role := range []string{model.RoleAdmin, model.RoleUser} {
+		if status := sendJSON(t, app, "DELETE", "/api/roles/"+role, ""); status != fiber.StatusConflict {
+			t.Errorf("delete built-in %s: expected 409, got %d", role, status)
+		}
+	}
+	roles, _ := repositoryMemory.NewRBACRepository(store).GetAllRoles()
+	if len(roles) != 2 {
+		t.Errorf("built-in roles should stay, got %+v", roles)
+	}
+
+	// tanpa roles:manage endpoint role ditolak
+	if status := sendJSON(t, rbacApp(store, 2, model.RoleUser), "POST", "/api/roles", `{"name":"operator_prodi","permissions":[]}`); status != fiber.StatusForbidden {
+		t.Errorf("user without roles:manage: expected 403, got %d", status)
+	}
+}
+
+// Load yang dimulai sebelum invalidasi tidak boleh menimpa cache dengan permission lama
+func TestPermissionCache_DiscardsLoadStartedBeforeInvalidation(t *testing.T) {
+	loads := 0
+	middleware.SetPermissionLoader(func() (map[string][]string, error) {
+		loads++
+		if loads == 1 {
+			// role diubah saat load pertama masih berjalan
+			middleware.InvalidatePermissionCache()
+			return map[string][]string{"operator_prodi": {model.PermAlumniWrite}}, nil
+		}
+		return map[string][]string{"operator_prodi": {model.PermAlumniRead}}, nil
+	})
+	app := protected(model.PermAlumniWrite)
+
+	status := func() int {
+		req := httptest.NewRequest("GET", "/api/protected", nil)
+		req.Header.Set("X-Role", "operator_prodi")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode
+	}
+	status()
+	if got := status(); got != fiber.StatusForbidden {
+		t.Errorf("stale permissions cached: expected 403, got %d", got)
+	}
+	if loads != 2 {
+		t.Errorf("expected permissions reloaded after invalidation, got %d loads", loads)
+	}
+}
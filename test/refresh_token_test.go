@@ -0,0 +1,139 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repositoryMemory"
+	"backendgo/utils"
+	"encoding/json"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// refreshSession user baru dengan satu refresh token aktif di family "sesi-1"
+func refreshSession(t *testing.T, store *repositoryMemory.Store) (userID int, refreshToken string) {
+	userID, err := repositoryMemory.NewUserRepository(store).Create("rani", "rani@example.com", "x", model.RoleUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshToken = "refresh-awal"
+	tokens := repositoryMemory.NewRefreshTokenRepository(store)
+	if err := tokens.Create(userID, utils.HashToken(refreshToken), "sesi-1", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	return userID, refreshToken
+}
+
+func postRefresh(t *testing.T, app *fiber.App, refreshToken string) (int, string) {
+	req := httptest.NewRequest("POST", "/api/token/refresh", strings.NewReader(`{"refresh_token":"`+refreshToken+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body struct {
+		Data model.LoginResponse `json:"data"`
+	}
+	json.NewDecoder(resp.Body).Decode(&body)
+	return resp.StatusCode, body.Data.RefreshToken
+}
+
+func TestRefreshToken_RotatesWithinFamily(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	_, first := refreshSession(t, store)
+	app := setupApp()
+	app.Post("/api/token/refresh", newAuthService(store).RefreshTokenService)
+
+	status, second := postRefresh(t, app, first)
+	if status != 200 || second == "" || second == first {
+		t.Fatalf("first refresh: status %d, token %q", status, second)
+	}
+	status, third := postRefresh(t, app, second)
+	if status != 200 || third == "" {
+		t.Fatalf("second refresh: status %d", status)
+	}
+
+	tokens := repositoryMemory.NewRefreshTokenRepository(store)
+	old, _ := tokens.GetByHash(utils.HashToken(first))
+	latest, err := tokens.GetByHash(utils.HashToken(third))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old.UsedAt == nil || latest.UsedAt != nil || latest.FamilyID != "sesi-1" {
+		t.Errorf("unexpected tokens after rotation: old=%+v latest=%+v", old, latest)
+	}
+	if session, _ := tokens.GetActiveSession("sesi-1"); session == nil {
+		t.Error("session should stay active after rotation")
+	}
+}
+
+// Token yang sudah dirotasi dipakai lagi → seluruh family dicabut, termasuk token terbarunya
+func TestRefreshToken_ReuseRevokesFamily(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	userID, first := refreshSession(t, store)
+	tokens := repositoryMemory.NewRefreshTokenRepository(store)
+	tokens.Create(userID, utils.HashToken("sesi-lain"), "sesi-2", time.Now().Add(time.Hour))
+	app := setupApp()
+	app.Post("/api/token/refresh", newAuthService(store).RefreshTokenService)
+
+	_, second := postRefresh(t, app, first)
+	if status, _ := postRefresh(t, app, first); status != fiber.StatusUnauthorized {
+		t.Fatalf("reused token: expected 401, got %d", status)
+	}
+	if status, _ := postRefresh(t, app, second); status != fiber.StatusUnauthorized {
+		t.Errorf("token issued before reuse should be revoked, got %d", status)
+	}
+	if session, _ := tokens.GetActiveSession("sesi-1"); session != nil {
+		t.Errorf("family should be revoked, got %+v", session)
+	}
+	// sesi lain milik user yang sama tidak ikut dicabut
+	if session, _ := tokens.GetActiveSession("sesi-2"); session == nil {
+		t.Error("other family should stay active")
+	}
+}
+
+// Dua refresh bersamaan dengan token yang sama: hanya satu yang berhasil merotasi
+func TestRefreshToken_ConcurrentRotationIsAtomic(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	_, first := refreshSession(t, store)
+	tokens := repositoryMemory.NewRefreshTokenRepository(store)
+	stored, _ := tokens.GetByHash(utils.HashToken(first))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	rotated := 0
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ok, err := tokens.Rotate(stored.ID, utils.HashToken("baru-"+strconv.Itoa(i)), time.Now().Add(time.Hour))
+			if err != nil {
+				t.Error(err)
+			}
+			if ok {
+				mu.Lock()
+				rotated++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if rotated != 1 {
+		t.Fatalf("expected exactly one rotation, got %d", rotated)
+	}
+	active := 0
+	for i := 0; i < 8; i++ {
+		if tok, err := tokens.GetByHash(utils.HashToken("baru-" + strconv.Itoa(i))); err == nil && tok.UsedAt == nil {
+			active++
+		}
+	}
+	if active != 1 {
+		t.Errorf("expected one replacement token stored, got %d", active)
+	}
+}
@@ -0,0 +1,671 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/modelmongo"
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/repositoryMongo"
+	"backendgo/database"
+	"backendgo/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ===================================================
+// 🔹 Contract test repository
+// Skenario yang sama dijalankan ke repository in-memory (selalu) dan ke
+// PostgreSQL / MongoDB asli kalau TEST_DB_DSN / TEST_MONGO_URI diisi.
+// Database test dikosongkan setiap skenario, jangan arahkan ke database produksi.
+// ===================================================
+
+type repoBackend struct {
+	alumni    repository.AlumniRepository
+	pekerjaan repository.PekerjaanRepository
+	users     repository.UserRepository
+	search    repository.SearchRepository
+}
+
+func forEachRepoBackend(t *testing.T, run func(t *testing.T, b repoBackend)) {
+	t.Run("memory", func(t *testing.T) {
+		store := repositoryMemory.NewStore()
+		run(t, repoBackend{
+			alumni:    repositoryMemory.NewAlumniRepository(store),
+			pekerjaan: repositoryMemory.NewPekerjaanRepository(store),
+			users:     repositoryMemory.NewUserRepository(store),
+			search:    repositoryMemory.NewSearchRepository(store),
+		})
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		dsn := os.Getenv("TEST_DB_DSN")
+		if dsn == "" {
+			t.Skip("TEST_DB_DSN kosong")
+		}
+		db, err := sql.Open("postgres", dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+		if _, err := database.MigrateUp(db); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.Exec(`TRUNCATE users, alumni, pekerjaan_alumni RESTART IDENTITY CASCADE`); err != nil {
+			t.Fatal(err)
+		}
+		run(t, repoBackend{
+			alumni:    repository.NewAlumniRepository(db),
+			pekerjaan: repository.NewPekerjaanRepository(db),
+			users:     repository.NewUserRepository(db),
+			search:    repository.NewSearchRepository(db),
+		})
+	})
+}
+
+func forEachFileBackend(t *testing.T, run func(t *testing.T, files repositoryMongo.FileRepository)) {
+	t.Run("memory", func(t *testing.T) {
+		run(t, repositoryMemory.NewFileRepository(repositoryMemory.NewStore()))
+	})
+
+	t.Run("mongo", func(t *testing.T) {
+		uri := os.Getenv("TEST_MONGO_URI")
+		if uri == "" {
+			t.Skip("TEST_MONGO_URI kosong")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { client.Disconnect(context.Background()) })
+
+		name := os.Getenv("TEST_MONGO_DB")
+		if name == "" {
+			name = "backendgo_test"
+		}
+		db := client.Database(name)
+		if err := db.Collection("files").Drop(ctx); err != nil {
+			t.Fatal(err)
+		}
+		run(t, repositoryMongo.NewFileRepository(db))
+	})
+}
+
+func contractAlumni(nim, nama, jurusan string, angkatan int) model.CreateAlumniRequest {
+	return model.CreateAlumniRequest{
+		NIM: nim, Nama: nama, Jurusan: jurusan, Angkatan: angkatan, TahunLulus: angkatan + 4,
+		Email: nim + "@example.com", NoTelepon: "0812", Alamat: "Jl. Merdeka",
+	}
+}
+
+func contractPekerjaan(alumniID int, perusahaan string) model.PekerjaanAlumni {
+	ts := time.Now()
+	return model.PekerjaanAlumni{
+		AlumniID: alumniID, NamaPerusahaan: perusahaan, PosisiJabatan: "Engineer",
+		BidangIndustri: "Teknologi", LokasiKerja: "Jakarta", GajiRange: "10-15 juta",
+		TanggalMulaiKerja: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), StatusPekerjaan: "aktif",
+		CreatedAt: ts, UpdatedAt: ts,
+	}
+}
+
+func mustCreateAlumni(t *testing.T, repo repository.AlumniRepository, a model.CreateAlumniRequest) model.Alumni {
+	t.Helper()
+	created, err := repo.Create(a)
+	if err != nil {
+		t.Fatalf("create alumni %s: %v", a.NIM, err)
+	}
+	return created
+}
+
+func TestRepositoryContract_AlumniCRUD(t *testing.T) {
+	forEachRepoBackend(t, func(t *testing.T, b repoBackend) {
+		a := mustCreateAlumni(t, b.alumni, contractAlumni("20200001", "Budi Santoso", "Informatika", 2020))
+		if a.ID == 0 || a.UserID == 0 {
+			t.Fatalf("expected id and linked user, got %+v", a)
+		}
+
+		// alumni baru otomatis punya akun user yang wajib ganti password
+		user, err := b.users.GetByUsernameOrEmail(a.Email)
+		if err != nil || user.ID != a.UserID || user.Role != "user" || !user.MustChangePassword {
+			t.Errorf("unexpected linked user: %+v, %v", user, err)
+		}
+		if detail, _ := b.users.GetDetailByID(a.UserID); detail == nil || detail.AlumniID == nil || *detail.AlumniID != a.ID {
+			t.Errorf("expected user detail to point to alumni %d, got %+v", a.ID, detail)
+		}
+
+		if _, err := b.alumni.Create(contractAlumni("20200001", "Nama Lain", "Informatika", 2020)); err == nil {
+			t.Error("expected duplicate NIM to fail")
+		}
+
+		got, err := b.alumni.GetByID(a.ID)
+		if err != nil || got.NIM != a.NIM || got.Nama != a.Nama {
+			t.Errorf("GetByID: %+v, %v", got, err)
+		}
+		if _, err := b.alumni.GetByID(9999); !errors.Is(err, repository.ErrAlumniNotFound) {
+			t.Errorf("expected ErrAlumniNotFound for missing alumni, got %v", err)
+		}
+
+		edit := model.UpdateAlumniRequest{
+			ID: a.ID, NIM: a.NIM, Nama: "Budi S.", Jurusan: "Sistem Informasi",
+			Angkatan: 2020, TahunLulus: 2024, Email: a.Email,
+		}
+		updated, err := b.alumni.Update(edit, a.Version)
+		if err != nil || updated.Nama != "Budi S." || updated.Jurusan != "Sistem Informasi" || updated.Version != a.Version+1 {
+			t.Errorf("Update: %+v, %v", updated, err)
+		}
+		// versi lama ditolak, versi 0 = tanpa cek
+		edit.Nama = "Budi Lama"
+		if got, err := b.alumni.Update(edit, a.Version); err != repository.ErrVersionConflict || got.Nama != "Budi S." {
+			t.Errorf("expected ErrVersionConflict with stale version, got %+v, %v", got, err)
+		}
+		if _, err := b.alumni.Update(model.UpdateAlumniRequest{ID: 9999, NIM: "x"}, 0); !errors.Is(err, repository.ErrAlumniNotFound) {
+			t.Errorf("expected ErrAlumniNotFound updating missing alumni, got %v", err)
+		}
+
+		// field kontak nil tidak diubah
+		phone := "0899"
+		contact, err := b.alumni.UpdateContact(a.ID, model.UpdateMyAlumniRequest{NoTelepon: &phone})
+		if err != nil || contact.NoTelepon != "0899" || contact.Email != a.Email || contact.Jurusan != "Sistem Informasi" {
+			t.Errorf("UpdateContact: %+v, %v", contact, err)
+		}
+
+		if _, err := b.alumni.UpdateContact(9999, model.UpdateMyAlumniRequest{NoTelepon: &phone}); !errors.Is(err, repository.ErrAlumniNotFound) {
+			t.Errorf("expected ErrAlumniNotFound updating contact of missing alumni, got %v", err)
+		}
+
+		if err := b.alumni.UpdateStatusKematian(a.ID, true); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.alumni.UpdateStatusKematian(9999, true); !errors.Is(err, repository.ErrAlumniNotFound) {
+			t.Errorf("expected ErrAlumniNotFound updating status of missing alumni, got %v", err)
+		}
+		if got, _ := b.alumni.GetByID(a.ID); !got.StatusKematian {
+			t.Error("expected status_kematian true")
+		}
+
+		// hapus permanen alumni ikut menghapus pekerjaannya
+		p, err := b.pekerjaan.Create(contractPekerjaan(a.ID, "PT Maju Jaya"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := b.alumni.SoftDelete(a.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := b.alumni.HardDelete(a.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := b.alumni.GetByID(a.ID); !errors.Is(err, repository.ErrAlumniNotFound) {
+			t.Errorf("expected alumni gone, got %v", err)
+		}
+		if _, err := b.pekerjaan.GetByID(p.ID); err != sql.ErrNoRows {
+			t.Errorf("expected pekerjaan deleted with alumni, got %v", err)
+		}
+	})
+}
+
+func TestRepositoryContract_AlumniTrash(t *testing.T) {
+	forEachRepoBackend(t, func(t *testing.T, b repoBackend) {
+		a := mustCreateAlumni(t, b.alumni, contractAlumni("20200001", "Budi", "Informatika", 2020))
+		other := mustCreateAlumni(t, b.alumni, contractAlumni("20200002", "Siti", "Informatika", 2020))
+		aktif, _ := b.pekerjaan.Create(contractPekerjaan(a.ID, "PT Aktif"))
+		lama, _ := b.pekerjaan.Create(contractPekerjaan(a.ID, "PT Lama"))
+		if err := b.pekerjaan.SoftDelete(lama.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := b.alumni.Restore(a.ID); err != repository.ErrAlumniNotTrashed {
+			t.Errorf("restore active alumni: expected ErrAlumniNotTrashed, got %v", err)
+		}
+		if _, _, err := b.alumni.HardDelete(a.ID); err != repository.ErrAlumniNotTrashed {
+			t.Errorf("hard delete active alumni: expected ErrAlumniNotTrashed, got %v", err)
+		}
+		if err := b.alumni.SoftDelete(a.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.alumni.SoftDelete(a.ID); !errors.Is(err, repository.ErrAlumniNotFound) {
+			t.Errorf("soft delete twice: expected ErrAlumniNotFound, got %v", err)
+		}
+
+		// alumni di trash tidak terlihat dan tidak bisa diubah
+		if _, err := b.alumni.GetByID(a.ID); !errors.Is(err, repository.ErrAlumniNotFound) {
+			t.Errorf("expected trashed alumni hidden, got %v", err)
+		}
+		if all, _ := b.alumni.GetAll(); len(all) != 1 || all[0].ID != other.ID {
+			t.Errorf("unexpected GetAll: %+v", all)
+		}
+		q, _ := repository.ParseListQuery(repository.AlumniListSpec, queryFrom(map[string]string{"jurusan": "Informatika"}))
+		if list, _ := b.alumni.List(q, 10, 0); len(list) != 1 || list[0].ID != other.ID {
+			t.Errorf("unexpected List: %+v", list)
+		}
+		if total, _ := b.alumni.Count(q); total != 1 {
+			t.Errorf("expected count 1, got %d", total)
+		}
+		if list, _, _ := b.alumni.ListCursor(q, nil, 10); len(list) != 1 {
+			t.Errorf("unexpected ListCursor: %+v", list)
+		}
+		streamed := 0
+		b.alumni.Stream(q, func(model.Alumni) error { streamed++; return nil })
+		if streamed != 1 {
+			t.Errorf("expected 1 streamed alumni, got %d", streamed)
+		}
+		if _, err := b.alumni.Update(model.UpdateAlumniRequest{ID: a.ID, NIM: a.NIM, Nama: "X"}, 0); !errors.Is(err, repository.ErrAlumniNotFound) {
+			t.Errorf("update trashed alumni: expected ErrAlumniNotFound, got %v", err)
+		}
+
+		// pekerjaan ikut di-trash, tapi tidak muncul di daftar maupun trash pekerjaan
+		if got, _ := b.pekerjaan.GetByID(aktif.ID); got.ID != aktif.ID {
+			t.Errorf("expected pekerjaan kept, got %+v", got)
+		}
+		pq, _ := repository.ParseListQuery(repository.PekerjaanListSpec, queryFrom(map[string]string{}))
+		if total, _ := b.pekerjaan.Count(pq); total != 0 {
+			t.Errorf("expected pekerjaan of trashed alumni hidden, got %d", total)
+		}
+		if trashed, _ := b.pekerjaan.GetTrashed(); len(trashed) != 0 {
+			t.Errorf("expected pekerjaan trash empty, got %+v", trashed)
+		}
+
+		trash, err := b.alumni.GetTrashed()
+		if err != nil || len(trash) != 1 || trash[0].ID != a.ID || !trash[0].IsDeleted || trash[0].DeletedAt == nil {
+			t.Fatalf("unexpected trash: %+v, %v", trash, err)
+		}
+		if got, err := b.alumni.GetTrashedByID(a.ID); err != nil || got.NIM != a.NIM {
+			t.Errorf("unexpected GetTrashedByID: %+v, %v", got, err)
+		}
+		if _, err := b.alumni.GetTrashedByID(other.ID); !errors.Is(err, repository.ErrAlumniNotTrashed) {
+			t.Errorf("GetTrashedByID of active alumni: expected ErrAlumniNotTrashed, got %v", err)
+		}
+
+		// restore hanya memulihkan pekerjaan yang ikut di-trash bersama alumni
+		if err := b.alumni.Restore(a.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := b.alumni.GetByID(a.ID); err != nil {
+			t.Errorf("expected restored alumni visible, got %v", err)
+		}
+		trashed, _ := b.pekerjaan.GetTrashedByAlumniID(a.ID)
+		if len(trashed) != 1 || trashed[0].ID != lama.ID {
+			t.Errorf("expected only PT Lama in pekerjaan trash, got %+v", trashed)
+		}
+		if total, _ := b.pekerjaan.Count(pq); total != 2 {
+			t.Errorf("expected 2 pekerjaan after restore, got %d", total)
+		}
+
+		// hard delete ikut menghapus akun user, akun admin yang ditautkan tetap ada
+		b.alumni.SoftDelete(a.ID)
+		deleted, userDeleted, err := b.alumni.HardDelete(a.ID)
+		if err != nil || deleted.ID != a.ID || deleted.UserID != a.UserID || !userDeleted {
+			t.Fatalf("unexpected hard delete result: %+v, %v, %v", deleted, userDeleted, err)
+		}
+		if _, err := b.users.GetByID(a.UserID); err != repository.ErrUserNotFound {
+			t.Errorf("expected user deleted with alumni, got %v", err)
+		}
+		if _, err := b.pekerjaan.GetByID(lama.ID); err != sql.ErrNoRows {
+			t.Errorf("expected pekerjaan deleted with alumni, got %v", err)
+		}
+		if trash, _ := b.alumni.GetTrashed(); len(trash) != 0 {
+			t.Errorf("expected empty trash, got %+v", trash)
+		}
+
+		adminID, err := b.users.Create("admin1", "admin1@example.com", "hash", "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.users.UnlinkAlumni(other.UserID)
+		if err := b.users.LinkAlumni(adminID, other.ID); err != nil {
+			t.Fatal(err)
+		}
+		b.alumni.SoftDelete(other.ID)
+		if _, userDeleted, err := b.alumni.HardDelete(other.ID); err != nil || userDeleted {
+			t.Fatalf("expected admin account kept (userDeleted=false), got %v, %v", userDeleted, err)
+		}
+		if _, err := b.users.GetByID(adminID); err != nil {
+			t.Errorf("expected linked admin account kept, got %v", err)
+		}
+	})
+}
+
+func TestRepositoryContract_AlumniList(t *testing.T) {
+	forEachRepoBackend(t, func(t *testing.T, b repoBackend) {
+		mustCreateAlumni(t, b.alumni, contractAlumni("20190001", "Andi", "Informatika", 2019))
+		mustCreateAlumni(t, b.alumni, contractAlumni("20200001", "Budi", "Informatika", 2020))
+		mustCreateAlumni(t, b.alumni, contractAlumni("20200002", "Citra", "Sistem Informasi", 2020))
+		mustCreateAlumni(t, b.alumni, contractAlumni("20210001", "Dewi", "Informatika", 2021))
+
+		q, err := repository.ParseListQuery(repository.AlumniListSpec, queryFrom(map[string]string{
+			"jurusan": "informatika", "angkatan_min": "2020", "sort": "-nama",
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		list, err := b.alumni.List(q, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list) != 2 || list[0].Nama != "Dewi" || list[1].Nama != "Budi" {
+			t.Errorf("unexpected filtered list: %+v", list)
+		}
+		if total, _ := b.alumni.Count(q); total != 2 {
+			t.Errorf("expected count 2, got %d", total)
+		}
+
+		q, _ = repository.ParseListQuery(repository.AlumniListSpec, queryFrom(map[string]string{"search": "CIT"}))
+		if list, _ := b.alumni.List(q, 10, 0); len(list) != 1 || list[0].Nama != "Citra" {
+			t.Errorf("unexpected search result: %+v", list)
+		}
+
+		q, _ = repository.ParseListQuery(repository.AlumniListSpec, queryFrom(nil))
+		if page, _ := b.alumni.List(q, 2, 2); len(page) != 2 || page[0].NIM != "20200002" {
+			t.Errorf("unexpected offset page: %+v", page)
+		}
+		if page, _ := b.alumni.List(q, 2, 10); len(page) != 0 {
+			t.Errorf("expected empty page past the end, got %+v", page)
+		}
+
+		var streamed []string
+		b.alumni.Stream(q, func(a model.Alumni) error {
+			streamed = append(streamed, a.NIM)
+			return nil
+		})
+		if len(streamed) != 4 || streamed[0] != "20190001" {
+			t.Errorf("unexpected stream: %v", streamed)
+		}
+	})
+}
+
+func TestRepositoryContract_AlumniCursor(t *testing.T) {
+	forEachRepoBackend(t, func(t *testing.T, b repoBackend) {
+		for _, a := range []model.CreateAlumniRequest{
+			contractAlumni("20200001", "Andi", "Informatika", 2020),
+			contractAlumni("20200002", "Budi", "Informatika", 2021),
+			contractAlumni("20200003", "Citra", "Informatika", 2020),
+			contractAlumni("20200004", "Dewi", "Informatika", 2021),
+			contractAlumni("20200005", "Eko", "Informatika", 2020),
+		} {
+			mustCreateAlumni(t, b.alumni, a)
+		}
+
+		q, _ := repository.ParseListQuery(repository.AlumniListSpec, queryFrom(map[string]string{"sort": "angkatan"}))
+		var seen []string
+		var first utils.CursorPage
+		var cur *utils.Cursor
+		for i := 0; i < 5; i++ {
+			list, page, err := b.alumni.ListCursor(q, cur, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if i == 0 {
+				first = page
+			}
+			for _, a := range list {
+				seen = append(seen, a.Nama)
+			}
+			if page.Next == "" {
+				break
+			}
+			if cur, err = utils.DecodeCursor(page.Next, q.SortKey(), q.CursorKinds()); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		want := []string{"Andi", "Citra", "Eko", "Budi", "Dewi"}
+		if len(seen) != len(want) {
+			t.Fatalf("expected %v, got %v", want, seen)
+		}
+		for i := range want {
+			if seen[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, seen)
+			}
+		}
+		if first.Prev != "" {
+			t.Errorf("first page should not have prev cursor, got %q", first.Prev)
+		}
+	})
+}
+
+func TestRepositoryContract_PekerjaanSoftDelete(t *testing.T) {
+	forEachRepoBackend(t, func(t *testing.T, b repoBackend) {
+		owner := mustCreateAlumni(t, b.alumni, contractAlumni("20200001", "Budi", "Informatika", 2020))
+		other := mustCreateAlumni(t, b.alumni, contractAlumni("20200002", "Siti", "Informatika", 2020))
+
+		p, err := b.pekerjaan.Create(contractPekerjaan(owner.ID, "PT Maju Jaya"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := b.pekerjaan.Create(contractPekerjaan(9999, "PT Tanpa Alumni")); err == nil {
+			t.Error("expected create for missing alumni to fail")
+		}
+
+		// alumni lain tidak bisa menyentuh pekerjaan ini
+		if err := b.pekerjaan.SoftDeleteOwned(p.ID, other.ID); err != repository.ErrPekerjaanNotOwned {
+			t.Errorf("expected ErrPekerjaanNotOwned, got %v", err)
+		}
+		if err := b.pekerjaan.HardDelete(p.ID); err != repository.ErrPekerjaanNotTrashed {
+			t.Errorf("expected ErrPekerjaanNotTrashed before soft delete, got %v", err)
+		}
+
+		edit := p
+		edit.PosisiJabatan = "Lead Engineer"
+		edit.UpdatedAt = time.Now()
+		if got, err := b.pekerjaan.UpdateOwned(edit); err != nil || got.PosisiJabatan != "Lead Engineer" || got.Version != p.Version+1 {
+			t.Errorf("UpdateOwned: %+v, %v", got, err)
+		}
+		if _, err := b.pekerjaan.Update(edit, p.Version); err != repository.ErrVersionConflict {
+			t.Errorf("expected ErrVersionConflict with stale version, got %v", err)
+		}
+		if _, err := b.pekerjaan.Update(contractPekerjaan(owner.ID, "PT Hilang"), 0); err != sql.ErrNoRows {
+			t.Errorf("expected sql.ErrNoRows updating missing pekerjaan, got %v", err)
+		}
+
+		if err := b.pekerjaan.SoftDeleteOwned(p.ID, owner.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := b.pekerjaan.UpdateOwned(edit); err != repository.ErrPekerjaanNotOwned {
+			t.Errorf("expected trashed pekerjaan to be read-only, got %v", err)
+		}
+		if trashed, _ := b.pekerjaan.GetTrashedByAlumniID(owner.ID); len(trashed) != 1 || !trashed[0].IsDeleted {
+			t.Errorf("expected 1 trashed pekerjaan for owner, got %+v", trashed)
+		}
+		if trashed, _ := b.pekerjaan.GetTrashedByAlumniID(other.ID); len(trashed) != 0 {
+			t.Errorf("expected no trashed pekerjaan for other alumni, got %+v", trashed)
+		}
+
+		if err := b.pekerjaan.RestoreOwned(p.ID, owner.ID); err != nil {
+			t.Fatal(err)
+		}
+		if trashed, _ := b.pekerjaan.GetTrashed(); len(trashed) != 0 {
+			t.Errorf("expected empty trash after restore, got %+v", trashed)
+		}
+
+		b.pekerjaan.SoftDelete(p.ID)
+		if err := b.pekerjaan.HardDeleteOwned(p.ID, other.ID); err != repository.ErrPekerjaanNotOwned {
+			t.Errorf("expected ErrPekerjaanNotOwned, got %v", err)
+		}
+		if err := b.pekerjaan.HardDeleteOwned(p.ID, owner.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := b.pekerjaan.GetByID(p.ID); err != sql.ErrNoRows {
+			t.Errorf("expected pekerjaan gone, got %v", err)
+		}
+	})
+}
+
+func TestRepositoryContract_PekerjaanList(t *testing.T) {
+	forEachRepoBackend(t, func(t *testing.T, b repoBackend) {
+		a := mustCreateAlumni(t, b.alumni, contractAlumni("20200001", "Budi", "Informatika", 2020))
+		for _, name := range []string{"PT Alpha", "PT Beta", "CV Gamma"} {
+			if _, err := b.pekerjaan.Create(contractPekerjaan(a.ID, name)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		q, _ := repository.ParseListQuery(repository.PekerjaanListSpec, queryFrom(map[string]string{
+			"search": "pt", "sort": "nama_perusahaan",
+		}))
+		list, err := b.pekerjaan.List(q, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list) != 2 || list[0].NamaPerusahaan != "PT Alpha" || list[1].NamaPerusahaan != "PT Beta" {
+			t.Errorf("unexpected list: %+v", list)
+		}
+		if total, _ := b.pekerjaan.Count(q); total != 2 {
+			t.Errorf("expected count 2, got %d", total)
+		}
+		if byAlumni, _ := b.pekerjaan.GetByAlumniID(a.ID); len(byAlumni) != 3 {
+			t.Errorf("expected 3 pekerjaan for alumni, got %d", len(byAlumni))
+		}
+	})
+}
+
+func TestRepositoryContract_SearchSkipsDeleted(t *testing.T) {
+	forEachRepoBackend(t, func(t *testing.T, b repoBackend) {
+		budi := mustCreateAlumni(t, b.alumni, contractAlumni("20200001", "Budi", "Informatika", 2020))
+		siti := mustCreateAlumni(t, b.alumni, contractAlumni("20200002", "Siti", "Informatika", 2020))
+		aktif, _ := b.pekerjaan.Create(contractPekerjaan(budi.ID, "Nusantara Aktif"))
+		dihapus, _ := b.pekerjaan.Create(contractPekerjaan(budi.ID, "Nusantara Dihapus"))
+		b.pekerjaan.Create(contractPekerjaan(siti.ID, "Nusantara Trash"))
+		if err := b.pekerjaan.SoftDelete(dihapus.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.alumni.SoftDelete(siti.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		list, total, err := b.search.SearchAll("Nusantara", []string{model.SearchTypePekerjaan}, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != 1 || len(list) != 1 || list[0].ID != aktif.ID {
+			t.Errorf("expected only pekerjaan %d, got total=%d %+v", aktif.ID, total, list)
+		}
+	})
+}
+
+func TestRepositoryContract_Users(t *testing.T) {
+	forEachRepoBackend(t, func(t *testing.T, b repoBackend) {
+		adminID, err := b.users.Create("admin1", "admin1@example.com", "hash", "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := b.users.Create("admin1", "lain@example.com", "hash", "user"); err == nil {
+			t.Error("expected duplicate username to fail")
+		}
+		if taken, _ := b.users.IsUsernameOrEmailTaken("x", "admin1@example.com"); !taken {
+			t.Error("expected email to be taken")
+		}
+		if _, err := b.users.GetByID(9999); err != repository.ErrUserNotFound {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+		if err := b.users.UpdateRole(9999, "admin"); err != repository.ErrUserNotFound {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+
+		if err := b.users.UpdatePassword(adminID, "hash2", false); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.users.SetActive(adminID, false); err != nil {
+			t.Fatal(err)
+		}
+		u, _ := b.users.GetByUsernameOrEmail("admin1")
+		if u == nil || u.PasswordHash != "hash2" || u.MustChangePassword || u.IsActive {
+			t.Errorf("unexpected user after update: %+v", u)
+		}
+
+		a := mustCreateAlumni(t, b.alumni, contractAlumni("20200001", "Budi", "Informatika", 2020))
+
+		inactive := false
+		if list, _ := b.users.List(model.UserFilter{IsActive: &inactive}, 10, 0); len(list) != 1 || list[0].ID != adminID {
+			t.Errorf("unexpected inactive users: %+v", list)
+		}
+		if list, _ := b.users.List(model.UserFilter{Search: "BUDI", Role: "user"}, 10, 0); len(list) != 1 || list[0].AlumniID == nil {
+			t.Errorf("unexpected search result: %+v", list)
+		}
+		if list, _ := b.users.List(model.UserFilter{}, 10, 5); list == nil || len(list) != 0 {
+			t.Errorf("expected empty non-nil page, got %#v", list)
+		}
+		if total, _ := b.users.Count(model.UserFilter{}); total != 2 {
+			t.Errorf("expected 2 users, got %d", total)
+		}
+
+		// alumni sudah punya user sendiri
+		if err := b.users.LinkAlumni(adminID, a.ID); err != repository.ErrAlumniAlreadyLinked {
+			t.Errorf("expected ErrAlumniAlreadyLinked, got %v", err)
+		}
+		if err := b.users.LinkAlumni(adminID, 9999); err != repository.ErrAlumniNotFound {
+			t.Errorf("expected ErrAlumniNotFound, got %v", err)
+		}
+		if err := b.users.UnlinkAlumni(a.UserID); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.users.UnlinkAlumni(a.UserID); err != repository.ErrAlumniNotLinked {
+			t.Errorf("expected ErrAlumniNotLinked, got %v", err)
+		}
+		if err := b.users.LinkAlumni(adminID, a.ID); err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := b.alumni.GetByID(a.ID); got.UserID != adminID {
+			t.Errorf("expected alumni linked to %d, got %d", adminID, got.UserID)
+		}
+
+		exists, _ := b.users.ExistingIDs([]int{adminID, 9999})
+		if !exists[adminID] || exists[9999] {
+			t.Errorf("unexpected ExistingIDs: %v", exists)
+		}
+	})
+}
+
+func TestRepositoryContract_Files(t *testing.T) {
+	forEachFileBackend(t, func(t *testing.T, files repositoryMongo.FileRepository) {
+		own := modelmongo.File{UserID: 10, FileName: "a.png", FileCategory: "foto"}
+		if err := files.Create(&own); err != nil {
+			t.Fatal(err)
+		}
+		if own.ID.IsZero() || own.UploadedAt.IsZero() {
+			t.Errorf("expected id and uploaded_at to be set, got %+v", own)
+		}
+		files.Create(&modelmongo.File{UserID: 11, FileName: "b.pdf", FileCategory: "sertifikat"})
+
+		if all, _ := files.FindAll(); len(all) != 2 {
+			t.Errorf("expected 2 files, got %d", len(all))
+		}
+		mine, _ := files.FindByUser(10)
+		if len(mine) != 1 || mine[0].ID != own.ID {
+			t.Errorf("unexpected files for user 10: %+v", mine)
+		}
+
+		if err := files.Delete("bukan-hex"); !errors.Is(err, repositoryMongo.ErrFileInvalidID) {
+			t.Errorf("expected invalid id error, got %v", err)
+		}
+		if err := files.Delete(own.ID.Hex()); err != nil {
+			t.Fatal(err)
+		}
+		if err := files.Delete(own.ID.Hex()); !errors.Is(err, repositoryMongo.ErrFileNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+
+		// trash alumni: file tetap dikembalikan FindAll / FindByUser dengan is_deleted
+		files.Create(&modelmongo.File{UserID: 11, FileName: "c.png", FileCategory: "foto"})
+		if err := files.SetDeletedByUser(11, true); err != nil {
+			t.Fatal(err)
+		}
+		if trashed, _ := files.FindByUser(11); len(trashed) != 2 || !trashed[0].IsDeleted || !trashed[1].IsDeleted {
+			t.Errorf("expected trashed files for user 11, got %+v", trashed)
+		}
+		deleted, err := files.DeleteByUser(11)
+		if err != nil || len(deleted) != 2 {
+			t.Fatalf("unexpected DeleteByUser: %+v, %v", deleted, err)
+		}
+		if all, _ := files.FindAll(); len(all) != 0 {
+			t.Errorf("expected no files left, got %+v", all)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package test
+
+import (
+	"backendgo/utils"
+	"strings"
+	"testing"
+)
+
+func TestParseGraduateRosterCSV(t *testing.T) {
+	csv := "\ufeffNIM,Nama,Jurusan,Angkatan,Tahun_Lulus\n" +
+		"2101,Budi Santoso,Informatika,2017,2021\n" +
+		"2102,  Siti   Aminah ,Sistem Informasi,,\n" +
+		"2101,Budi Lagi,Informatika,2017,2021\n" +
+		",Tanpa NIM,,,\n" +
+		"2103,Andi,Informatika,abc,2021\n"
+
+	list, errs, err := utils.ParseGraduateRosterCSV(strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("expected 2 valid rows, got %d: %+v", len(list), list)
+	}
+	if list[0].NIM != "2101" || list[0].Angkatan != 2017 || list[0].TahunLulus != 2021 {
+		t.Errorf("row 1 parsed wrong: %+v", list[0])
+	}
+	if list[1].Nama != "Siti   Aminah" || list[1].Angkatan != 0 {
+		t.Errorf("row 2 parsed wrong: %+v", list[1])
+	}
+	if len(errs) != 3 {
+		t.Errorf("expected 3 row errors (duplicate, missing nim, bad angkatan), got %v", errs)
+	}
+}
+
+func TestParseGraduateRosterCSV_MissingColumn(t *testing.T) {
+	_, _, err := utils.ParseGraduateRosterCSV(strings.NewReader("nim,jurusan\n2101,Informatika\n"))
+	if err == nil {
+		t.Fatal("expected error when nama column is missing")
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	if utils.NormalizeName("  Siti   AMINAH ") != utils.NormalizeName("siti aminah") {
+		t.Error("names differing only in case/spacing should match")
+	}
+	if utils.NormalizeName("Siti Aminah") == utils.NormalizeName("Siti Amina") {
+		t.Error("different names should not match")
+	}
+}
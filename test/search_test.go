@@ -0,0 +1,85 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/service"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestSafeHighlight(t *testing.T) {
+	got := repository.SafeHighlight("\x02Budi\x03 <script>alert(1)</script> & \x02Santoso\x03")
+	want := "<mark>Budi</mark> &lt;script&gt;alert(1)&lt;/script&gt; &amp; <mark>Santoso</mark>"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSearch_RejectsInvalidParams(t *testing.T) {
+	stubPermissions()
+	app := setupApp()
+	app.Get("/api/search", asUser(1, 1, "user"), service.NewSearchService(nil).SearchService)
+
+	cases := map[string]int{
+		"q=a":                         400, // terlalu pendek
+		"type=semua&q=budi":           400,
+		"q=budi&limit=0":              400,
+		"q=" + url.QueryEscape("   "): 400,
+	}
+	for query, want := range cases {
+		resp, err := app.Test(httptest.NewRequest("GET", "/api/search?"+query, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != want {
+			t.Errorf("%s: expected %d, got %d", query, want, resp.StatusCode)
+		}
+	}
+}
+
+func TestSearch_RequiresReadPermissionForType(t *testing.T) {
+	stubPermissions()
+	app := setupApp()
+	// role admin di stub tidak punya alumni:read / pekerjaan:read
+	app.Get("/api/search", asUser(1, 0, "admin"), service.NewSearchService(nil).SearchService)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/search?q=budi", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != 403 {
+		t.Errorf("expected 403, got %d", resp.StatusCode)
+	}
+}
+
+func TestSearch_HidesSoftDeletedPekerjaan(t *testing.T) {
+	stubPermissions()
+	store := sampleStore()
+	pekerjaan := repositoryMemory.NewPekerjaanRepository(store)
+	for _, nama := range []string{"Garuda Aktif", "Garuda Dihapus"} {
+		p, err := pekerjaan.Create(model.PekerjaanAlumni{AlumniID: 1, NamaPerusahaan: nama, PosisiJabatan: "Engineer"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nama == "Garuda Dihapus" {
+			pekerjaan.SoftDelete(p.ID)
+		}
+	}
+
+	app := setupApp()
+	app.Get("/api/search", asUser(1, 1, "user"), service.NewSearchService(repositoryMemory.NewSearchRepository(store)).SearchService)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/search?q=garuda&type=pekerjaan", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body model.SearchResponse
+	json.NewDecoder(resp.Body).Decode(&body)
+	if resp.StatusCode != 200 || len(body.Data) != 1 || body.Data[0].Title != "Garuda Aktif" {
+		t.Errorf("expected only active pekerjaan, got %d %+v", resp.StatusCode, body.Data)
+	}
+}
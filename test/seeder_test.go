@@ -0,0 +1,73 @@
+package test
+
+import (
+	"backendgo/database/seeder"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestSeederGenerate_Deterministic(t *testing.T) {
+	a := seeder.Generate(42, 40)
+	b := seeder.Generate(42, 40)
+	if !reflect.DeepEqual(a, b) {
+		t.Fatal("same seed should produce identical dataset")
+	}
+
+	c := seeder.Generate(7, 40)
+	if reflect.DeepEqual(a.Alumni[0], c.Alumni[0]) {
+		t.Error("different seed should produce different data")
+	}
+
+	if len(a.Alumni) != 40 || len(a.Roster) != 48 {
+		t.Fatalf("unexpected size: alumni=%d roster=%d", len(a.Alumni), len(a.Roster))
+	}
+}
+
+func TestSeederGenerate_Valid(t *testing.T) {
+	data := seeder.Generate(42, 200)
+
+	nims, usernames, emails := map[string]bool{}, map[string]bool{}, map[string]bool{}
+	for _, r := range data.Roster {
+		if nims[r.NIM] {
+			t.Errorf("duplicate NIM %s", r.NIM)
+		}
+		nims[r.NIM] = true
+	}
+
+	jobs, files := 0, 0
+	for _, a := range data.Alumni {
+		if usernames[a.Username] || emails[a.Email] {
+			t.Errorf("duplicate username/email %s / %s", a.Username, a.Email)
+		}
+		usernames[a.Username], emails[a.Email] = true, true
+		if a.TahunLulus < a.Angkatan+4 || len(a.NIM) != 8 {
+			t.Errorf("invalid alumni %+v", a)
+		}
+
+		for i, p := range a.Pekerjaan {
+			jobs++
+			if p.TanggalSelesaiKerja != nil && !p.TanggalSelesaiKerja.After(p.TanggalMulaiKerja) {
+				t.Errorf("%s: job ends before it starts", a.NIM)
+			}
+			// hanya pekerjaan terakhir yang boleh masih aktif
+			if p.TanggalSelesaiKerja == nil && i != len(a.Pekerjaan)-1 {
+				t.Errorf("%s: open-ended job must be the latest", a.NIM)
+			}
+			if i > 0 && p.TanggalMulaiKerja.Before(*a.Pekerjaan[i-1].TanggalSelesaiKerja) {
+				t.Errorf("%s: overlapping job history", a.NIM)
+			}
+		}
+
+		for _, f := range a.Files {
+			files++
+			magic := map[string][]byte{"foto": []byte("\x89PNG"), "sertifikat": []byte("%PDF")}[f.Category]
+			if !bytes.HasPrefix(f.Content, magic) {
+				t.Errorf("%s: invalid %s content", f.FileName, f.Category)
+			}
+		}
+	}
+	if jobs == 0 || files == 0 {
+		t.Errorf("expected jobs and files, got %d jobs %d files", jobs, files)
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"path/filepath"
 	"runtime"
 
-	"backendgo/database"
 	"github.com/joho/godotenv"
 )
 
+// Test unit tidak konek ke PostgreSQL / MongoDB: service dirakit dengan repository
+// in-memory (app/repositoryMemory). Contract test di repository_contract_test.go
+// menjalankan skenario yang sama ke Postgres/Mongo bila TEST_DB_DSN / TEST_MONGO_URI
+// di-set. Di sini cukup load .env untuk konfigurasi.
 func init() {
 
 	// detect root project path
@@ -20,10 +23,4 @@ func init() {
 	if err != nil {
 		log.Println("Warning: gagal load .env dari setup_db.go")
 	}
-
-	// connect PostgreSQL
-	database.ConnectDB()
-
-	// connect MongoDB
-	database.ConnectMongoDB()
 }
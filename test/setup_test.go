@@ -1,7 +1,14 @@
 package test
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"backendgo/middleware"
 
+	"github.com/gofiber/fiber/v2"
+)
+
+// setupApp app kosong dengan ErrorHandler, request id, dan bahasa yang sama seperti main.go
 func setupApp() *fiber.App {
-	return fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: middleware.ErrorHandler})
+	app.Use(middleware.RequestID(), middleware.Language())
+	return app
 }
@@ -0,0 +1,274 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMemory"
+	"backendgo/middleware"
+	"backendgo/utils"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Secret RFC 6238 Appendix B: "12345678901234567890" dalam base32
+const rfcTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode_RFC6238Vectors(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, want := range vectors {
+		got, err := utils.TOTPCode(rfcTOTPSecret, utils.TOTPCounter(time.Unix(unix, 0)))
+		if err != nil {
+			t.Fatalf("TOTPCode: %v", err)
+		}
+		if got != want {
+			t.Errorf("T=%d: got %s, want %s", unix, got, want)
+		}
+	}
+}
+
+func TestValidateTOTP_AllowsOneStepSkew(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	prev, _ := utils.TOTPCode(rfcTOTPSecret, utils.TOTPCounter(now)-1)
+	old, _ := utils.TOTPCode(rfcTOTPSecret, utils.TOTPCounter(now)-3)
+
+	if ok, counter := utils.ValidateTOTP(rfcTOTPSecret, prev, now); !ok || counter != utils.TOTPCounter(now)-1 {
+		t.Errorf("Expected previous-step code to be accepted, got ok=%v counter=%d", ok, counter)
+	}
+	if ok, _ := utils.ValidateTOTP(rfcTOTPSecret, old, now); ok {
+		t.Error("Expected code three steps old to be rejected")
+	}
+	if ok, _ := utils.ValidateTOTP(rfcTOTPSecret, "12345", now); ok {
+		t.Error("Expected short code to be rejected")
+	}
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := utils.TOTPProvisioningURI("Alumni Portal", "admin", "JBSWY3DPEHPK3PXP")
+	if !strings.HasPrefix(uri, "otpauth://totp/Alumni%20Portal:admin?") {
+		t.Errorf("Unexpected label in %s", uri)
+	}
+	if !strings.Contains(uri, "secret=JBSWY3DPEHPK3PXP") || !strings.Contains(uri, "issuer=Alumni+Portal") {
+		t.Errorf("Missing secret/issuer in %s", uri)
+	}
+}
+
+func TestRecoveryCodes_Normalize(t *testing.T) {
+	codes, err := utils.GenerateRecoveryCodes(10)
+	if err != nil || len(codes) != 10 {
+		t.Fatalf("GenerateRecoveryCodes: %v (%d codes)", err, len(codes))
+	}
+	for _, code := range codes {
+		messy := " " + strings.ToUpper(strings.ReplaceAll(code, "-", "")) + " "
+		if utils.NormalizeRecoveryCode(messy) != code {
+			t.Errorf("Normalize(%q) != %q", messy, code)
+		}
+	}
+}
+
+func TestAuthRequired_RejectsMFAChallengeToken(t *testing.T) {
+	token, _, err := utils.GenerateMFAToken(1)
+	if err != nil {
+		t.Fatalf("GenerateMFAToken: %v", err)
+	}
+	if _, err := utils.ValidateToken(token); err != utils.ErrNotAccessToken {
+		t.Errorf("Expected ErrNotAccessToken, got %v", err)
+	}
+
+	app := setupApp()
+	app.Get("/api/profile", middleware.AuthRequired(), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	req := httptest.NewRequest("GET", "/api/profile", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusUnauthorized {
+		t.Errorf("Expected 401 for MFA challenge token, got %d", resp.StatusCode)
+	}
+}
+
+// mfaUser user dengan 2FA aktif dan dua recovery code
+func mfaUser(t *testing.T, store *repositoryMemory.Store) int {
+	userID, err := repositoryMemory.NewUserRepository(store).Create("dina", "dina@example.com", "x", model.RoleUser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mfa := repositoryMemory.NewMFARepository(store)
+	hashes := []string{utils.HashToken("aaaaa-bbbbb"), utils.HashToken("ccccc-ddddd")}
+	if err := mfa.SetTOTPSecret(userID, rfcTOTPSecret); err != nil {
+		t.Fatal(err)
+	}
+	if err := mfa.EnableTOTP(userID, 0, hashes); err != nil {
+		t.Fatal(err)
+	}
+	return userID
+}
+
+func postLoginMFA(t *testing.T, app *fiber.App, mfaToken, recoveryCode string) int {
+	body := `{"mfa_token":"` + mfaToken + `","recovery_code":"` + recoveryCode + `"}`
+	req := httptest.NewRequest("POST", "/api/login/mfa", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode
+}
+
+func TestLoginMFA_ChallengeTokenIsSingleUse(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	userID := mfaUser(t, store)
+	app := setupApp()
+	app.Post("/api/login/mfa", newAuthService(store).LoginMFAService)
+
+	token, _, err := utils.GenerateMFAToken(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := postLoginMFA(t, app, token, "aaaaa-bbbbb"); status != 200 {
+		t.Fatalf("Expected 200 on first use, got %d", status)
+	}
+	// recovery code lain masih valid, tapi token challenge yang sama tidak boleh dipakai lagi
+	if status := postLoginMFA(t, app, token, "ccccc-ddddd"); status != fiber.StatusUnauthorized {
+		t.Errorf("Expected 401 on replayed MFA token, got %d", status)
+	}
+	// replay ditolak sebelum kode dicek, jadi recovery code yang dikirim tidak hangus
+	if left, _ := repositoryMemory.NewMFARepository(store).CountUnusedRecoveryCodes(userID); left != 1 {
+		t.Errorf("Replayed MFA token must not consume a recovery code, %d left", left)
+	}
+}
+
+// Request bersamaan dengan satu token challenge hanya boleh memakai satu faktor
+func TestLoginMFA_ConcurrentChallengeUsesOneFactor(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	userID := mfaUser(t, store)
+	app := setupApp()
+	app.Post("/api/login/mfa", newAuthService(store).LoginMFAService)
+	token, _, _ := utils.GenerateMFAToken(userID)
+
+	var wg sync.WaitGroup
+	for _, code := range []string{"aaaaa-bbbbb", "ccccc-ddddd"} {
+		wg.Add(1)
+		go func(code string) {
+			defer wg.Done()
+			postLoginMFA(t, app, token, code)
+		}(code)
+	}
+	wg.Wait()
+
+	if left, _ := repositoryMemory.NewMFARepository(store).CountUnusedRecoveryCodes(userID); left != 1 {
+		t.Errorf("Expected exactly one recovery code used, %d left", left)
+	}
+}
+
+func TestLoginMFA_RespectsIPThrottle(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	userID := mfaUser(t, store)
+	app := setupApp()
+	app.Post("/api/login/mfa", newAuthService(store).LoginMFAService)
+
+	// IP app.Test selalu 0.0.0.0
+	attempts := repositoryMemory.NewLoginAttemptRepository(store)
+	if _, err := attempts.RecordFailure(repository.LoginScopeIP, "0.0.0.0", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := attempts.Lock(repository.LoginScopeIP, "0.0.0.0", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	token, _, _ := utils.GenerateMFAToken(userID)
+	if status := postLoginMFA(t, app, token, "aaaaa-bbbbb"); status != fiber.StatusTooManyRequests {
+		t.Errorf("Expected 429 from locked IP, got %d", status)
+	}
+	if left, _ := repositoryMemory.NewMFARepository(store).CountUnusedRecoveryCodes(userID); left != 2 {
+		t.Errorf("Recovery code must not be consumed while IP is locked, %d left", left)
+	}
+}
+
+// Password benar tidak mereset counter gagal akun yang 2FA-nya aktif; kalau direset,
+// kode 2FA bisa ditebak terus dengan login ulang untuk mendapat challenge baru
+func TestLoginMFA_PasswordLoginDoesNotResetFailures(t *testing.T) {
+	t.Setenv("LOGIN_MAX_FAILURES", "3")
+	t.Setenv("LOGIN_BACKOFF_BASE", "0s")
+	store := repositoryMemory.NewStore()
+	userID := mfaUser(t, store)
+	hash, _ := utils.HashPassword("password-dina")
+	repositoryMemory.NewUserRepository(store).UpdatePassword(userID, hash, false)
+
+	auth := newAuthService(store)
+	app := setupApp()
+	app.Post("/api/login", auth.LoginService)
+	app.Post("/api/login/mfa", auth.LoginMFAService)
+
+	login := func() (int, string) {
+		req := httptest.NewRequest("POST", "/api/login", strings.NewReader(`{"username":"dina","password":"password-dina"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var body struct {
+			Data model.MFAChallengeResponse `json:"data"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+		return resp.StatusCode, body.Data.MFAToken
+	}
+
+	for i := 0; i < 3; i++ {
+		status, token := login()
+		if status != 200 || token == "" {
+			t.Fatalf("login %d: expected MFA challenge, got %d", i+1, status)
+		}
+		if status := postLoginMFA(t, app, token, "salah-salah"); status != fiber.StatusUnauthorized {
+			t.Fatalf("wrong code %d: expected 401, got %d", i+1, status)
+		}
+	}
+	if status, _ := login(); status != fiber.StatusTooManyRequests {
+		t.Errorf("expected account locked after repeated 2FA failures, got %d", status)
+	}
+}
+
+// Kebijakan 2FA admin mengikuti permission role, bukan nama role "admin"
+func TestMFARequired_FollowsRolePermissions(t *testing.T) {
+	t.Setenv("MFA_REQUIRED_FOR_ADMIN", "true")
+	store := repositoryMemory.NewStore()
+	rbac := repositoryMemory.NewRBACRepository(store)
+	rbac.CreateRole(model.RoleRequest{Name: "pengelola_akun", Permissions: []string{model.PermUsersManage}})
+	middleware.SetPermissionLoader(rbac.RolePermissionMap)
+	utils.SetMFAAdminRole(func(role string) bool {
+		return middleware.RoleHasPermission(role, model.PermUsersManage) || middleware.RoleHasPermission(role, model.PermRolesManage)
+	})
+	defer utils.SetMFAAdminRole(func(role string) bool { return role == model.RoleAdmin })
+
+	for role, want := range map[string]bool{
+		model.RoleAdmin:  true,
+		"pengelola_akun": true,
+		model.RoleUser:   false,
+	} {
+		if got := utils.MFAEnrollmentRequired(model.User{Role: role}); got != want {
+			t.Errorf("%s: expected enrollment required %v, got %v", role, want, got)
+		}
+	}
+	if utils.MFAEnrollmentRequired(model.User{Role: "pengelola_akun", TOTPEnabled: true}) {
+		t.Error("enrolled user should not need enrollment")
+	}
+
+	app := setupApp()
+	app.Post("/api/mfa/totp/disable", asUser(1, 0, "pengelola_akun"), newAuthService(store).MFADisableService)
+	if status := sendJSON(t, app, "POST", "/api/mfa/totp/disable", `{"code":"123456"}`); status != fiber.StatusForbidden {
+		t.Errorf("disable 2FA for custom admin role: expected 403, got %d", status)
+	}
+}
@@ -0,0 +1,225 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/repository"
+	"backendgo/app/repositoryMemory"
+	"backendgo/app/service"
+	"backendgo/middleware"
+	"backendgo/utils"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func newUserService(store *repositoryMemory.Store) *service.UserService {
+	return service.NewUserService(
+		repositoryMemory.NewUserRepository(store),
+		repositoryMemory.NewRBACRepository(store),
+		repositoryMemory.NewRefreshTokenRepository(store),
+		repositoryMemory.NewLoginAttemptRepository(store),
+		repositoryMemory.NewPasswordResetRepository(store),
+		repositoryMemory.NewAuditRepository(store),
+	)
+}
+
+// userAdminApp route /api/users/:id/status dan /role dengan admin (user id adminID) yang sedang login
+func userAdminApp(store *repositoryMemory.Store, adminID int) *fiber.App {
+	return userManagerApp(store, adminID, model.RoleAdmin)
+}
+
+// userManagerApp seperti userAdminApp dengan role pemanggil tertentu; permission dibaca dari role di store
+func userManagerApp(store *repositoryMemory.Store, userID int, role string) *fiber.App {
+	middleware.SetPermissionLoader(repositoryMemory.NewRBACRepository(store).RolePermissionMap)
+	s := newUserService(store)
+	app := setupApp()
+	app.Put("/api/users/:id/status", asUser(userID, 0, role), s.UpdateUserStatusService)
+	app.Put("/api/users/:id/role", asUser(userID, 0, role), s.UpdateUserRoleService)
+	return app
+}
+
+func TestUserStatus_CannotDisableSelf(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	adminID, _ := repositoryMemory.NewUserRepository(store).Create("admin", "admin@example.com", "x", model.RoleAdmin)
+	app := userAdminApp(store, adminID)
+	path := "/api/users/" + strconv.Itoa(adminID) + "/status"
+
+	if status := sendJSON(t, app, "PUT", path, `{"is_active":false}`); status != fiber.StatusBadRequest {
+		t.Errorf("disable self: expected 400, got %d", status)
+	}
+	admin, _ := repositoryMemory.NewUserRepository(store).GetByID(adminID)
+	if !admin.IsActive {
+		t.Error("admin should stay active")
+	}
+	// mengaktifkan diri sendiri tidak berbahaya
+	if status := sendJSON(t, app, "PUT", path, `{"is_active":true}`); status != 200 {
+		t.Errorf("enable self: expected 200, got %d", status)
+	}
+	if status := sendJSON(t, app, "PUT", "/api/users/999/status", `{"is_active":false}`); status != fiber.StatusNotFound {
+		t.Errorf("unknown user: expected 404, got %d", status)
+	}
+}
+
+func TestUserRole_Guards(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	users := repositoryMemory.NewUserRepository(store)
+	adminID, _ := users.Create("admin", "admin@example.com", "x", model.RoleAdmin)
+	userID, _ := users.Create("sari", "sari@example.com", "x", model.RoleUser)
+	app := userAdminApp(store, adminID)
+	path := func(id int) string { return "/api/users/" + strconv.Itoa(id) + "/role" }
+
+	for name, tc := range map[string]struct {
+		path, body string
+		status     int
+	}{
+		"own role":     {path(adminID), `{"role":"user"}`, fiber.StatusBadRequest},
+		"unknown role": {path(userID), `{"role":"tidak_ada"}`, fiber.StatusBadRequest},
+		"empty role":   {path(userID), `{}`, fiber.StatusBadRequest},
+		"unknown user": {path(999), `{"role":"admin"}`, fiber.StatusNotFound},
+	} {
+		if status := sendJSON(t, app, "PUT", tc.path, tc.body); status != tc.status {
+			t.Errorf("%s: expected %d, got %d", name, tc.status, status)
+		}
+	}
+	if admin, _ := users.GetByID(adminID); admin.Role != model.RoleAdmin {
+		t.Errorf("own role should not change, got %q", admin.Role)
+	}
+
+	if status := sendJSON(t, app, "PUT", path(userID), `{"role":"admin"}`); status != 200 {
+		t.Fatalf("change role: expected 200, got %d", status)
+	}
+	if user, _ := users.GetByID(userID); user.Role != model.RoleAdmin {
+		t.Errorf("expected role admin, got %q", user.Role)
+	}
+}
+
+// users:manage tanpa roles:manage tidak bisa memberikan atau mencabut role dengan permission
+// yang tidak dimiliki pemanggil
+func TestUserRole_RequiresRolesManageForEscalation(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	rbac := repositoryMemory.NewRBACRepository(store)
+	rbac.CreateRole(model.RoleRequest{Name: "helpdesk", Permissions: []string{model.PermUsersManage, model.PermAlumniRead, model.PermPekerjaanRead}})
+	rbac.CreateRole(model.RoleRequest{Name: "pembaca", Permissions: []string{model.PermAlumniRead}})
+	users := repositoryMemory.NewUserRepository(store)
+	helpdeskID, _ := users.Create("helpdesk", "helpdesk@example.com", "x", "helpdesk")
+	userID, _ := users.Create("sari", "sari@example.com", "x", model.RoleUser)
+	adminID, _ := users.Create("admin", "admin@example.com", "x", model.RoleAdmin)
+	app := userManagerApp(store, helpdeskID, "helpdesk")
+	path := func(id int) string { return "/api/users/" + strconv.Itoa(id) + "/role" }
+
+	if status := sendJSON(t, app, "PUT", path(userID), `{"role":"admin"}`); status != fiber.StatusForbidden {
+		t.Errorf("grant admin: expected 403, got %d", status)
+	}
+	if status := sendJSON(t, app, "PUT", path(adminID), `{"role":"user"}`); status != fiber.StatusForbidden {
+		t.Errorf("revoke admin: expected 403, got %d", status)
+	}
+	if u, _ := users.GetByID(userID); u.Role != model.RoleUser {
+		t.Errorf("role should not change, got %q", u.Role)
+	}
+	if u, _ := users.GetByID(adminID); u.Role != model.RoleAdmin {
+		t.Errorf("admin role should not change, got %q", u.Role)
+	}
+
+	// role yang permission-nya sudah dimiliki pemanggil tetap boleh
+	if status := sendJSON(t, app, "PUT", path(userID), `{"role":"pembaca"}`); status != 200 {
+		t.Errorf("grant subset role: expected 200, got %d", status)
+	}
+}
+
+// Menonaktifkan user mencabut semua sesinya: access token lama ditolak, refresh dan login juga
+func TestUserStatus_DisableRevokesSessions(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	users := repositoryMemory.NewUserRepository(store)
+	adminID, _ := users.Create("admin", "admin@example.com", "x", model.RoleAdmin)
+	userID := passwordUser(t, store, false)
+	tokens := repositoryMemory.NewRefreshTokenRepository(store)
+	tokens.Create(userID, utils.HashToken("refresh-sari-2"), "sesi-2", time.Now().Add(time.Hour))
+	middleware.SetSessionLoader(tokens.GetActiveSession)
+
+	user, _ := users.GetByID(userID)
+	token, _, err := utils.GenerateToken(*user, "sesi-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := userAdminApp(store, adminID)
+	ok := func(c *fiber.Ctx) error { return c.SendString("ok") }
+	app.Get("/api/profile", middleware.AuthRequired(), ok)
+	auth := newAuthService(store)
+	app.Post("/api/login", auth.LoginService)
+	app.Post("/api/token/refresh", auth.RefreshTokenService)
+	bearer := []string{"Authorization", "Bearer " + token}
+
+	if status := sendJSON(t, app, "GET", "/api/profile", "", bearer...); status != 200 {
+		t.Fatalf("before disable: expected 200, got %d", status)
+	}
+	if status := sendJSON(t, app, "PUT", "/api/users/"+strconv.Itoa(userID)+"/status", `{"is_active":false}`); status != 200 {
+		t.Fatalf("disable: expected 200, got %d", status)
+	}
+
+	for _, family := range []string{"sesi-1", "sesi-2"} {
+		if session, _ := tokens.GetActiveSession(family); session != nil {
+			t.Errorf("%s should be revoked, got %+v", family, session)
+		}
+	}
+	if status := sendJSON(t, app, "GET", "/api/profile", "", bearer...); status != fiber.StatusUnauthorized {
+		t.Errorf("access token after disable: expected 401, got %d", status)
+	}
+	if status, _ := postRefresh(t, app, "refresh-sari-2"); status != fiber.StatusUnauthorized {
+		t.Errorf("refresh after disable: expected 401, got %d", status)
+	}
+	if status := sendJSON(t, app, "POST", "/api/login", `{"username":"sari","password":"password-lama"}`); status != fiber.StatusForbidden {
+		t.Errorf("login after disable: expected 403, got %d", status)
+	}
+}
+
+// Sesi yang masih aktif milik user nonaktif (mis. pencabutan sesi gagal) tetap ditolak AuthRequired
+func TestAuthRequired_RejectsDisabledAccount(t *testing.T) {
+	store := repositoryMemory.NewStore()
+	userID := passwordUser(t, store, false)
+	users := repositoryMemory.NewUserRepository(store)
+	middleware.SetSessionLoader(repositoryMemory.NewRefreshTokenRepository(store).GetActiveSession)
+
+	user, _ := users.GetByID(userID)
+	token, _, _ := utils.GenerateToken(*user, "sesi-1")
+	users.SetActive(userID, false)
+
+	app := setupApp()
+	app.Get("/api/profile", middleware.AuthRequired(), func(c *fiber.Ctx) error { return c.SendString("ok") })
+	if status := sendJSON(t, app, "GET", "/api/profile", "", "Authorization", "Bearer "+token); status != fiber.StatusForbidden {
+		t.Errorf("disabled account: expected 403, got %d", status)
+	}
+}
+
+// brokenUserRepo mensimulasikan database user yang sedang bermasalah
+type brokenUserRepo struct {
+	repository.UserRepository
+}
+
+func (brokenUserRepo) GetByUsernameOrEmail(string) (*model.User, error) {
+	return nil, errors.New("koneksi database terputus")
+}
+
+// Error database saat mencari user bukan "username salah": 500, tanpa mencatat login gagal
+func TestLogin_UserLookupErrorIsInternal(t *testing.T) {
+	t.Setenv("LOGIN_MAX_FAILURES", "1")
+	store := repositoryMemory.NewStore()
+	auth := service.NewAuthService(
+		brokenUserRepo{repositoryMemory.NewUserRepository(store)},
+		repositoryMemory.NewRefreshTokenRepository(store),
+		repositoryMemory.NewLoginAttemptRepository(store),
+		repositoryMemory.NewMFARepository(store),
+		repositoryMemory.NewPasswordResetRepository(store),
+		repositoryMemory.NewAuditRepository(store),
+	)
+	app := setupApp()
+	app.Post("/api/login", auth.LoginService)
+
+	for i := 0; i < 2; i++ {
+		if status := sendJSON(t, app, "POST", "/api/login", `{"username":"sari","password":"password-lama"}`); status != fiber.StatusInternalServerError {
+			t.Errorf("attempt %d: expected 500, got %d", i+1, status)
+		}
+	}
+}
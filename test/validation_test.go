@@ -0,0 +1,132 @@
+package test
+
+import (
+	"backendgo/app/model"
+	"backendgo/app/modelmongo"
+	"backendgo/apperror"
+	"backendgo/utils"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func fieldErrors(errs []model.FieldError) map[string]string {
+	out := map[string]string{}
+	for _, e := range errs {
+		out[e.Field] = e.Message
+	}
+	return out
+}
+
+func TestValidate_CreateAlumniRequest(t *testing.T) {
+	valid := model.CreateAlumniRequest{
+		NIM: "20200001", Nama: "Budi", Angkatan: 2020, TahunLulus: 2024,
+		Email: "budi@example.com", NoTelepon: "+6281234567890",
+	}
+	if errs := utils.Validate(valid); len(errs) != 0 {
+		t.Fatalf("expected valid request, got %+v", errs)
+	}
+
+	invalid := model.CreateAlumniRequest{
+		NIM: "A-1", Angkatan: 2020, TahunLulus: 2018,
+		Email: "budi@", NoTelepon: "12345",
+	}
+	got := fieldErrors(utils.Validate(invalid))
+	want := map[string]string{
+		"nim":         "format NIM tidak valid",
+		"nama":        "wajib diisi",
+		"tahun_lulus": "tidak boleh lebih kecil dari angkatan",
+		"email":       "format email tidak valid",
+	}
+	for field, msg := range want {
+		if got[field] != msg {
+			t.Errorf("%s: expected %q, got %q", field, msg, got[field])
+		}
+	}
+	if !strings.HasPrefix(got["no_telepon"], "format nomor telepon tidak valid") {
+		t.Errorf("no_telepon: unexpected %q", got["no_telepon"])
+	}
+	if len(got) != 5 {
+		t.Errorf("expected 5 field errors, got %v", got)
+	}
+}
+
+func TestValidate_PhoneFormats(t *testing.T) {
+	for phone, ok := range map[string]bool{
+		"081234567890":      true,
+		"0812-3456-7890":    true,
+		"+6281234567890":    true,
+		"6281234567890":     true,
+		"+14155552671":      true,
+		"021555":            false,
+		"+0812345678":       false,
+		"08123456789012345": false,
+	} {
+		errs := utils.Validate(model.UpdateMyAlumniRequest{NoTelepon: &phone})
+		if (len(errs) == 0) != ok {
+			t.Errorf("%s: expected valid=%v, got %+v", phone, ok, errs)
+		}
+	}
+}
+
+func TestValidate_PekerjaanDates(t *testing.T) {
+	selesai := "2023-07-31"
+	req := model.CreatePekerjaanRequest{
+		AlumniID: 1, NamaPerusahaan: "PT A", PosisiJabatan: "Dev",
+		TanggalMulaiKerja: "2023-08-01", TanggalSelesaiKerja: &selesai, StatusPekerjaan: "pensiun",
+	}
+	got := fieldErrors(utils.Validate(req))
+	if got["tanggal_selesai_kerja"] != "tidak boleh sebelum tanggal_mulai_kerja" {
+		t.Errorf("unexpected tanggal_selesai_kerja error: %q", got["tanggal_selesai_kerja"])
+	}
+	if got["status_pekerjaan"] != "harus salah satu dari: aktif, selesai, resign" {
+		t.Errorf("unexpected status_pekerjaan error: %q", got["status_pekerjaan"])
+	}
+
+	empty := ""
+	req.TanggalSelesaiKerja, req.StatusPekerjaan = &empty, ""
+	if errs := utils.Validate(req); len(errs) != 0 {
+		t.Errorf("empty optional fields should pass, got %+v", errs)
+	}
+}
+
+func TestValidate_MongoPartialUpdate(t *testing.T) {
+	blank, date := " ", "2023/08/01"
+	got := fieldErrors(utils.Validate(modelmongo.UpdatePekerjaanRequest{NamaPerusahaan: &blank, TanggalMulaiKerja: &date}))
+	if got["nama_perusahaan"] != "tidak boleh kosong" || got["tanggal_mulai_kerja"] != "format tanggal harus YYYY-MM-DD" {
+		t.Errorf("unexpected errors: %v", got)
+	}
+	if errs := utils.Validate(modelmongo.UpdatePekerjaanRequest{}); len(errs) != 0 {
+		t.Errorf("fields not sent should pass, got %+v", errs)
+	}
+}
+
+func TestCreateAlumni_ValidationErrors(t *testing.T) {
+	app := setupApp()
+	app.Post("/api/alumni", newAlumniService().CreateAlumniService)
+
+	req := httptest.NewRequest("POST", "/api/alumni", strings.NewReader(`{"nim":"","email":"x","angkatan":2020,"tahun_lulus":2019}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := app.Test(req)
+	if resp.StatusCode != 422 {
+		t.Fatalf("expected 422, got %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Error struct {
+			Code    string             `json:"code"`
+			Details []model.FieldError `json:"details"`
+		} `json:"error"`
+	}
+	json.NewDecoder(resp.Body).Decode(&body)
+	if body.Error.Code != apperror.CodeValidation {
+		t.Errorf("expected code %s, got %q", apperror.CodeValidation, body.Error.Code)
+	}
+	got := fieldErrors(body.Error.Details)
+	for _, field := range []string{"nim", "nama", "email", "tahun_lulus"} {
+		if got[field] == "" {
+			t.Errorf("expected error for %s, got %v", field, got)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package utils
+
+import (
+	"backendgo/app/model"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MapAlumniImportRows memetakan baris spreadsheet (baris pertama = header) ke CreateAlumniRequest.
+// mapping: kolom alumni → nama header di file, mis. {"nim": "NIM Mahasiswa"}; kolom yang
+// tidak dipetakan dicari dari header dengan nama yang sama. Baris tidak valid dilaporkan di errs.
+func MapAlumniImportRows(rows [][]string, mapping map[string]string) ([]model.AlumniImportRow, []model.ImportRowError, error) {
+	if len(rows) == 0 {
+		return nil, nil, fmt.Errorf("file kosong")
+	}
+
+	header := map[string]int{}
+	for i, name := range rows[0] {
+		header[normalizeHeader(name)] = i
+	}
+	for target := range mapping {
+		if !isAlumniImportColumn(target) {
+			return nil, nil, fmt.Errorf("kolom mapping %q tidak dikenal", target)
+		}
+	}
+
+	cols := map[string]int{}
+	for _, target := range model.AlumniImportColumns {
+		source := target
+		if m, ok := mapping[target]; ok && strings.TrimSpace(m) != "" {
+			source = m
+		}
+		if i, ok := header[normalizeHeader(source)]; ok {
+			cols[target] = i
+		} else if _, mapped := mapping[target]; mapped {
+			return nil, nil, fmt.Errorf("kolom %q (untuk %s) tidak ada di header", source, target)
+		}
+	}
+	for _, required := range []string{"nim", "nama", "email"} {
+		if _, ok := cols[required]; !ok {
+			return nil, nil, fmt.Errorf("kolom %s wajib ada di file", required)
+		}
+	}
+
+	get := func(record []string, col string) string {
+		i, ok := cols[col]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var (
+		result []model.AlumniImportRow
+		errs   []model.ImportRowError
+	)
+	seen := map[string]int{}
+	for n, record := range rows[1:] {
+		line := n + 2
+		if isBlankRecord(record) {
+			continue
+		}
+
+		a := model.CreateAlumniRequest{
+			NIM:       get(record, "nim"),
+			Nama:      get(record, "nama"),
+			Jurusan:   get(record, "jurusan"),
+			Email:     get(record, "email"),
+			NoTelepon: get(record, "no_telepon"),
+			Alamat:    get(record, "alamat"),
+		}
+		rowErrs := []model.ImportRowError{}
+		fail := func(col, msg string) {
+			rowErrs = append(rowErrs, model.ImportRowError{Row: line, NIM: a.NIM, Column: col, Message: msg})
+		}
+
+		if a.NIM == "" {
+			fail("nim", "wajib diisi")
+		} else if first, dup := seen[a.NIM]; dup {
+			fail("nim", fmt.Sprintf("duplikat dengan baris %d", first))
+		}
+		if a.Nama == "" {
+			fail("nama", "wajib diisi")
+		}
+		if a.Email == "" {
+			fail("email", "wajib diisi")
+		} else if !strings.Contains(a.Email, "@") {
+			fail("email", "format email tidak valid")
+		}
+
+		numbers := []struct {
+			col string
+			dst *int
+		}{{"angkatan", &a.Angkatan}, {"tahun_lulus", &a.TahunLulus}}
+		for _, num := range numbers {
+			v := get(record, num.col)
+			if v == "" {
+				continue
+			}
+			// XLSX menyimpan angka sebagai "2019" atau "2019.0"
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || f != float64(int(f)) {
+				fail(num.col, "harus berupa angka")
+				continue
+			}
+			*num.dst = int(f)
+		}
+		if a.Angkatan > 0 && a.TahunLulus > 0 && a.TahunLulus < a.Angkatan {
+			fail("tahun_lulus", "tidak boleh lebih kecil dari angkatan")
+		}
+
+		if v := get(record, "status_kematian"); v != "" {
+			status, ok := parseImportBool(v)
+			if !ok {
+				fail("status_kematian", "harus true/false")
+			}
+			a.StatusKematian = status
+		}
+
+		if len(rowErrs) > 0 {
+			errs = append(errs, rowErrs...)
+			continue
+		}
+		seen[a.NIM] = line
+		result = append(result, model.AlumniImportRow{Row: line, Data: a})
+	}
+	return result, errs, nil
+}
+
+// BuildImportErrorReport membuat CSV laporan error import
+func BuildImportErrorReport(errs []model.ImportRowError) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"baris", "nim", "kolom", "pesan"}); err != nil {
+		return nil, err
+	}
+	for _, e := range errs {
+		if err := w.Write([]string{strconv.Itoa(e.Row), e.NIM, e.Column, e.Message}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func normalizeHeader(name string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+}
+
+func isAlumniImportColumn(col string) bool {
+	for _, c := range model.AlumniImportColumns {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func parseImportBool(v string) (bool, bool) {
+	switch strings.ToLower(v) {
+	case "true", "1", "ya", "wafat":
+		return true, true
+	case "false", "0", "tidak", "hidup":
+		return false, true
+	}
+	return false, false
+}
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"backendgo/apperror"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Arah cursor pagination
+const (
+	CursorNext = "next"
+	CursorPrev = "prev"
+)
+
+// CursorKind tipe nilai satu kolom keyset. Nilai cursor dari client dicek terhadap tipe
+// kolomnya sebelum dipakai, cursor yang diubah client dijawab 400 bukan error database.
+type CursorKind int
+
+const (
+	CursorText      CursorKind = iota
+	CursorInt                  // kolom INTEGER / SERIAL
+	CursorBool                 // kolom BOOLEAN
+	CursorTimestamp            // kolom TIMESTAMP / DATE PostgreSQL, format CursorTimeLayout
+	CursorRFC3339              // tanggal BSON MongoDB, format RFC3339Nano
+	CursorObjectID             // _id MongoDB, hex 24 karakter
+)
+
+// CursorTimeLayout format kolom waktu PostgreSQL di cursor (tanpa zona, sama dengan kolom TIMESTAMP)
+const CursorTimeLayout = "2006-01-02T15:04:05.999999"
+
+// ParseCursorValue ubah nilai teks cursor ke tipe Go kolomnya (int, bool, time.Time, string)
+func ParseCursorValue(kind CursorKind, raw string) (interface{}, error) {
+	switch kind {
+	case CursorInt:
+		return strconv.Atoi(raw)
+	case CursorBool:
+		return strconv.ParseBool(raw)
+	case CursorTimestamp:
+		return time.Parse(CursorTimeLayout, raw)
+	case CursorRFC3339:
+		return time.Parse(time.RFC3339Nano, raw)
+	case CursorObjectID:
+		if b, err := hex.DecodeString(raw); err != nil || len(b) != 12 {
+			return nil, apperror.BadRequest("list.invalid_cursor")
+		}
+		return raw, nil
+	default:
+		return raw, nil
+	}
+}
+
+// Cursor posisi keyset pagination. Dikirim ke client sebagai string opaque (base64url JSON);
+// nilai hanya dipakai lewat placeholder query, jadi cursor yang diubah client paling jauh
+// hanya menggeser posisi halaman.
+type Cursor struct {
+	Sort   string   `json:"s"` // urutan saat cursor dibuat, harus sama saat dipakai
+	Values []string `json:"v"` // nilai kolom sort baris batas (termasuk tie breaker)
+	Dir    string   `json:"d"`
+}
+
+func EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor validasi cursor dari client; sortKey = urutan yang sedang dipakai request,
+// kinds = tipe tiap kolom keyset (jumlah dan tipe nilai cursor harus cocok)
+func DecodeCursor(s, sortKey string, kinds []CursorKind) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, apperror.BadRequest("list.invalid_cursor")
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || len(c.Values) == 0 {
+		return nil, apperror.BadRequest("list.invalid_cursor")
+	}
+	if c.Dir != CursorNext && c.Dir != CursorPrev {
+		return nil, apperror.BadRequest("list.invalid_cursor")
+	}
+	if c.Sort != sortKey {
+		return nil, apperror.BadRequest("list.cursor_mismatch")
+	}
+	if len(c.Values) != len(kinds) {
+		return nil, apperror.BadRequest("list.invalid_cursor")
+	}
+	for i, kind := range kinds {
+		if _, err := ParseCursorValue(kind, c.Values[i]); err != nil {
+			return nil, apperror.BadRequest("list.invalid_cursor")
+		}
+	}
+	return &c, nil
+}
+
+// CursorPage cursor halaman berikut / sebelumnya ("" = tidak ada)
+type CursorPage struct {
+	Next string
+	Prev string
+}
+
+// BuildCursorPage potong hasil query (diambil limit+1 baris) menjadi satu halaman dan buat
+// cursor next / prev. Untuk arah prev, hasil query berurutan terbalik dan dibalik di sini.
+func BuildCursorPage[T any](list []T, limit int, cur *Cursor, sortKey string, values func(T) []string) ([]T, CursorPage) {
+	hasMore := len(list) > limit
+	if hasMore {
+		list = list[:limit]
+	}
+	reverse := cur != nil && cur.Dir == CursorPrev
+	if reverse {
+		for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+			list[i], list[j] = list[j], list[i]
+		}
+	}
+
+	var page CursorPage
+	if len(list) == 0 {
+		return list, page
+	}
+	if hasMore || reverse {
+		page.Next = EncodeCursor(Cursor{Sort: sortKey, Values: values(list[len(list)-1]), Dir: CursorNext})
+	}
+	if (reverse && hasMore) || (!reverse && cur != nil) {
+		page.Prev = EncodeCursor(Cursor{Sort: sortKey, Values: values(list[0]), Dir: CursorPrev})
+	}
+	return list, page
+}
@@ -0,0 +1,187 @@
+package utils
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TableWriter menulis tabel baris per baris ke output tanpa menampung seluruh data di memori.
+// Close wajib dipanggil untuk menutup struktur file (zip XLSX, xref PDF).
+type TableWriter interface {
+	WriteHeader(cols []string) error
+	WriteRow(values []string) error
+	Close() error
+}
+
+// Format export yang didukung
+const (
+	ExportCSV  = "csv"
+	ExportXLSX = "xlsx"
+	ExportPDF  = "pdf"
+)
+
+// ExportContentType content type HTTP untuk format export
+func ExportContentType(format string) string {
+	switch format {
+	case ExportXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ExportPDF:
+		return "application/pdf"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// NewTableWriter buat writer sesuai format. title dipakai sebagai judul PDF / nama sheet XLSX,
+// widths = lebar relatif tiap kolom di PDF.
+func NewTableWriter(format string, w io.Writer, title string, widths []float64) (TableWriter, error) {
+	switch format {
+	case ExportCSV:
+		return NewCSVTableWriter(w), nil
+	case ExportXLSX:
+		return NewXLSXTableWriter(w, title), nil
+	case ExportPDF:
+		return NewPDFTableWriter(w, title, widths), nil
+	default:
+		return nil, errors.New("format harus csv, xlsx, atau pdf")
+	}
+}
+
+// ---- CSV ----
+
+type csvTableWriter struct {
+	w *csv.Writer
+}
+
+func NewCSVTableWriter(w io.Writer) TableWriter {
+	return &csvTableWriter{w: csv.NewWriter(w)}
+}
+
+func (t *csvTableWriter) WriteHeader(cols []string) error { return t.w.Write(cols) }
+
+func (t *csvTableWriter) WriteRow(values []string) error { return t.w.Write(values) }
+
+func (t *csvTableWriter) Close() error {
+	t.w.Flush()
+	return t.w.Error()
+}
+
+// ---- XLSX ----
+// Sheet ditulis langsung ke entry zip; semua sel berupa inline string supaya
+// tidak perlu sharedStrings.xml (yang harus ditulis setelah semua data diketahui).
+
+type xlsxTableWriter struct {
+	zw    *zip.Writer
+	sheet io.Writer
+	row   int
+	err   error
+}
+
+func NewXLSXTableWriter(w io.Writer, sheetName string) TableWriter {
+	t := &xlsxTableWriter{zw: zip.NewWriter(w)}
+	t.err = t.writeParts(sheetName)
+	return t
+}
+
+func (t *xlsxTableWriter) writeParts(sheetName string) error {
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+		{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+		{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="` + xmlEscape(xlsxSheetName(sheetName)) + `" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`},
+	}
+	for _, p := range parts {
+		f, err := t.zw.Create(p.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return err
+		}
+	}
+
+	sheet, err := t.zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	t.sheet = sheet
+	_, err = io.WriteString(sheet, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return err
+}
+
+func (t *xlsxTableWriter) WriteHeader(cols []string) error { return t.WriteRow(cols) }
+
+func (t *xlsxTableWriter) WriteRow(values []string) error {
+	if t.err != nil {
+		return t.err
+	}
+	t.row++
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, t.row)
+	for i, v := range values {
+		fmt.Fprintf(&b, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`,
+			xlsxColumnName(i), t.row, xmlEscape(v))
+	}
+	b.WriteString(`</row>`)
+	_, t.err = io.WriteString(t.sheet, b.String())
+	return t.err
+}
+
+func (t *xlsxTableWriter) Close() error {
+	if t.err == nil {
+		_, t.err = io.WriteString(t.sheet, `</sheetData></worksheet>`)
+	}
+	if err := t.zw.Close(); t.err == nil {
+		t.err = err
+	}
+	return t.err
+}
+
+// xlsxColumnName 0 → A, 25 → Z, 26 → AA
+func xlsxColumnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// xlsxSheetName nama sheet maksimal 31 karakter dan tanpa : \ / ? * [ ]
+func xlsxSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`:\/?*[]`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" {
+		name = "Sheet1"
+	}
+	if r := []rune(name); len(r) > 31 {
+		name = string(r[:31])
+	}
+	return name
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	// karakter kontrol selain tab/newline tidak valid di XML
+	s = strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, s)
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -1,47 +1,163 @@
 package utils
 
 import (
-	"os"
+	"errors"
+	"log"
+	"sync"
 	"time"
-	"fmt"
 
-	"github.com/golang-jwt/jwt/v5"
 	"backendgo/app/model"
+	"backendgo/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-var jwtSecret = []byte(os.Getenv("JWT_SECRET"))
+var (
+	keyStore     *KeyStore
+	keyStoreOnce sync.Once
+)
+
+// DefaultKeyStore key store dari env, dibuat saat pertama kali dipakai
+// (setelah .env di-load, bukan saat package init).
+//
+//	JWT_ALG     HS256 (default), RS256, atau EdDSA
+//	JWT_SECRET  secret untuk HS256
+//	JWT_KEY_DIR direktori kunci privat untuk RS256/EdDSA
+func DefaultKeyStore() *KeyStore {
+	keyStoreOnce.Do(func() {
+		ks, err := NewKeyStore(
+			config.GetEnv("JWT_KEY_DIR", ""),
+			config.GetEnv("JWT_ALG", AlgHS256),
+			[]byte(config.GetEnv("JWT_SECRET", "")),
+		)
+		if err != nil {
+			log.Fatal("Gagal menyiapkan kunci JWT:", err)
+		}
+		keyStore = ks
+	})
+	return keyStore
+}
+
+// StartKeyRotation menjalankan rotasi kunci sesuai JWT_KEY_ROTATION_INTERVAL
+// (0 = tanpa rotasi). Kunci lama masih diterima selama JWT_KEY_RETENTION.
+func StartKeyRotation(stop <-chan struct{}) {
+	DefaultKeyStore().StartRotation(
+		config.GetDuration("JWT_KEY_ROTATION_INTERVAL", 0),
+		config.GetDuration("JWT_KEY_RETENTION", 24*time.Hour),
+		stop,
+	)
+}
+
+// AccessTokenTTL masa berlaku access token (env ACCESS_TOKEN_TTL, default 15 menit)
+func AccessTokenTTL() time.Duration {
+	return config.GetDuration("ACCESS_TOKEN_TTL", 15*time.Minute)
+}
+
+// GenerateToken bikin access token JWT yang terikat ke sesi (sid = family refresh token)
+func GenerateToken(user model.User, sessionID string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(AccessTokenTTL())
 
-// GenerateToken bikin token JWT
-func GenerateToken(user model.User) (string, error) {
 	claims := jwt.MapClaims{
-		"user_id":  user.ID,
-		"username": user.Username,
-		"role":     user.Role,
-		"exp":      time.Now().Add(time.Hour * 72).Unix(), // token berlaku 3 hari
+		"iss":        config.GetEnv("JWT_ISSUER", "alumni-portal"),
+		"user_id":    user.ID,
+		"username":   user.Username,
+		"role":       user.Role,
+		"sid":        sessionID,
+		"mcp":        user.MustChangePassword,
+		"mfa_enroll": MFAEnrollmentRequired(user),
+		"jti":        uuid.New().String(),
+		"iat":        now.Unix(),
+		"exp":        expiresAt.Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	signed, err := DefaultKeyStore().Sign(claims)
+	return signed, expiresAt, err
 }
 
-// ValidateToken memverifikasi token JWT dan mengembalikan claims
+// ErrNotAccessToken token valid tapi bertujuan khusus (mis. challenge MFA),
+// tidak boleh dipakai sebagai access token.
+var ErrNotAccessToken = errors.New("bukan access token")
+
+// ValidateToken memverifikasi access token JWT dan mengembalikan claims
 func ValidateToken(tokenString string) (jwt.MapClaims, error) {
-    token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-        
-        if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-            return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-        }
-        return jwtSecret, nil
-    })
-
-    if err != nil {
-        return nil, err
-    }
-
-    // Ambil claims
-    if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-        return claims, nil
-    }
-
-    return nil, fmt.Errorf("invalid token")
+	claims, err := DefaultKeyStore().Parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := claims["purpose"]; ok {
+		return nil, ErrNotAccessToken
+	}
+	return claims, nil
+}
+
+// mfaAdminRole penentu role yang terkena MFA_REQUIRED_FOR_ADMIN, dipasang saat wiring
+// lewat SetMFAAdminRole (default: role admin bawaan)
+var mfaAdminRole = func(role string) bool { return role == model.RoleAdmin }
+
+// SetMFAAdminRole memasang penentu role admin untuk kebijakan 2FA, mis. berdasarkan
+// permission role supaya role admin kustom ikut wajib 2FA
+func SetMFAAdminRole(isAdmin func(role string) bool) {
+	mfaAdminRole = isAdmin
+}
+
+// MFARequired true kalau kebijakan MFA_REQUIRED_FOR_ADMIN aktif dan role ini termasuk admin
+func MFARequired(role string) bool {
+	return config.GetBool("MFA_REQUIRED_FOR_ADMIN", false) && mfaAdminRole(role)
+}
+
+// MFAEnrollmentRequired true kalau 2FA wajib untuk role user ini (MFARequired) tapi
+// belum diaktifkan. Token-nya hanya boleh dipakai untuk enrollment.
+func MFAEnrollmentRequired(user model.User) bool {
+	return !user.TOTPEnabled && MFARequired(user.Role)
+}
+
+// GenerateMFAToken token challenge berumur pendek setelah password benar,
+// ditukar dengan access token di /api/login/mfa bersama kode TOTP.
+func GenerateMFAToken(userID int) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(config.GetDuration("MFA_CHALLENGE_TTL", 5*time.Minute))
+
+	claims := jwt.MapClaims{
+		"iss":     config.GetEnv("JWT_ISSUER", "alumni-portal"),
+		"purpose": "mfa",
+		"user_id": userID,
+		"jti":     uuid.New().String(),
+		"iat":     now.Unix(),
+		"exp":     expiresAt.Unix(),
+	}
+
+	signed, err := DefaultKeyStore().Sign(claims)
+	return signed, expiresAt, err
+}
+
+// MFAChallenge isi token challenge MFA yang sudah diverifikasi
+type MFAChallenge struct {
+	UserID    int
+	JTI       string    // id token, dicatat saat dipakai supaya token hanya berlaku sekali
+	ExpiresAt time.Time
+}
+
+// ValidateMFAToken memverifikasi token challenge MFA dan mengembalikan isinya
+func ValidateMFAToken(tokenString string) (MFAChallenge, error) {
+	claims, err := DefaultKeyStore().Parse(tokenString)
+	if err != nil {
+		return MFAChallenge{}, err
+	}
+	if purpose, _ := claims["purpose"].(string); purpose != "mfa" {
+		return MFAChallenge{}, errors.New("bukan token MFA")
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return MFAChallenge{}, errors.New("user_id tidak ada di token")
+	}
+	jti, ok := claims["jti"].(string)
+	if !ok || jti == "" {
+		return MFAChallenge{}, errors.New("jti tidak ada di token")
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return MFAChallenge{}, errors.New("exp tidak ada di token")
+	}
+	return MFAChallenge{UserID: int(userID), JTI: jti, ExpiresAt: exp.Time}, nil
 }
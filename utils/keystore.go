@@ -0,0 +1,439 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Algoritma penandatanganan yang didukung
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+const rsaKeyBits = 2048
+
+// unknownKidReloadInterval jarak minimal antar reload yang dipicu kid tidak dikenal,
+// supaya token dengan kid sembarang tidak membuat direktori kunci dibaca terus-menerus
+const unknownKidReloadInterval = 10 * time.Second
+
+// signingKey satu kunci di key store, diidentifikasi lewat kid
+type signingKey struct {
+	kid       string
+	alg       string
+	private   interface{} // *rsa.PrivateKey, ed25519.PrivateKey, atau []byte (HS256)
+	public    crypto.PublicKey
+	createdAt time.Time
+}
+
+// JWK representasi kunci publik untuk endpoint JWKS (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSet isi dari /.well-known/jwks.json
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeyStore menyimpan kunci penandatanganan JWT.
+//
+// Untuk RS256/EdDSA kunci dibaca dari direktori (satu file PEM PKCS#8 per kunci,
+// nama file = kid). Kunci terbaru dipakai untuk menandatangani token baru, kunci
+// lama tetap dipakai untuk verifikasi sampai dihapus oleh rotasi.
+type KeyStore struct {
+	mu     sync.RWMutex
+	dir    string
+	alg    string
+	keys   map[string]*signingKey
+	active *signingKey
+
+	reloadMu     sync.Mutex
+	unknownKidAt time.Time // reload terakhir karena kid tidak dikenal
+}
+
+// NewKeyStore membuat key store. Untuk HS256 cukup secret, dir diabaikan.
+// Untuk RS256/EdDSA, kunci baru dibuat otomatis kalau direktori masih kosong.
+func NewKeyStore(dir, alg string, secret []byte) (*KeyStore, error) {
+	ks := &KeyStore{dir: dir, alg: alg, keys: map[string]*signingKey{}}
+
+	switch alg {
+	case AlgHS256:
+		if len(secret) == 0 {
+			return nil, errors.New("JWT_SECRET wajib diisi untuk HS256")
+		}
+		key := &signingKey{kid: "hs256", alg: AlgHS256, private: secret, public: secret, createdAt: time.Now()}
+		ks.keys[key.kid] = key
+		ks.active = key
+		return ks, nil
+	case AlgRS256, AlgEdDSA:
+		if dir == "" {
+			return nil, fmt.Errorf("JWT_KEY_DIR wajib diisi untuk %s", alg)
+		}
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, err
+		}
+		if err := ks.Reload(); err != nil {
+			return nil, err
+		}
+		if ks.active == nil {
+			if err := ks.Rotate(); err != nil {
+				return nil, err
+			}
+		}
+		return ks, nil
+	default:
+		return nil, fmt.Errorf("algoritma JWT tidak didukung: %s", alg)
+	}
+}
+
+// Alg algoritma yang dipakai key store
+func (ks *KeyStore) Alg() string {
+	return ks.alg
+}
+
+// Reload membaca ulang semua kunci dari direktori, supaya kunci yang dibuat
+// instance lain ikut dikenali saat verifikasi.
+func (ks *KeyStore) Reload() error {
+	if ks.alg == AlgHS256 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(ks.dir)
+	if err != nil {
+		return err
+	}
+
+	keys := map[string]*signingKey{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".pem" {
+			continue
+		}
+		path := filepath.Join(ks.dir, entry.Name())
+		key, err := loadKeyFile(path)
+		if err != nil {
+			log.Printf("Lewati kunci JWT %s: %v\n", path, err)
+			continue
+		}
+		if key.alg != ks.alg {
+			continue
+		}
+		keys[key.kid] = key
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.keys = keys
+	ks.active = newestKey(keys)
+	return nil
+}
+
+// Rotate membuat kunci baru dan menjadikannya kunci aktif
+func (ks *KeyStore) Rotate() error {
+	if ks.alg == AlgHS256 {
+		return errors.New("rotasi kunci tidak didukung untuk HS256")
+	}
+
+	var private interface{}
+	var public crypto.PublicKey
+	switch ks.alg {
+	case AlgRS256:
+		k, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return err
+		}
+		private, public = k, &k.PublicKey
+	case AlgEdDSA:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return err
+		}
+		private, public = priv, pub
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	kid := fmt.Sprintf("%s-%x", now.Format("20060102T150405Z"), suffix)
+
+	path := filepath.Join(ks.dir, kid+".pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return err
+	}
+	// Instance lain membaca createdAt dari mtime file; mtime bawaan filesystem bisa
+	// lebih kasar dari jarak dua rotasi, jadi tulis waktu persisnya supaya semua
+	// instance memilih kunci aktif yang sama
+	if err := os.Chtimes(path, now, now); err != nil {
+		return err
+	}
+
+	key := &signingKey{kid: kid, alg: ks.alg, private: private, public: public, createdAt: now}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.keys[kid] = key
+	ks.active = key
+	log.Printf("Kunci JWT baru dibuat: kid=%s alg=%s\n", kid, ks.alg)
+	return nil
+}
+
+// Prune menghapus kunci non-aktif yang umurnya melewati maxAge
+func (ks *KeyStore) Prune(maxAge time.Duration) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	for kid, key := range ks.keys {
+		if key == ks.active || time.Since(key.createdAt) <= maxAge {
+			continue
+		}
+		if err := os.Remove(filepath.Join(ks.dir, kid+".pem")); err != nil && !os.IsNotExist(err) {
+			log.Printf("Gagal menghapus kunci JWT %s: %v\n", kid, err)
+			continue
+		}
+		delete(ks.keys, kid)
+		log.Printf("Kunci JWT kadaluarsa dihapus: kid=%s\n", kid)
+	}
+}
+
+// StartRotation menjalankan rotasi terjadwal: kunci aktif diganti setiap interval,
+// kunci lama disimpan selama retention setelah tidak aktif lagi untuk verifikasi.
+func (ks *KeyStore) StartRotation(interval, retention time.Duration, stop <-chan struct{}) {
+	if ks.alg == AlgHS256 || interval <= 0 {
+		return
+	}
+
+	check := interval / 10
+	if check > time.Minute {
+		check = time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(check)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := ks.Reload(); err != nil {
+					log.Println("Gagal reload kunci JWT:", err)
+					continue
+				}
+				ks.mu.RLock()
+				due := ks.active == nil || time.Since(ks.active.createdAt) >= interval
+				ks.mu.RUnlock()
+				if due {
+					if err := ks.Rotate(); err != nil {
+						log.Println("Gagal rotasi kunci JWT:", err)
+						continue
+					}
+				}
+				ks.Prune(interval + retention)
+			}
+		}
+	}()
+}
+
+// Sign menandatangani claims dengan kunci aktif dan menambahkan header kid
+func (ks *KeyStore) Sign(claims jwt.Claims) (string, error) {
+	ks.mu.RLock()
+	key := ks.active
+	ks.mu.RUnlock()
+	if key == nil {
+		return "", errors.New("tidak ada kunci JWT aktif")
+	}
+
+	token := jwt.NewWithClaims(signingMethod(key.alg), claims)
+	token.Header["kid"] = key.kid
+	return token.SignedString(key.private)
+}
+
+// Parse memverifikasi token memakai kunci sesuai header kid. Kid yang belum dikenal
+// bisa berasal dari kunci yang baru dirotasi instance lain, jadi direktori kunci
+// dibaca ulang sekali (dibatasi unknownKidReloadInterval) sebelum token ditolak.
+func (ks *KeyStore) Parse(tokenString string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := ks.lookup(kid)
+		if !ok && ks.alg != AlgHS256 && ks.reloadForUnknownKid() {
+			key, ok = ks.lookup(kid)
+		}
+		if !ok {
+			return nil, fmt.Errorf("kid tidak dikenal: %v", token.Header["kid"])
+		}
+		return key.public, nil
+	}, jwt.WithValidMethods([]string{ks.alg}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
+		return claims, nil
+	}
+	return nil, fmt.Errorf("invalid token")
+}
+
+// lookup kunci verifikasi untuk kid
+func (ks *KeyStore) lookup(kid string) (*signingKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	key, ok := ks.keys[kid]
+	if !ok && ks.alg == AlgHS256 {
+		// token lama (sebelum ada kid) tetap diterima selama secret sama
+		key, ok = ks.active, ks.active != nil
+	}
+	return key, ok
+}
+
+// reloadForUnknownKid reload kunci dari direktori, paling sering sekali per
+// unknownKidReloadInterval. Hasil false berarti reload tidak dijalankan atau gagal.
+func (ks *KeyStore) reloadForUnknownKid() bool {
+	ks.reloadMu.Lock()
+	defer ks.reloadMu.Unlock()
+
+	if time.Since(ks.unknownKidAt) < unknownKidReloadInterval {
+		return false
+	}
+	ks.unknownKidAt = time.Now()
+	if err := ks.Reload(); err != nil {
+		log.Println("Gagal reload kunci JWT:", err)
+		return false
+	}
+	return true
+}
+
+// JWKS daftar kunci publik yang masih bisa dipakai untuk verifikasi.
+// Untuk HS256 daftar selalu kosong karena secret tidak boleh dipublikasikan.
+func (ks *KeyStore) JWKS() JWKSet {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	set := JWKSet{Keys: []JWK{}}
+	for _, key := range sortedKeys(ks.keys) {
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, JWK{
+				Kty: "RSA",
+				Kid: key.kid,
+				Use: "sig",
+				Alg: key.alg,
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			set.Keys = append(set.Keys, JWK{
+				Kty: "OKP",
+				Kid: key.kid,
+				Use: "sig",
+				Alg: key.alg,
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+	return set
+}
+
+func loadKeyFile(path string) (*signingKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("bukan file PEM")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &signingKey{
+		kid:       strings.TrimSuffix(filepath.Base(path), ".pem"),
+		private:   parsed,
+		createdAt: info.ModTime(),
+	}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.alg, key.public = AlgRS256, &k.PublicKey
+	case ed25519.PrivateKey:
+		key.alg, key.public = AlgEdDSA, k.Public()
+	default:
+		return nil, fmt.Errorf("tipe kunci tidak didukung: %T", parsed)
+	}
+	return key, nil
+}
+
+func signingMethod(alg string) jwt.SigningMethod {
+	switch alg {
+	case AlgRS256:
+		return jwt.SigningMethodRS256
+	case AlgEdDSA:
+		return jwt.SigningMethodEdDSA
+	default:
+		return jwt.SigningMethodHS256
+	}
+}
+
+func newestKey(keys map[string]*signingKey) *signingKey {
+	sorted := sortedKeys(keys)
+	if len(sorted) == 0 {
+		return nil
+	}
+	return sorted[len(sorted)-1]
+}
+
+// sortedKeys urut dari yang paling lama ke yang paling baru
+func sortedKeys(keys map[string]*signingKey) []*signingKey {
+	list := make([]*signingKey, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, key)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].createdAt.Equal(list[j].createdAt) {
+			return list[i].kid < list[j].kid
+		}
+		return list[i].createdAt.Before(list[j].createdAt)
+	})
+	return list
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"time"
+
+	"backendgo/config"
+)
+
+// LoginThrottle aturan pembatasan percobaan login: setiap kegagalan memperpanjang
+// jeda secara eksponensial, dan setelah MaxFailures kegagalan dikunci selama
+// LockoutDuration. Kegagalan yang lebih lama dari FailureWindow dilupakan.
+type LoginThrottle struct {
+	MaxFailures     int
+	LockoutDuration time.Duration
+	BackoffBase     time.Duration
+	BackoffMax      time.Duration
+	FailureWindow   time.Duration
+}
+
+// AccountLoginThrottle aturan per akun dari env LOGIN_*
+func AccountLoginThrottle() LoginThrottle {
+	return loginThrottleFromEnv("LOGIN_MAX_FAILURES", 5)
+}
+
+// IPLoginThrottle aturan per alamat IP, batasnya lebih longgar karena satu IP
+// bisa dipakai banyak user (NAT kampus)
+func IPLoginThrottle() LoginThrottle {
+	return loginThrottleFromEnv("LOGIN_IP_MAX_FAILURES", 20)
+}
+
+func loginThrottleFromEnv(maxKey string, maxFallback int) LoginThrottle {
+	return LoginThrottle{
+		MaxFailures:     config.GetInt(maxKey, maxFallback),
+		LockoutDuration: config.GetDuration("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+		BackoffBase:     config.GetDuration("LOGIN_BACKOFF_BASE", time.Second),
+		BackoffMax:      config.GetDuration("LOGIN_BACKOFF_MAX", 5*time.Minute),
+		FailureWindow:   config.GetDuration("LOGIN_FAILURE_WINDOW", time.Hour),
+	}
+}
+
+// Backoff jeda setelah kegagalan ke-n: base, 2×base, 4×base, ... maksimal BackoffMax
+func (p LoginThrottle) Backoff(failures int) time.Duration {
+	if failures <= 0 || p.BackoffBase <= 0 {
+		return 0
+	}
+	d := p.BackoffBase
+	for i := 1; i < failures && i < 32; i++ {
+		if p.BackoffMax > 0 && d >= p.BackoffMax {
+			break
+		}
+		d *= 2
+	}
+	if p.BackoffMax > 0 && d > p.BackoffMax {
+		return p.BackoffMax
+	}
+	return d
+}
+
+// ShouldLock true kalau jumlah kegagalan sudah mencapai batas lockout
+func (p LoginThrottle) ShouldLock(failures int) bool {
+	return p.MaxFailures > 0 && failures >= p.MaxFailures
+}
+
+// RetryAt waktu paling cepat percobaan login berikutnya diizinkan.
+// Nilai nol berarti tidak ada pembatasan.
+func (p LoginThrottle) RetryAt(failures int, lastFailure time.Time, lockedUntil *time.Time, now time.Time) time.Time {
+	if lockedUntil != nil && lockedUntil.After(now) {
+		return *lockedUntil
+	}
+	if failures <= 0 || now.Sub(lastFailure) > p.FailureWindow {
+		return time.Time{}
+	}
+	retry := lastFailure.Add(p.Backoff(failures))
+	if retry.After(now) {
+		return retry
+	}
+	return time.Time{}
+}
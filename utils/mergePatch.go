@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrMergePatchNotObject body PATCH harus objek JSON
+var ErrMergePatchNotObject = errors.New("merge patch harus berupa objek JSON")
+
+// ApplyMergePatch terapkan JSON Merge Patch (RFC 7386) ke dokumen JSON target:
+// field di patch menimpa target, null menghapus field, objek digabung rekursif,
+// array dan nilai lain diganti utuh. Field yang tidak ada di patch tidak berubah.
+func ApplyMergePatch(target, patch []byte) ([]byte, error) {
+	var patchDoc interface{}
+	if err := json.Unmarshal(patch, &patchDoc); err != nil {
+		return nil, err
+	}
+	if _, ok := patchDoc.(map[string]interface{}); !ok {
+		return nil, ErrMergePatchNotObject
+	}
+
+	var targetDoc interface{}
+	if err := json.Unmarshal(target, &targetDoc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergePatch(targetDoc, patchDoc))
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = map[string]interface{}{}
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergePatch(targetObj[key], value)
+	}
+	return targetObj
+}
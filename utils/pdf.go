@@ -0,0 +1,250 @@
+package utils
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// PDF tabel sederhana (A4 landscape, font Helvetica bawaan PDF) yang ditulis per halaman:
+// hanya isi halaman aktif yang ditampung di memori, posisi objek dicatat untuk tabel xref.
+const (
+	pdfPageWidth  = 842.0
+	pdfPageHeight = 595.0
+	pdfMargin     = 36.0
+	pdfFontSize   = 8.0
+	pdfRowHeight  = 14.0
+	pdfCellPad    = 3.0
+)
+
+// Objek tetap: 1 = Catalog, 2 = Pages, 3 = Helvetica, 4 = Helvetica-Bold; halaman mulai dari 5
+const pdfFirstPageObj = 5
+
+type pdfTableWriter struct {
+	w       *countingWriter
+	title   string
+	widths  []float64
+	header  []string
+	offsets map[int]int64
+	pages   []int // nomor objek tiap halaman
+	nextObj int
+	page    bytes.Buffer
+	y       float64
+	err     error
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func NewPDFTableWriter(w io.Writer, title string, widths []float64) TableWriter {
+	t := &pdfTableWriter{
+		w:       &countingWriter{w: w},
+		title:   title,
+		widths:  widths,
+		offsets: map[int]int64{},
+		nextObj: pdfFirstPageObj,
+	}
+	_, t.err = io.WriteString(t.w, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	return t
+}
+
+func (t *pdfTableWriter) WriteHeader(cols []string) error {
+	t.header = cols
+	if len(t.widths) != len(cols) {
+		t.widths = make([]float64, len(cols))
+		for i := range t.widths {
+			t.widths[i] = 1
+		}
+	}
+	// skala lebar relatif ke lebar halaman
+	total := 0.0
+	for _, w := range t.widths {
+		total += w
+	}
+	usable := pdfPageWidth - 2*pdfMargin
+	scaled := make([]float64, len(t.widths))
+	for i, w := range t.widths {
+		scaled[i] = w / total * usable
+	}
+	t.widths = scaled
+	return t.err
+}
+
+func (t *pdfTableWriter) WriteRow(values []string) error {
+	if t.err != nil {
+		return t.err
+	}
+	if t.page.Len() == 0 {
+		t.startPage()
+	}
+	if t.y-pdfRowHeight < pdfMargin+pdfRowHeight {
+		if t.err = t.flushPage(); t.err != nil {
+			return t.err
+		}
+		t.startPage()
+	}
+	t.drawRow(values, false)
+	return nil
+}
+
+func (t *pdfTableWriter) startPage() {
+	t.page.Reset()
+	t.y = pdfPageHeight - pdfMargin
+	if len(t.pages) == 0 && t.title != "" {
+		t.text("F2", 14, pdfMargin, t.y-14, t.title)
+		t.text("F1", pdfFontSize, pdfMargin, t.y-28, "Dicetak: "+time.Now().Format("02-01-2006 15:04"))
+		t.y -= 40
+	}
+	if len(t.header) > 0 {
+		fmt.Fprintf(&t.page, "0.85 g %.2f %.2f %.2f %.2f re f 0 g\n",
+			pdfMargin, t.y-pdfRowHeight, pdfPageWidth-2*pdfMargin, pdfRowHeight)
+		t.drawRow(t.header, true)
+	}
+}
+
+func (t *pdfTableWriter) drawRow(values []string, bold bool) {
+	font, charWidth := "F1", 0.52
+	if bold {
+		font, charWidth = "F2", 0.56
+	}
+	x := pdfMargin
+	baseline := t.y - pdfRowHeight + 4
+	for i, w := range t.widths {
+		v := ""
+		if i < len(values) {
+			v = values[i]
+		}
+		maxChars := int((w - 2*pdfCellPad) / (pdfFontSize * charWidth))
+		t.text(font, pdfFontSize, x+pdfCellPad, baseline, truncateRunes(v, maxChars))
+		x += w
+	}
+	t.y -= pdfRowHeight
+	fmt.Fprintf(&t.page, "0.75 G 0.5 w %.2f %.2f m %.2f %.2f l S 0 G\n",
+		pdfMargin, t.y, pdfPageWidth-pdfMargin, t.y)
+}
+
+func (t *pdfTableWriter) text(font string, size, x, y float64, s string) {
+	fmt.Fprintf(&t.page, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, pdfEscape(s))
+}
+
+// flushPage tulis halaman aktif: content stream (zlib) + objek Page
+func (t *pdfTableWriter) flushPage() error {
+	t.text("F1", pdfFontSize, pdfPageWidth-pdfMargin-50, pdfMargin/2, fmt.Sprintf("Halaman %d", len(t.pages)+1))
+
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(t.page.Bytes()); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	contentObj, pageObj := t.nextObj, t.nextObj+1
+	t.nextObj += 2
+	if err := t.writeObj(contentObj, fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.Bytes())); err != nil {
+		return err
+	}
+	if err := t.writeObj(pageObj, fmt.Sprintf(
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+		pdfPageWidth, pdfPageHeight, contentObj)); err != nil {
+		return err
+	}
+	t.pages = append(t.pages, pageObj)
+	t.page.Reset()
+	return nil
+}
+
+func (t *pdfTableWriter) writeObj(num int, body string) error {
+	t.offsets[num] = t.w.n
+	_, err := fmt.Fprintf(t.w, "%d 0 obj\n%s\nendobj\n", num, body)
+	return err
+}
+
+func (t *pdfTableWriter) Close() error {
+	if t.err != nil {
+		return t.err
+	}
+	// Tabel kosong tetap menghasilkan satu halaman berisi judul + header
+	if t.page.Len() == 0 && len(t.pages) == 0 {
+		t.startPage()
+	}
+	if t.page.Len() > 0 {
+		if err := t.flushPage(); err != nil {
+			return err
+		}
+	}
+
+	kids := make([]string, len(t.pages))
+	for i, p := range t.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", p)
+	}
+	objects := []struct {
+		num  int
+		body string
+	}{
+		{1, "<< /Type /Catalog /Pages 2 0 R >>"},
+		{2, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(t.pages))},
+		{3, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"},
+		{4, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>"},
+	}
+	for _, o := range objects {
+		if err := t.writeObj(o.num, o.body); err != nil {
+			return err
+		}
+	}
+
+	xrefAt := t.w.n
+	var b strings.Builder
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", t.nextObj)
+	for i := 1; i < t.nextObj; i++ {
+		fmt.Fprintf(&b, "%010d 00000 n \n", t.offsets[i])
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", t.nextObj, xrefAt)
+	_, err := io.WriteString(t.w, b.String())
+	return err
+}
+
+// pdfEscape ubah teks ke WinAnsi (Latin-1) dan escape karakter khusus string PDF
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r >= 0x20 && r < 0x7f, r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+func truncateRunes(s string, max int) string {
+	r := []rune(s)
+	if max <= 0 {
+		return ""
+	}
+	if len(r) <= max {
+		return s
+	}
+	if max <= 3 {
+		return string(r[:max])
+	}
+	return string(r[:max-3]) + "..."
+}
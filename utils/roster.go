@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"backendgo/app/model"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParseGraduateRosterCSV membaca CSV roster lulusan. Baris pertama wajib header;
+// kolom nim dan nama wajib ada, jurusan / angkatan / tahun_lulus opsional.
+// Baris yang tidak valid dilewati dan dilaporkan di errs (nomor baris mulai 1 = header).
+func ParseGraduateRosterCSV(r io.Reader) (list []model.GraduateRoster, errs []string, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("header CSV tidak bisa dibaca: %w", err)
+	}
+	cols := map[string]int{}
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"nim", "nama"} {
+		if _, ok := cols[required]; !ok {
+			return nil, nil, fmt.Errorf("kolom %s wajib ada di header", required)
+		}
+	}
+
+	get := func(record []string, col string) string {
+		i, ok := cols[col]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	seen := map[string]bool{}
+	line := 1
+	for {
+		record, readErr := reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+		line++
+		if readErr != nil {
+			errs = append(errs, fmt.Sprintf("baris %d: %v", line, readErr))
+			continue
+		}
+
+		g := model.GraduateRoster{
+			NIM:     get(record, "nim"),
+			Nama:    get(record, "nama"),
+			Jurusan: get(record, "jurusan"),
+		}
+		if g.NIM == "" || g.Nama == "" {
+			errs = append(errs, fmt.Sprintf("baris %d: nim dan nama wajib diisi", line))
+			continue
+		}
+		if seen[g.NIM] {
+			errs = append(errs, fmt.Sprintf("baris %d: NIM %s duplikat", line, g.NIM))
+			continue
+		}
+
+		valid := true
+		numbers := []struct {
+			col string
+			dst *int
+		}{{"angkatan", &g.Angkatan}, {"tahun_lulus", &g.TahunLulus}}
+		for _, num := range numbers {
+			v := get(record, num.col)
+			if v == "" {
+				continue
+			}
+			n, convErr := strconv.Atoi(v)
+			if convErr != nil {
+				errs = append(errs, fmt.Sprintf("baris %d: %s harus berupa angka", line, num.col))
+				valid = false
+				break
+			}
+			*num.dst = n
+		}
+		if !valid {
+			continue
+		}
+
+		seen[g.NIM] = true
+		list = append(list, g)
+	}
+	return list, errs, nil
+}
+
+// NormalizeName menyamakan penulisan nama untuk dicocokkan (huruf kecil, spasi tunggal)
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"backendgo/config"
+)
+
+// RefreshTokenTTL masa berlaku refresh token (env REFRESH_TOKEN_TTL, default 30 hari)
+func RefreshTokenTTL() time.Duration {
+	return config.GetDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour)
+}
+
+// GenerateOpaqueToken bikin token acak (base64url) untuk refresh token dan sejenisnya
+func GenerateOpaqueToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken menghasilkan SHA-256 hex dari token, supaya token asli tidak disimpan di DB
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
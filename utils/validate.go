@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"backendgo/app/model"
+	"backendgo/config"
+	"backendgo/i18n"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ===================================================
+// 🔹 Validasi request deklaratif lewat tag `validate`
+// Contoh: `validate:"required,nim"`, `validate:"date,gtefield=tanggal_mulai_kerja"`.
+// Aturan dipisah koma dan dicek berurutan; aturan pertama yang gagal dilaporkan.
+// Field kosong (string kosong, angka 0, pointer nil) hanya dicek oleh required,
+// notblank berlaku untuk pointer yang dikirim (dipakai request update parsial).
+//
+//   required      wajib diisi
+//   notblank      kalau dikirim tidak boleh kosong
+//   nim           cocok dengan NIM_PATTERN
+//   email         format email
+//   phone         E.164 (+6281234567890) atau nomor Indonesia (081234567890)
+//   date          YYYY-MM-DD
+//   oneof=a b     salah satu nilai (dipisah spasi)
+//   min=N, max=N  batas angka, atau batas panjang untuk teks
+//   gtefield=f    tidak lebih kecil dari field f (angka / tanggal)
+// ===================================================
+
+const dateLayout = "2006-01-02"
+
+var (
+	emailPattern   = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	phoneE164      = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+	phoneIndonesia = regexp.MustCompile(`^(\+62|62|0)8[1-9][0-9]{6,11}$`)
+)
+
+// Validate cek semua field struct (atau pointer ke struct) dan kembalikan semua kesalahan.
+// Pesan dalam bahasa default; Key + Args ikut disimpan untuk diterjemahkan ErrorHandler.
+func Validate(v interface{}) []model.FieldError {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	rt := rv.Type()
+
+	errs := []model.FieldError{}
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get("validate")
+		if tag == "" {
+			continue
+		}
+		for _, rule := range strings.Split(tag, ",") {
+			if key, args := checkRule(rv, rv.Field(i), rule); key != "" {
+				errs = append(errs, model.FieldError{
+					Field:   jsonName(rt.Field(i)),
+					Message: i18n.Translate(i18n.Default, key, args...),
+					Key:     key,
+					Args:    args,
+				})
+				break
+			}
+		}
+	}
+	return errs
+}
+
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
+
+// fieldByJSON cari field saudara berdasarkan nama json (untuk gtefield)
+func fieldByJSON(parent reflect.Value, name string) reflect.Value {
+	for i := 0; i < parent.NumField(); i++ {
+		if jsonName(parent.Type().Field(i)) == name {
+			return parent.Field(i)
+		}
+	}
+	panic("validate: field " + name + " tidak ada")
+}
+
+// isEmpty nilai dianggap tidak diisi: nil, teks kosong / spasi, atau 0
+func isEmpty(v reflect.Value) bool {
+	if v.Kind() == reflect.Ptr {
+		return v.IsNil()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(v.String()) == ""
+	case reflect.Int, reflect.Int64:
+		return v.Int() == 0
+	}
+	return v.IsZero()
+}
+
+// checkRule kembalikan key pesan i18n (+ argumen) kalau aturan gagal, "" kalau lolos
+func checkRule(parent, field reflect.Value, rule string) (string, []interface{}) {
+	name, param, _ := strings.Cut(rule, "=")
+
+	switch name {
+	case "required":
+		if isEmpty(field) || isEmpty(reflect.Indirect(field)) {
+			return "validation.required", nil
+		}
+		return "", nil
+	case "notblank":
+		if !isEmpty(field) && isEmpty(field.Elem()) {
+			return "validation.notblank", nil
+		}
+		return "", nil
+	}
+
+	if isEmpty(field) {
+		return "", nil
+	}
+	value := reflect.Indirect(field)
+	text := strings.TrimSpace(fmt.Sprint(value.Interface()))
+	if value.Kind() == reflect.String && text == "" {
+		return "", nil
+	}
+
+	switch name {
+	case "nim":
+		pattern := config.GetEnv("NIM_PATTERN", `^[0-9]{8,15}$`)
+		if ok, _ := regexp.MatchString(pattern, text); !ok {
+			return "validation.nim", nil
+		}
+	case "email":
+		if !emailPattern.MatchString(text) {
+			return "validation.email", nil
+		}
+	case "phone":
+		phone := strings.NewReplacer(" ", "", "-", "").Replace(text)
+		if !phoneE164.MatchString(phone) && !phoneIndonesia.MatchString(phone) {
+			return "validation.phone", nil
+		}
+	case "date":
+		if _, err := time.Parse(dateLayout, text); err != nil {
+			return "validation.date", nil
+		}
+	case "oneof":
+		allowed := strings.Fields(param)
+		for _, a := range allowed {
+			if text == a {
+				return "", nil
+			}
+		}
+		return "validation.oneof", []interface{}{strings.Join(allowed, ", ")}
+	case "min", "max":
+		limit, _ := strconv.Atoi(param)
+		n, key := len([]rune(text)), "validation."+name+"_length"
+		if value.Kind() != reflect.String {
+			n, key = int(value.Int()), "validation."+name
+		}
+		if (name == "min" && n < limit) || (name == "max" && n > limit) {
+			return key, []interface{}{limit}
+		}
+	case "gtefield":
+		other := fieldByJSON(parent, param)
+		if isEmpty(other) {
+			return "", nil
+		}
+		otherValue := reflect.Indirect(other)
+		if value.Kind() != reflect.String {
+			if value.Int() < otherValue.Int() {
+				return "validation.gte_number", []interface{}{param}
+			}
+			return "", nil
+		}
+		a, errA := time.Parse(dateLayout, text)
+		b, errB := time.Parse(dateLayout, strings.TrimSpace(otherValue.String()))
+		if errA == nil && errB == nil && a.Before(b) {
+			return "validation.gte_date", []interface{}{param}
+		}
+	default:
+		panic("validate: aturan " + name + " tidak dikenal")
+	}
+	return "", nil
+}